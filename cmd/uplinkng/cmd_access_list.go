@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
 
 	"storj.io/storj/cmd/uplinkng/ulext"
 	"storj.io/uplink"
@@ -31,19 +32,28 @@ func (c *cmdAccessList) Setup(params clingy.Parameters) {
 	).(bool)
 }
 
-func (c *cmdAccessList) Execute(ctx clingy.Context) error {
+// accessListEntry is the result written for every saved access. The value is
+// only included when listing verbosely.
+type accessListEntry struct {
+	Name      string `json:"name"`
+	Satellite string `json:"satellite"`
+	Current   bool   `json:"current"`
+	Value     string `json:"value,omitempty"`
+}
+
+func (c *cmdAccessList) Execute(ctx clingy.Context) (err error) {
 	defaultName, accesses, err := c.ex.GetAccessInfo(true)
 	if err != nil {
 		return err
 	}
 
-	var tw *tabbedWriter
+	var rw *resultWriter
 	if c.verbose {
-		tw = newTabbedWriter(ctx.Stdout(), "CURRENT", "NAME", "SATELLITE", "VALUE")
+		rw = newResultWriter(ctx.Stdout(), c.ex.OutputFormat(), "CURRENT", "NAME", "SATELLITE", "VALUE")
 	} else {
-		tw = newTabbedWriter(ctx.Stdout(), "CURRENT", "NAME", "SATELLITE")
+		rw = newResultWriter(ctx.Stdout(), c.ex.OutputFormat(), "CURRENT", "NAME", "SATELLITE")
 	}
-	defer tw.Done()
+	defer func() { err = errs.Combine(err, rw.Done()) }()
 
 	var names []string
 	for name := range accesses {
@@ -66,10 +76,16 @@ func (c *cmdAccessList) Execute(ctx clingy.Context) error {
 			inUse = '*'
 		}
 
+		entry := accessListEntry{
+			Name:      name,
+			Satellite: address,
+			Current:   name == defaultName,
+		}
 		if c.verbose {
-			tw.WriteLine(inUse, name, address, accesses[name])
+			entry.Value = accesses[name]
+			rw.Write(entry, inUse, name, address, accesses[name])
 		} else {
-			tw.WriteLine(inUse, name, address)
+			rw.Write(entry, inUse, name, address)
 		}
 	}
 
@@ -6,24 +6,38 @@ package main
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strconv"
+	"sync"
 
 	progressbar "github.com/cheggaaa/pb/v3"
 	"github.com/zeebo/clingy"
 	"github.com/zeebo/errs"
 
+	"storj.io/common/memory"
+	"storj.io/common/sync2"
 	"storj.io/storj/cmd/uplinkng/ulext"
 	"storj.io/storj/cmd/uplinkng/ulfs"
 	"storj.io/storj/cmd/uplinkng/ulloc"
 )
 
+// minPartSize is the smallest part the satellite accepts in a multipart upload, only
+// the last part of an upload can be smaller.
+const minPartSize = 5 * memory.MiB
+
 type cmdCp struct {
 	ex ulext.External
 
-	access    string
-	recursive bool
-	dryrun    bool
-	progress  bool
+	access      string
+	recursive   bool
+	dryrun      bool
+	progress    bool
+	resume      bool
+	parallelism int
+	parts       int
+	partSize    memory.Size
+
+	objectFilter
 
 	source ulloc.Location
 	dest   ulloc.Location
@@ -45,25 +59,86 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 	c.progress = params.Flag("progress", "Show a progress bar when possible", true,
 		clingy.Transform(strconv.ParseBool),
 	).(bool)
+	c.resume = params.Flag("resume", "Record the progress of the copy and continue an interrupted copy of the same files", false,
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+	c.parallelism = params.Flag("parallelism", "Number of files copied concurrently in a recursive copy", 1,
+		clingy.Transform(strconv.Atoi),
+	).(int)
+	c.parts = params.Flag("parts", "Number of parts of a single file transferred concurrently", 1,
+		clingy.Transform(strconv.Atoi),
+	).(int)
+	c.partSize = params.Flag("part-size", "Size of the parts a file is split into when --parts is more than 1, at least 5MiB for uploads", 64*memory.MiB,
+		clingy.Transform(parseSize), clingy.Type("size"),
+	).(memory.Size)
+
+	c.objectFilter.Setup(params)
 
 	c.source = params.Arg("source", "Source to copy", clingy.Transform(ulloc.Parse)).(ulloc.Location)
 	c.dest = params.Arg("dest", "Desination to copy", clingy.Transform(ulloc.Parse)).(ulloc.Location)
 }
 
-func (c *cmdCp) Execute(ctx clingy.Context) error {
+// cpResult is the result written for every copy, move or synchronization. Failed
+// operations have the error and are only written when the output isn't text and
+// they are part of a recursive operation. A single failed operation is reported by
+// the error the command returns.
+type cpResult struct {
+	Action string `json:"action"`
+	Source string `json:"source,omitempty"`
+	Dest   string `json:"dest"`
+	Error  string `json:"error,omitempty"`
+}
+
+func (c *cmdCp) Execute(ctx clingy.Context) (err error) {
+	if c.parallelism < 1 {
+		return errs.New("parallelism must be at least 1")
+	} else if c.parts < 1 {
+		return errs.New("parts must be at least 1")
+	} else if c.partSize <= 0 {
+		return errs.New("part size must be positive")
+	} else if c.dest.Remote() && !c.source.Remote() && (c.parts > 1 || c.resume) && c.partSize < minPartSize {
+		return errs.New("part size must be at least %s for multipart uploads", minPartSize)
+	}
+
+	filter, err := c.filter()
+	if err != nil {
+		return err
+	} else if !c.recursive && !filter.IsZero() {
+		return errs.New("filters can only be used with --recursive")
+	}
+
 	fs, err := c.ex.OpenFilesystem(ctx, c.access)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = fs.Close() }()
 
+	// the transfers and the progress bar write to stdout concurrently.
+	stdout := &syncWriter{w: ctx.Stdout()}
+
+	// nothing but the copied data may be written when copying to stdout.
+	var results io.Writer = stdout
+	if c.dest.Std() {
+		results = ioutil.Discard
+	}
+
+	rw := newResultWriter(results, c.ex.OutputFormat())
+	defer func() { err = errs.Combine(err, rw.Done()) }()
+
+	// the progress bar would be mixed into any machine readable results.
+	var progress *copyProgress
+	if c.progress && !c.dest.Std() && rw.Text() {
+		progress = &copyProgress{out: stdout}
+		defer progress.finish()
+	}
+
 	if c.recursive {
-		return c.copyRecursive(ctx, fs)
+		return c.copyRecursive(ctx, fs, rw, progress, filter)
 	}
-	return c.copyFile(ctx, fs, c.source, c.dest, c.progress)
+	return c.copyFile(ctx, fs, rw, progress, c.source, c.dest)
 }
 
-func (c *cmdCp) copyRecursive(ctx clingy.Context, fs ulfs.Filesystem) error {
+func (c *cmdCp) copyRecursive(ctx clingy.Context, fs ulfs.Filesystem, rw *resultWriter, progress *copyProgress, filter *ulfs.Filter) error {
 	if c.source.Std() || c.dest.Std() {
 		return errs.New("cannot recursively copy to stdin/stdout")
 	}
@@ -72,32 +147,52 @@ func (c *cmdCp) copyRecursive(ctx clingy.Context, fs ulfs.Filesystem) error {
 	if err != nil {
 		return err
 	}
+	iter = ulfs.FilterObjects(iter, c.source, filter)
 
+	var mu sync.Mutex
 	anyFailed := false
+
+	limiter := sync2.NewLimiter(c.parallelism)
+
 	for iter.Next() {
 		rel, err := c.source.RelativeTo(iter.Item().Loc)
 		if err != nil {
+			limiter.Wait()
 			return err
 		}
 
 		source := iter.Item().Loc
 		dest := c.dest.AppendKey(rel)
 
-		if err := c.copyFile(ctx, fs, source, dest, false); err != nil {
-			fmt.Fprintln(ctx.Stderr(), copyVerb(source, dest), "failed:", err.Error())
-			anyFailed = true
+		ok := limiter.Go(ctx, func() {
+			if err := c.copyFile(ctx, fs, rw, progress, source, dest); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if rw.Text() {
+					fmt.Fprintln(ctx.Stderr(), copyVerb(source, dest), "failed:", err.Error())
+				}
+				anyFailed = true
+			}
+		})
+		if !ok {
+			break
 		}
 	}
 
+	limiter.Wait()
+
 	if err := iter.Err(); err != nil {
 		return errs.Wrap(err)
+	} else if err := ctx.Err(); err != nil {
+		return errs.Wrap(err)
 	} else if anyFailed {
 		return errs.New("some downloads failed")
 	}
 	return nil
 }
 
-func (c *cmdCp) copyFile(ctx clingy.Context, fs ulfs.Filesystem, source, dest ulloc.Location, progress bool) error {
+func (c *cmdCp) copyFile(ctx clingy.Context, fs ulfs.Filesystem, rw *resultWriter, progress *copyProgress, source, dest ulloc.Location) (err error) {
 	if isDir := fs.IsLocalDir(ctx, dest); isDir {
 		base, ok := source.Base()
 		if !ok {
@@ -106,37 +201,297 @@ func (c *cmdCp) copyFile(ctx clingy.Context, fs ulfs.Filesystem, source, dest ul
 		dest = dest.AppendKey(base)
 	}
 
+	// text results are written before the copy starts so that they show what is
+	// being copied, and the others are written once it is known if it succeeded.
 	if !source.Std() && !dest.Std() {
-		fmt.Fprintln(ctx.Stdout(), copyVerb(source, dest), source, "to", dest)
+		result := cpResult{Action: copyVerb(source, dest), Source: source.String(), Dest: dest.String()}
+		if rw.Text() {
+			rw.Write(result, result.Action, source, "to", dest)
+		} else {
+			defer func() {
+				if err == nil {
+					rw.Write(result)
+				} else if c.recursive {
+					result.Error = err.Error()
+					rw.Write(result)
+				}
+			}()
+		}
 	}
 
 	if c.dryrun {
 		return nil
 	}
 
+	// the length of stdin isn't known, so it's always copied as a single stream.
+	info := ulfs.ObjectInfo{ContentLength: -1}
+	if !source.Std() {
+		info, err = fs.Stat(ctx, source)
+		if err != nil {
+			return err
+		}
+	}
+
+	length := info.ContentLength
+	if length < 0 {
+		// without the length there's nothing to show progress against.
+		progress = nil
+	}
+	progress.add(length)
+
+	if c.resume && length >= 0 && !dest.Std() {
+		return c.copyResumable(ctx, fs, rw, progress, source, dest, info)
+	}
+	if c.parts > 1 && length > int64(c.partSize) && !dest.Std() {
+		return c.copyParts(ctx, fs, progress, source, dest, length)
+	}
+
 	rh, err := fs.Open(ctx, source)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = rh.Close() }()
 
-	wh, err := fs.Create(ctx, dest)
+	wh, err := fs.Create(ctx, dest, nil)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = wh.Abort() }()
 
-	var bar *progressbar.ProgressBar
-	var writer io.Writer = wh
+	if _, err := io.Copy(progress.writer(wh), rh); err != nil {
+		return errs.Combine(err, wh.Abort())
+	}
+	return errs.Wrap(wh.Commit())
+}
+
+// copyParts splits the source into parts of the part size and copies them concurrently
+// into a multipart destination.
+func (c *cmdCp) copyParts(ctx clingy.Context, fs ulfs.Filesystem, progress *copyProgress, source, dest ulloc.Location, length int64) error {
+	mwh, err := fs.CreateMultipart(ctx, dest)
+	if err != nil {
+		return err
+	}
+
+	if err := c.transferParts(ctx, fs, mwh, progress, source, length, nil, nil); err != nil {
+		return errs.Combine(err, mwh.Abort(ctx))
+	}
+	return errs.Wrap(mwh.Commit(ctx))
+}
 
-	if length := rh.Info().ContentLength; progress && length >= 0 && !c.dest.Std() {
-		bar = progressbar.New64(length).SetWriter(ctx.Stdout())
-		writer = bar.NewProxyWriter(writer)
-		bar.Start()
-		defer bar.Finish()
+// copyResumable copies the source in parts like copyParts, but records the committed
+// parts in the transfer state so that an interrupted copy skips them when it's run
+// again. A download with a single part continues from the end of the partial file.
+func (c *cmdCp) copyResumable(ctx clingy.Context, fs ulfs.Filesystem, rw *resultWriter, progress *copyProgress, source, dest ulloc.Location, info ulfs.ObjectInfo) error {
+	key := transferStateKey(source, dest)
+	expected := transferState{
+		Source:   source.String(),
+		Dest:     dest.String(),
+		Length:   info.ContentLength,
+		Created:  info.Created,
+		PartSize: int64(c.partSize),
 	}
 
-	if _, err := io.Copy(writer, rh); err != nil {
+	var state transferState
+	ok, err := c.ex.LoadTransferState(key, &state)
+	if err != nil {
+		return err
+	}
+
+	var mwh ulfs.MultiWriteHandle
+	if ok && state.matches(expected) {
+		mwh, err = resumeMultipart(ctx, fs, dest, state.ID)
+		if err != nil && rw.Text() {
+			rw.Write(nil, "unable to resume", copyVerb(source, dest), "starting over:", err.Error())
+		}
+	} else if ok && dest.Remote() {
+		// the pending upload of a state which doesn't match would be left behind.
+		if err := abortPendingUpload(ctx, fs, dest, state.ID); err != nil {
+			return err
+		}
+	}
+
+	resumed := mwh != nil
+	if !resumed {
+		mwh, err = fs.CreateMultipart(ctx, dest)
+		if err != nil {
+			return err
+		}
+
+		state = expected
+		state.ID = mwh.ID()
+		if err := c.ex.SaveTransferState(key, state); err != nil {
+			return errs.Combine(err, mwh.Abort(ctx))
+		}
+	}
+
+	if c.parts == 1 && dest.Local() {
+		err = c.transferTail(ctx, fs, mwh, progress, source, dest, info.ContentLength, resumed)
+	} else {
+		err = c.transferRemainingParts(ctx, fs, mwh, progress, source, key, &state)
+	}
+	if err != nil {
+		// the pending upload and the state are kept so that the copy can be resumed.
+		if rw.Text() {
+			fmt.Fprintln(ctx.Stderr(), "run the same command with --resume to continue")
+		}
+		return errs.Wrap(err)
+	}
+
+	if err := mwh.Commit(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+	return c.ex.RemoveTransferState(key)
+}
+
+// resumeMultipart continues the multipart write with the id. A remote upload is only
+// resumed when it's still listed as pending at the location.
+func resumeMultipart(ctx clingy.Context, fs ulfs.Filesystem, loc ulloc.Location, id string) (ulfs.MultiWriteHandle, error) {
+	if loc.Remote() {
+		pending, err := isPendingUpload(ctx, fs, loc, id)
+		if err != nil {
+			return nil, err
+		} else if !pending {
+			return nil, errs.New("upload %q is not pending anymore", id)
+		}
+	}
+	return fs.ResumeMultipart(ctx, loc, id)
+}
+
+// abortPendingUpload aborts the remote upload with the id when it's still pending.
+func abortPendingUpload(ctx clingy.Context, fs ulfs.Filesystem, loc ulloc.Location, id string) error {
+	pending, err := isPendingUpload(ctx, fs, loc, id)
+	if err != nil || !pending {
+		return err
+	}
+
+	mwh, err := fs.ResumeMultipart(ctx, loc, id)
+	if err != nil {
+		return err
+	}
+	return errs.Wrap(mwh.Abort(ctx))
+}
+
+// isPendingUpload returns whether the upload with the id is listed as pending at the
+// remote location.
+func isPendingUpload(ctx clingy.Context, fs ulfs.Filesystem, loc ulloc.Location, id string) (bool, error) {
+	iter, err := fs.ListUploads(ctx, loc, false)
+	if err != nil {
+		return false, err
+	}
+	for iter.Next() {
+		if item := iter.Item(); item.Loc == loc && item.UploadID == id {
+			return true, nil
+		}
+	}
+	return false, errs.Wrap(iter.Err())
+}
+
+// transferTail downloads the source into a single part that starts at the end of the
+// partial local file when the download is resumed.
+func (c *cmdCp) transferTail(ctx clingy.Context, fs ulfs.Filesystem, mwh ulfs.MultiWriteHandle, progress *copyProgress, source, dest ulloc.Location, length int64, resumed bool) error {
+	var offset int64
+	if resumed {
+		rh, err := fs.Open(ctx, dest)
+		if err != nil {
+			return err
+		}
+		offset = rh.Info().ContentLength
+		_ = rh.Close()
+
+		if offset < 0 || offset > length {
+			offset = 0
+		}
+	}
+
+	progress.skip(offset)
+	return copyPart(ctx, fs, mwh, progress, source, 0, offset, length-offset)
+}
+
+// transferRemainingParts copies the parts of the source that are not committed yet and
+// records every committed part in the state.
+func (c *cmdCp) transferRemainingParts(ctx clingy.Context, fs ulfs.Filesystem, mwh ulfs.MultiWriteHandle, progress *copyProgress, source ulloc.Location, key string, state *transferState) error {
+	committed, err := mwh.Committed(ctx)
+	if err != nil {
+		return err
+	}
+
+	done := make(map[int]bool)
+	for _, index := range append(committed, state.Parts...) {
+		done[index] = true
+	}
+
+	var mu sync.Mutex
+	return c.transferParts(ctx, fs, mwh, progress, source, state.Length,
+		func(index int) bool { return done[index] },
+		func(index int) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			state.Parts = append(state.Parts, index)
+			return c.ex.SaveTransferState(key, state)
+		})
+}
+
+// transferParts splits the source into parts of the part size and copies them concurrently
+// into mwh. The parts for which skip returns true are not copied and committed is called
+// after a part is committed. Both functions may be nil.
+func (c *cmdCp) transferParts(ctx clingy.Context, fs ulfs.Filesystem, mwh ulfs.MultiWriteHandle, progress *copyProgress, source ulloc.Location, length int64, skip func(index int) bool, committed func(index int) error) error {
+	var mu sync.Mutex
+	var group errs.Group
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(group) > 0
+	}
+
+	limiter := sync2.NewLimiter(c.parts)
+	for index, offset := 0, int64(0); offset < length && !failed(); index, offset = index+1, offset+int64(c.partSize) {
+		index, offset := index, offset
+		partLength := int64(c.partSize)
+		if offset+partLength > length {
+			partLength = length - offset
+		}
+
+		if skip != nil && skip(index) {
+			progress.skip(partLength)
+			continue
+		}
+
+		ok := limiter.Go(ctx, func() {
+			err := copyPart(ctx, fs, mwh, progress, source, index, offset, partLength)
+			if err == nil && committed != nil {
+				err = committed(index)
+			}
+			if err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+
+				group.Add(errs.New("part %d: %v", index, err))
+			}
+		})
+		if !ok {
+			break
+		}
+	}
+	limiter.Wait()
+
+	group.Add(ctx.Err())
+	return group.Err()
+}
+
+func copyPart(ctx clingy.Context, fs ulfs.Filesystem, mwh ulfs.MultiWriteHandle, progress *copyProgress, source ulloc.Location, index int, offset, length int64) error {
+	rh, err := fs.OpenRange(ctx, source, offset, length)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rh.Close() }()
+
+	wh, err := mwh.Part(ctx, index, offset)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(progress.writer(wh), rh); err != nil {
 		return errs.Combine(err, wh.Abort())
 	}
 	return errs.Wrap(wh.Commit())
@@ -152,3 +507,80 @@ func copyVerb(source, dest ulloc.Location) string {
 		return "copy"
 	}
 }
+
+func parseSize(v string) (memory.Size, error) {
+	size, err := memory.ParseString(v)
+	return memory.Size(size), err
+}
+
+// copyProgress aggregates the progress of every transfer of a copy into a
+// single progress bar. A nil *copyProgress shows no progress.
+type copyProgress struct {
+	out io.Writer
+
+	mu  sync.Mutex
+	bar *progressbar.ProgressBar
+}
+
+// add adds the length of a transfer to the total of the progress bar.
+func (p *copyProgress) add(length int64) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.bar == nil {
+		p.bar = progressbar.New64(length).SetWriter(p.out)
+		p.bar.Start()
+		return
+	}
+	p.bar.SetTotal(p.bar.Total() + length)
+}
+
+// writer returns w counting the written bytes towards the progress bar.
+func (p *copyProgress) writer(w io.Writer) io.Writer {
+	if p == nil {
+		return w
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.bar.NewProxyWriter(w)
+}
+
+// skip counts length bytes that don't have to be copied again as done.
+func (p *copyProgress) skip(length int64) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.bar.Add64(length)
+}
+
+func (p *copyProgress) finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.bar != nil {
+		p.bar.Finish()
+	}
+}
+
+// syncWriter serializes the writes to an io.Writer.
+type syncWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (s *syncWriter) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.w.Write(p)
+}
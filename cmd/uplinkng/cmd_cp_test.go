@@ -4,8 +4,13 @@
 package main
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"storj.io/common/memory"
+	"storj.io/storj/cmd/uplinkng/ulext"
+	"storj.io/storj/cmd/uplinkng/ulloc"
 	"storj.io/storj/cmd/uplinkng/ultest"
 )
 
@@ -93,6 +98,149 @@ func TestCpRecursiveDownload(t *testing.T) {
 	)
 }
 
+func TestCpParts(t *testing.T) {
+	// uploaded parts can't be smaller than the minimum, except the last one.
+	large := strings.Repeat("0123456789abcdef", int(minPartSize)/16) + "tail"
+
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/file1.txt", large),
+		ultest.WithFile("sj://user/file2.txt", "fedcba9876543210"),
+	)
+
+	state.Succeed(t, "cp", "/home/user/file1.txt", "sj://user/file1.txt", "--parts", "3", "--part-size", "5MiB").RequireFiles(t,
+		ultest.File{Loc: "/home/user/file1.txt", Contents: large},
+		ultest.File{Loc: "sj://user/file1.txt", Contents: large},
+		ultest.File{Loc: "sj://user/file2.txt", Contents: "fedcba9876543210"},
+	)
+
+	state.Succeed(t, "cp", "sj://user/file2.txt", "/home/user/file2.txt", "--parts", "2", "--part-size", "4B").RequireFiles(t,
+		ultest.File{Loc: "/home/user/file1.txt", Contents: large},
+		ultest.File{Loc: "/home/user/file2.txt", Contents: "fedcba9876543210"},
+		ultest.File{Loc: "sj://user/file2.txt", Contents: "fedcba9876543210"},
+	)
+
+	state.Fail(t, "cp", "/home/user/file1.txt", "sj://user/file1.txt", "--parts", "0")
+	state.Fail(t, "cp", "/home/user/file1.txt", "sj://user/file1.txt", "--part-size", "0")
+	state.Fail(t, "cp", "/home/user/file1.txt", "sj://user/file1.txt", "--parts", "2", "--part-size", "4MiB")
+	state.Fail(t, "cp", "/home/user/file1.txt", "sj://user/file1.txt", "--resume", "--part-size", "4MiB")
+}
+
+func TestCpRecursiveParallel(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/file1.txt", "data1"),
+		ultest.WithFile("/home/user/folder1/file2.txt", "data2"),
+		ultest.WithFile("/home/user/folder1/file3.txt", "data3"),
+		ultest.WithFile("/home/user/folder2/file4.txt", "data4"),
+		ultest.WithBucket("user"),
+	)
+
+	state.Succeed(t, "cp", "/home/user", "sj://user/dest/", "--recursive", "--parallelism", "3", "--parts", "2").RequireFiles(t,
+		ultest.File{Loc: "/home/user/file1.txt", Contents: "data1"},
+		ultest.File{Loc: "/home/user/folder1/file2.txt", Contents: "data2"},
+		ultest.File{Loc: "/home/user/folder1/file3.txt", Contents: "data3"},
+		ultest.File{Loc: "/home/user/folder2/file4.txt", Contents: "data4"},
+
+		ultest.File{Loc: "sj://user/dest/file1.txt", Contents: "data1"},
+		ultest.File{Loc: "sj://user/dest/folder1/file2.txt", Contents: "data2"},
+		ultest.File{Loc: "sj://user/dest/folder1/file3.txt", Contents: "data3"},
+		ultest.File{Loc: "sj://user/dest/folder2/file4.txt", Contents: "data4"},
+	)
+
+	state.Fail(t, "cp", "/home/user", "sj://user/dest", "--recursive", "--parallelism", "0")
+}
+
+func TestCpResumeUpload(t *testing.T) {
+	source, dest := ulloc.NewLocal("/home/user/file1.txt"), ulloc.NewRemote("user", "file1.txt")
+	key := transferStateKey(source, dest)
+
+	firstPart := strings.Repeat("0", int(minPartSize))
+	contents := firstPart + "456789"
+
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/file1.txt", contents),
+		ultest.WithBucket("user"),
+	)
+
+	// a copy that completes doesn't leave any state behind.
+	state.Succeed(t, "cp", "/home/user/file1.txt", "sj://user/file1.txt", "--resume", "--part-size", "5MiB").RequireFiles(t,
+		ultest.File{Loc: "/home/user/file1.txt", Contents: contents},
+		ultest.File{Loc: "sj://user/file1.txt", Contents: contents},
+	).RequireTransfers(t)
+
+	// the committed parts of the pending upload are not uploaded again, which the
+	// different contents of the first part show.
+	transfer := transferState{
+		Source:   source.String(),
+		Dest:     dest.String(),
+		Length:   int64(len(contents)),
+		Created:  time.Unix(1, 0),
+		PartSize: int64(minPartSize),
+	}
+	pendingPart := strings.Repeat("a", int(minPartSize))
+
+	state.With(
+		ultest.WithPendingParts("sj://user/file1.txt", &transfer.ID, pendingPart),
+		ultest.WithTransferState(key, &transfer),
+	).Succeed(t, "cp", "/home/user/file1.txt", "sj://user/file1.txt", "--resume", "--part-size", "5MiB").RequireFiles(t,
+		ultest.File{Loc: "/home/user/file1.txt", Contents: contents},
+		ultest.File{Loc: "sj://user/file1.txt", Contents: pendingPart + "456789"},
+	).RequireTransfers(t)
+
+	// a state for a different part size starts over and aborts its pending upload.
+	state.With(
+		ultest.WithPendingParts("sj://user/file1.txt", &transfer.ID, pendingPart),
+		ultest.WithTransferState(key, &transfer),
+	).Succeed(t, "cp", "/home/user/file1.txt", "sj://user/file1.txt", "--resume", "--part-size", "6MiB").RequireFiles(t,
+		ultest.File{Loc: "/home/user/file1.txt", Contents: contents},
+		ultest.File{Loc: "sj://user/file1.txt", Contents: contents},
+	).RequireTransfers(t).RequirePending(t)
+
+	// a state whose upload isn't pending anymore starts over.
+	missing := transfer
+	missing.ID = "upload-missing"
+	state.With(
+		ultest.WithTransferState(key, &missing),
+	).Succeed(t, "cp", "/home/user/file1.txt", "sj://user/file1.txt", "--resume", "--part-size", "5MiB").RequireFiles(t,
+		ultest.File{Loc: "/home/user/file1.txt", Contents: contents},
+		ultest.File{Loc: "sj://user/file1.txt", Contents: contents},
+	).RequireTransfers(t).RequirePending(t)
+}
+
+func TestCpResumeDownload(t *testing.T) {
+	source, dest := ulloc.NewRemote("user", "file1.txt"), ulloc.NewLocal("/home/user/file1.txt")
+	key := transferStateKey(source, dest)
+
+	// the partial file is continued from its end, which the different contents of
+	// the partial file show.
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/file1.txt", "0123456789"),
+		ultest.WithFile("/home/user/file1.txt", "abcd"),
+		ultest.WithTransferState(key, transferState{
+			Source:   source.String(),
+			Dest:     dest.String(),
+			Length:   10,
+			Created:  time.Unix(1, 0),
+			PartSize: int64(64 * memory.MiB),
+		}),
+	)
+
+	state.Succeed(t, "cp", "sj://user/file1.txt", "/home/user/file1.txt", "--resume").RequireFiles(t,
+		ultest.File{Loc: "/home/user/file1.txt", Contents: "abcd456789"},
+		ultest.File{Loc: "sj://user/file1.txt", Contents: "0123456789"},
+	).RequireTransfers(t)
+
+	// without the state the partial file is overwritten.
+	state = ultest.Setup(commands,
+		ultest.WithFile("sj://user/file1.txt", "0123456789"),
+		ultest.WithFile("/home/user/file1.txt", "abcd"),
+	)
+
+	state.Succeed(t, "cp", "sj://user/file1.txt", "/home/user/file1.txt", "--resume").RequireFiles(t,
+		ultest.File{Loc: "/home/user/file1.txt", Contents: "0123456789"},
+		ultest.File{Loc: "sj://user/file1.txt", Contents: "0123456789"},
+	).RequireTransfers(t)
+}
+
 func TestCpRecursiveDifficult(t *testing.T) {
 	state := ultest.Setup(commands,
 		ultest.WithFile("sj://user/dot-dot/../foo"),
@@ -116,3 +264,24 @@ func TestCpRecursiveDifficult(t *testing.T) {
 	// TODO(jeff): these tests. oops.
 	_ = state
 }
+
+func TestCpOutput(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithBucket("user"),
+		ultest.WithFile("/home/user/file1.txt"),
+		ultest.WithFile("/home/user/file2.txt"),
+	)
+
+	state.Succeed(t, "cp", "/home/user", "sj://user/dest/", "--recursive", "--output", "ndjson").RequireStdout(t, `
+		{"action":"upload","source":"/home/user/file1.txt","dest":"sj://user/dest/file1.txt"}
+		{"action":"upload","source":"/home/user/file2.txt","dest":"sj://user/dest/file2.txt"}
+	`).RequireExitCode(t, ulext.ExitSuccess)
+
+	state.Fail(t, "cp", "/home/user/missing.txt", "sj://user/missing.txt", "--output", "json").RequireStdout(t, `
+		[]
+	`).RequireStderr(t, `
+		{"error":"file does not exist"}
+	`).RequireExitCode(t, ulext.ExitFailure)
+
+	state.Succeed(t, "cp", "/home/user/file1.txt", "-", "--output", "json").RequireStdout(t, ``)
+}
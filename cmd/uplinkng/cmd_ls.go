@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
 
 	"storj.io/storj/cmd/uplinkng/ulext"
 	"storj.io/storj/cmd/uplinkng/ulfs"
@@ -23,6 +24,8 @@ type cmdLs struct {
 	pending   bool
 	utc       bool
 
+	objectFilter
+
 	prefix *ulloc.Location
 }
 
@@ -46,6 +49,8 @@ func (c *cmdLs) Setup(params clingy.Parameters) {
 		clingy.Transform(strconv.ParseBool),
 	).(bool)
 
+	c.objectFilter.Setup(params)
+
 	c.prefix = params.Arg("prefix", "Prefix to list (sj://BUCKET[/KEY])", clingy.Optional,
 		clingy.Transform(ulloc.Parse),
 	).(*ulloc.Location)
@@ -58,33 +63,54 @@ func (c *cmdLs) Execute(ctx clingy.Context) error {
 	return c.listLocation(ctx, *c.prefix)
 }
 
-func (c *cmdLs) listBuckets(ctx clingy.Context) error {
+// lsBucket is the result written for every bucket.
+type lsBucket struct {
+	Name    string    `json:"name"`
+	Created time.Time `json:"created"`
+}
+
+// lsObject is the result written for every object or prefix. Prefixes have no
+// creation time or size.
+type lsObject struct {
+	Kind    string     `json:"kind"`
+	Key     string     `json:"key"`
+	Created *time.Time `json:"created,omitempty"`
+	Size    *int64     `json:"size,omitempty"`
+}
+
+func (c *cmdLs) listBuckets(ctx clingy.Context) (err error) {
 	project, err := c.ex.OpenProject(ctx, c.access)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = project.Close() }()
 
-	tw := newTabbedWriter(ctx.Stdout(), "CREATED", "NAME")
-	defer tw.Done()
+	rw := newResultWriter(ctx.Stdout(), c.ex.OutputFormat(), "CREATED", "NAME")
+	defer func() { err = errs.Combine(err, rw.Done()) }()
 
 	iter := project.ListBuckets(ctx, nil)
 	for iter.Next() {
 		item := iter.Item()
-		tw.WriteLine(formatTime(c.utc, item.Created), item.Name)
+		rw.Write(lsBucket{Name: item.Name, Created: item.Created.UTC()},
+			formatTime(c.utc, item.Created), item.Name)
 	}
 	return iter.Err()
 }
 
-func (c *cmdLs) listLocation(ctx clingy.Context, prefix ulloc.Location) error {
+func (c *cmdLs) listLocation(ctx clingy.Context, prefix ulloc.Location) (err error) {
+	filter, err := c.filter()
+	if err != nil {
+		return err
+	}
+
 	fs, err := c.ex.OpenFilesystem(ctx, c.access, ulext.BypassEncryption(c.encrypted))
 	if err != nil {
 		return err
 	}
 	defer func() { _ = fs.Close() }()
 
-	tw := newTabbedWriter(ctx.Stdout(), "KIND", "CREATED", "SIZE", "KEY")
-	defer tw.Done()
+	rw := newResultWriter(ctx.Stdout(), c.ex.OutputFormat(), "KIND", "CREATED", "SIZE", "KEY")
+	defer func() { err = errs.Combine(err, rw.Done()) }()
 
 	// create the object iterator of either existing objects or pending multipart uploads
 	var iter ulfs.ObjectIterator
@@ -96,14 +122,18 @@ func (c *cmdLs) listLocation(ctx clingy.Context, prefix ulloc.Location) error {
 	if err != nil {
 		return err
 	}
+	iter = ulfs.FilterObjects(iter, prefix, filter)
 
 	// iterate and print the results
 	for iter.Next() {
 		obj := iter.Item()
 		if obj.IsPrefix {
-			tw.WriteLine("PRE", "", "", obj.Loc.Key())
+			rw.Write(lsObject{Kind: "prefix", Key: obj.Loc.Key()},
+				"PRE", "", "", obj.Loc.Key())
 		} else {
-			tw.WriteLine("OBJ", formatTime(c.utc, obj.Created), obj.ContentLength, obj.Loc.Key())
+			created, size := obj.Created.UTC(), obj.ContentLength
+			rw.Write(lsObject{Kind: "object", Key: obj.Loc.Key(), Created: &created, Size: &size},
+				"OBJ", formatTime(c.utc, obj.Created), obj.ContentLength, obj.Loc.Key())
 		}
 	}
 	return iter.Err()
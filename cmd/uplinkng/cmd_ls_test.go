@@ -6,6 +6,7 @@ package main
 import (
 	"testing"
 
+	"storj.io/storj/cmd/uplinkng/ulext"
 	"storj.io/storj/cmd/uplinkng/ultest"
 )
 
@@ -281,3 +282,51 @@ func TestLsDifficult(t *testing.T) {
 		`)
 	})
 }
+
+func TestLsFilters(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/file1.tmp"),
+		ultest.WithFile("sj://user/dir/file2.txt"),
+		ultest.WithFile("sj://user/file3.txt"),
+		ultest.WithFile("sj://user/file4.tmp"),
+	)
+
+	state.Succeed(t, "ls", "sj://user/", "-r", "--exclude-regex", `\.tmp$`, "--newer-than", "1970-01-01T00:00:02Z").RequireStdout(t, `
+		KIND    CREATED                SIZE    KEY
+		OBJ     1970-01-01 00:00:03    0       file3.txt
+	`)
+
+	state.Succeed(t, "ls", "sj://user/", "-r", "--include", "dir/*").RequireStdout(t, `
+		KIND    CREATED                SIZE    KEY
+		OBJ     1970-01-01 00:00:02    0       dir/file2.txt
+	`)
+
+	state.Succeed(t, "ls", "sj://user/dir/", "-r", "--include", "file2.*").RequireStdout(t, `
+		KIND    CREATED                SIZE    KEY
+		OBJ     1970-01-01 00:00:02    0       dir/file2.txt
+	`)
+
+	state.Succeed(t, "ls", "sj://user/dir/", "-r", "--include", "dir/*").RequireStdout(t, ``)
+}
+
+func TestLsOutput(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/dir/file1.txt"),
+		ultest.WithFile("sj://user/file2.txt"),
+	)
+
+	state.Succeed(t, "ls", "sj://user/", "--output", "json").RequireStdout(t, `
+		[{"kind":"prefix","key":"dir/"},{"kind":"object","key":"file2.txt","created":"1970-01-01T00:00:02Z","size":0}]
+	`)
+
+	state.Succeed(t, "ls", "sj://user/", "--recursive", "--output", "ndjson").RequireStdout(t, `
+		{"kind":"object","key":"dir/file1.txt","created":"1970-01-01T00:00:01Z","size":0}
+		{"kind":"object","key":"file2.txt","created":"1970-01-01T00:00:02Z","size":0}
+	`)
+
+	state.Succeed(t, "ls", "sj://user/missing/", "--output", "json").RequireStdout(t, `
+		[]
+	`)
+
+	state.Fail(t, "ls", "sj://user/", "--output", "yaml").RequireExitCode(t, ulext.ExitUsage)
+}
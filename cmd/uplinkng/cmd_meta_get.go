@@ -6,6 +6,7 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"strconv"
 
 	"github.com/zeebo/clingy"
@@ -13,6 +14,7 @@ import (
 
 	"storj.io/storj/cmd/uplinkng/ulext"
 	"storj.io/storj/cmd/uplinkng/ulloc"
+	"storj.io/uplink"
 )
 
 type cmdMetaGet struct {
@@ -64,20 +66,33 @@ func (c *cmdMetaGet) Execute(ctx clingy.Context) error {
 			return errs.New("entry %q does not exist", *c.entry)
 		}
 
-		fmt.Fprintln(ctx.Stdout(), value)
-		return nil
+		if c.ex.OutputFormat() == ulext.OutputText {
+			fmt.Fprintln(ctx.Stdout(), value)
+			return nil
+		}
+		return writeJSON(ctx.Stdout(), value, false)
 	}
 
-	if object.Custom == nil {
-		fmt.Fprintln(ctx.Stdout(), "{}")
-		return nil
+	custom := object.Custom
+	if custom == nil {
+		custom = uplink.CustomMetadata{}
 	}
+	return writeJSON(ctx.Stdout(), custom, c.ex.OutputFormat() == ulext.OutputText)
+}
 
-	data, err := json.MarshalIndent(object.Custom, "", "  ")
+// writeJSON writes the value as a single line of JSON, or indented when indent is true.
+func writeJSON(w io.Writer, value interface{}, indent bool) error {
+	var data []byte
+	var err error
+	if indent {
+		data, err = json.MarshalIndent(value, "", "  ")
+	} else {
+		data, err = json.Marshal(value)
+	}
 	if err != nil {
 		return errs.Wrap(err)
 	}
 
-	fmt.Fprintln(ctx.Stdout(), string(data))
+	fmt.Fprintln(w, string(data))
 	return nil
 }
@@ -0,0 +1,130 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplinkng/ulext"
+	"storj.io/storj/cmd/uplinkng/ulfs"
+	"storj.io/storj/cmd/uplinkng/ulloc"
+)
+
+type cmdMv struct {
+	ex ulext.External
+
+	access    string
+	recursive bool
+	dryrun    bool
+
+	source ulloc.Location
+	dest   ulloc.Location
+}
+
+func newCmdMv(ex ulext.External) *cmdMv {
+	return &cmdMv{ex: ex}
+}
+
+func (c *cmdMv) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Which access to use", "").(string)
+	c.recursive = params.Flag("recursive", "Move all objects or files under the specified prefix", false,
+		clingy.Short('r'),
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+	c.dryrun = params.Flag("dryrun", "Print what operations would happen but don't execute them", false,
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+
+	c.source = params.Arg("source", "Source to move", clingy.Transform(ulloc.Parse)).(ulloc.Location)
+	c.dest = params.Arg("dest", "Destination to move", clingy.Transform(ulloc.Parse)).(ulloc.Location)
+}
+
+func (c *cmdMv) Execute(ctx clingy.Context) (err error) {
+	if c.source.Std() || c.dest.Std() {
+		return errs.New("cannot move to or from stdin/stdout")
+	}
+	if c.source.Remote() != c.dest.Remote() {
+		return errs.New("moving objects between local and remote is not supported")
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	rw := newResultWriter(ctx.Stdout(), c.ex.OutputFormat())
+	defer func() { err = errs.Combine(err, rw.Done()) }()
+
+	if c.recursive {
+		return c.moveRecursive(ctx, fs, rw)
+	}
+	return c.moveFile(ctx, fs, rw, c.source, c.dest)
+}
+
+func (c *cmdMv) moveRecursive(ctx clingy.Context, fs ulfs.Filesystem, rw *resultWriter) error {
+	iter, err := fs.ListObjects(ctx, c.source, true)
+	if err != nil {
+		return err
+	}
+
+	anyFailed := false
+	for iter.Next() {
+		rel, err := c.source.RelativeTo(iter.Item().Loc)
+		if err != nil {
+			return err
+		}
+
+		source := iter.Item().Loc
+		dest := c.dest.AppendKey(rel)
+
+		if err := c.moveFile(ctx, fs, rw, source, dest); err != nil {
+			if rw.Text() {
+				fmt.Fprintln(ctx.Stderr(), "move", source, "failed:", err.Error())
+			}
+			anyFailed = true
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return errs.Wrap(err)
+	} else if anyFailed {
+		return errs.New("some moves failed")
+	}
+	return nil
+}
+
+func (c *cmdMv) moveFile(ctx clingy.Context, fs ulfs.Filesystem, rw *resultWriter, source, dest ulloc.Location) (err error) {
+	if isDir := fs.IsLocalDir(ctx, dest); isDir {
+		base, ok := source.Base()
+		if !ok {
+			return errs.New("destination is a directory and cannot find base name for %q", source)
+		}
+		dest = dest.AppendKey(base)
+	}
+
+	result := cpResult{Action: "move", Source: source.String(), Dest: dest.String()}
+	if rw.Text() {
+		rw.Write(result, result.Action, source, "to", dest)
+	} else {
+		defer func() {
+			if err == nil {
+				rw.Write(result)
+			} else if c.recursive {
+				result.Error = err.Error()
+				rw.Write(result)
+			}
+		}()
+	}
+
+	if c.dryrun {
+		return nil
+	}
+
+	return fs.Move(ctx, source, dest)
+}
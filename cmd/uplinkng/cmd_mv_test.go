@@ -0,0 +1,75 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+
+	"storj.io/storj/cmd/uplinkng/ultest"
+)
+
+func TestMvRemote(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/file1.txt", "remote"),
+		ultest.WithFile("/home/user/file1.txt", "local"),
+	)
+
+	state.Succeed(t, "mv", "sj://user/file1.txt", "sj://user/file2.txt").RequireFiles(t,
+		ultest.File{Loc: "sj://user/file2.txt", Contents: "remote"},
+		ultest.File{Loc: "/home/user/file1.txt", Contents: "local"},
+	)
+}
+
+func TestMvRemoteOtherBucket(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/file1.txt", "remote"),
+		ultest.WithBucket("other"),
+	)
+
+	state.Succeed(t, "mv", "sj://user/file1.txt", "sj://other/file1.txt").RequireFiles(t,
+		ultest.File{Loc: "sj://other/file1.txt", Contents: "remote"},
+	)
+}
+
+func TestMvRemoteRecursive(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/folder1/file1.txt", "data1"),
+		ultest.WithFile("sj://user/folder1/folder2/file2.txt", "data2"),
+		ultest.WithFile("sj://user/other.txt", "data3"),
+	)
+
+	state.Succeed(t, "mv", "sj://user/folder1/", "sj://user/moved", "-r").RequireFiles(t,
+		ultest.File{Loc: "sj://user/moved/file1.txt", Contents: "data1"},
+		ultest.File{Loc: "sj://user/moved/folder2/file2.txt", Contents: "data2"},
+		ultest.File{Loc: "sj://user/other.txt", Contents: "data3"},
+	)
+}
+
+func TestMvLocal(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/file1.txt", "local"),
+	)
+
+	state.Succeed(t, "mv", "/home/user/file1.txt", "/home/user/file2.txt").RequireFiles(t,
+		ultest.File{Loc: "/home/user/file2.txt", Contents: "local"},
+	)
+}
+
+func TestMvDryrun(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/file1.txt", "remote"),
+	)
+
+	state.Succeed(t, "mv", "sj://user/file1.txt", "sj://user/file2.txt", "--dryrun").RequireFiles(t,
+		ultest.File{Loc: "sj://user/file1.txt", Contents: "remote"},
+	)
+}
+
+func TestMvBetweenLocalAndRemote(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/file1.txt", "remote"),
+	)
+
+	state.Fail(t, "mv", "sj://user/file1.txt", "/home/user/file1.txt")
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/cmd/uplinkng/ulext"
+	"storj.io/storj/cmd/uplinkng/ulfs"
 	"storj.io/storj/cmd/uplinkng/ulloc"
 )
 
@@ -21,6 +22,8 @@ type cmdRm struct {
 	recursive bool
 	encrypted bool
 
+	objectFilter
+
 	location ulloc.Location
 }
 
@@ -38,24 +41,43 @@ func (c *cmdRm) Setup(params clingy.Parameters) {
 		clingy.Transform(strconv.ParseBool),
 	).(bool)
 
+	c.objectFilter.Setup(params)
+
 	c.location = params.Arg("location", "Location to remove (sj://BUCKET[/KEY])",
 		clingy.Transform(ulloc.Parse),
 	).(ulloc.Location)
 }
 
-func (c *cmdRm) Execute(ctx clingy.Context) error {
+// rmResult is the result written for every removal. Failed removals have the error
+// and are only written when the output isn't text.
+type rmResult struct {
+	Location string `json:"location"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (c *cmdRm) Execute(ctx clingy.Context) (err error) {
+	filter, err := c.filter()
+	if err != nil {
+		return err
+	} else if !c.recursive && !filter.IsZero() {
+		return errs.New("filters can only be used with --recursive")
+	}
+
 	fs, err := c.ex.OpenFilesystem(ctx, c.access, ulext.BypassEncryption(c.encrypted))
 	if err != nil {
 		return err
 	}
 	defer func() { _ = fs.Close() }()
 
+	rw := newResultWriter(ctx.Stdout(), c.ex.OutputFormat())
+	defer func() { err = errs.Combine(err, rw.Done()) }()
+
 	if !c.recursive {
 		if err := fs.Remove(ctx, c.location); err != nil {
 			return err
 		}
 
-		fmt.Fprintln(ctx.Stdout(), "removed", c.location)
+		rw.Write(rmResult{Location: c.location.String()}, "removed", c.location)
 		return nil
 	}
 
@@ -63,16 +85,21 @@ func (c *cmdRm) Execute(ctx clingy.Context) error {
 	if err != nil {
 		return err
 	}
+	iter = ulfs.FilterObjects(iter, c.location, filter)
 
 	anyFailed := false
 	for iter.Next() {
 		loc := iter.Item().Loc
 
 		if err := fs.Remove(ctx, loc); err != nil {
-			fmt.Fprintln(ctx.Stderr(), "remove", loc, "failed:", err.Error())
+			if rw.Text() {
+				fmt.Fprintln(ctx.Stderr(), "remove", loc, "failed:", err.Error())
+			} else {
+				rw.Write(rmResult{Location: loc.String(), Error: err.Error()})
+			}
 			anyFailed = true
 		} else {
-			fmt.Fprintln(ctx.Stdout(), "removed", loc)
+			rw.Write(rmResult{Location: loc.String()}, "removed", loc)
 		}
 	}
 
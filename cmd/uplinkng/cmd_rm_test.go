@@ -6,6 +6,7 @@ package main
 import (
 	"testing"
 
+	"storj.io/storj/cmd/uplinkng/ulext"
 	"storj.io/storj/cmd/uplinkng/ultest"
 )
 
@@ -70,3 +71,36 @@ func TestRmLocalRecursive(t *testing.T) {
 		ultest.File{Loc: "/home/user/other_file1.txt"},
 	)
 }
+
+func TestRmRecursiveFilters(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/file1.tmp"),
+		ultest.WithFile("sj://user/dir/file2.tmp"),
+		ultest.WithFile("sj://user/dir/file3.txt"),
+		ultest.WithFile("sj://user/file4.tmp"),
+	)
+
+	state.Fail(t, "rm", "sj://user/file1.tmp", "--include", "*.tmp")
+	state.Fail(t, "rm", "sj://user/", "-r", "--include-regex", "(")
+
+	state.Succeed(t, "rm", "sj://user/", "-r", "--include", "*.tmp", "--older-than", "1970-01-01T00:00:04Z").RequireFiles(t,
+		ultest.File{Loc: "sj://user/dir/file3.txt"},
+		ultest.File{Loc: "sj://user/file4.tmp"},
+	)
+}
+
+func TestRmOutput(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/file1.txt"),
+		ultest.WithFile("sj://user/file2.txt"),
+	)
+
+	state.Succeed(t, "rm", "sj://user/", "--recursive", "--output", "json").RequireStdout(t, `
+		[{"location":"sj://user/file1.txt"},{"location":"sj://user/file2.txt"}]
+	`).RequireExitCode(t, ulext.ExitSuccess)
+
+	state.Fail(t, "rm", "sj://user/file1.txt", "--include", "*.txt", "--output", "ndjson").RequireStdout(t, `
+	`).RequireStderr(t, `
+		{"error":"filters can only be used with --recursive"}
+	`).RequireExitCode(t, ulext.ExitFailure)
+}
@@ -0,0 +1,326 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplinkng/ulext"
+	"storj.io/storj/cmd/uplinkng/ulfs"
+	"storj.io/storj/cmd/uplinkng/ulloc"
+)
+
+type cmdSync struct {
+	ex ulext.External
+
+	access   string
+	delete   bool
+	checksum bool
+	dryrun   bool
+
+	objectFilter
+
+	source ulloc.Location
+	dest   ulloc.Location
+}
+
+func newCmdSync(ex ulext.External) *cmdSync {
+	return &cmdSync{ex: ex}
+}
+
+func (c *cmdSync) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Which access to use", "").(string)
+	c.delete = params.Flag("delete", "Delete files or objects from the destination that are not in the source", false,
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+	c.checksum = params.Flag("checksum", "Compare the SHA-256 of the contents instead of the modification time", false,
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+	c.dryrun = params.Flag("dryrun", "Print what operations would happen but don't execute them", false,
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+	c.objectFilter.Setup(params)
+
+	c.source = params.Arg("source", "Source to synchronize from", clingy.Transform(ulloc.Parse)).(ulloc.Location)
+	c.dest = params.Arg("dest", "Destination to synchronize to", clingy.Transform(ulloc.Parse)).(ulloc.Location)
+}
+
+func (c *cmdSync) Execute(ctx clingy.Context) (err error) {
+	if c.source.Std() || c.dest.Std() {
+		return errs.New("cannot synchronize to or from stdin/stdout")
+	}
+	filter, err := c.filter()
+	if err != nil {
+		return err
+	}
+
+	source, err := syncDir(c.source)
+	if err != nil {
+		return err
+	}
+	dest, err := syncDir(c.dest)
+	if err != nil {
+		return err
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	sources, err := listRelative(ctx, fs, source)
+	if err != nil {
+		return err
+	}
+	dests, err := listRelative(ctx, fs, dest)
+	if err != nil {
+		return err
+	}
+
+	rw := newResultWriter(ctx.Stdout(), c.ex.OutputFormat())
+	defer func() { err = errs.Combine(err, rw.Done()) }()
+
+	var summary syncSummary
+
+	for _, rel := range sortedKeys(sources) {
+		src := sources[rel]
+		if !filter.Matches(rel, src) {
+			continue
+		}
+
+		dst, exists := dests[rel]
+		destLoc := dest.AppendKey(rel)
+
+		result := cpResult{Action: copyVerb(src.Loc, destLoc), Source: src.Loc.String(), Dest: destLoc.String()}
+
+		hash, upToDate, err := c.upToDate(ctx, fs, src, dst, exists)
+		if err != nil {
+			if rw.Text() {
+				fmt.Fprintln(ctx.Stderr(), "compare", src.Loc, "to", destLoc, "failed:", err.Error())
+			} else {
+				result.Error = err.Error()
+				rw.Write(result)
+			}
+			summary.failed++
+			continue
+		} else if upToDate {
+			summary.upToDate++
+			continue
+		}
+
+		if rw.Text() {
+			rw.Write(result, result.Action, src.Loc, "to", destLoc)
+		}
+		if !c.dryrun {
+			if err := c.copy(ctx, fs, src, destLoc, hash); err != nil {
+				if rw.Text() {
+					fmt.Fprintln(ctx.Stderr(), result.Action, "failed:", err.Error())
+				} else {
+					result.Error = err.Error()
+					rw.Write(result)
+				}
+				summary.failed++
+				continue
+			}
+		}
+		if !rw.Text() {
+			rw.Write(result)
+		}
+		summary.copied++
+	}
+
+	if c.delete {
+		for _, rel := range sortedKeys(dests) {
+			if _, ok := sources[rel]; ok || !filter.Matches(rel, dests[rel]) {
+				continue
+			}
+
+			loc := dests[rel].Loc
+			result := cpResult{Action: "remove", Dest: loc.String()}
+
+			if rw.Text() {
+				rw.Write(result, result.Action, loc)
+			}
+			if !c.dryrun {
+				if err := fs.Remove(ctx, loc); err != nil {
+					if rw.Text() {
+						fmt.Fprintln(ctx.Stderr(), "remove", loc, "failed:", err.Error())
+					} else {
+						result.Error = err.Error()
+						rw.Write(result)
+					}
+					summary.failed++
+					continue
+				}
+			}
+			if !rw.Text() {
+				rw.Write(result)
+			}
+			summary.removed++
+		}
+	}
+
+	if rw.Text() {
+		fmt.Fprintf(ctx.Stdout(), "%d copied, %d up to date, %d removed, %d failed\n",
+			summary.copied, summary.upToDate, summary.removed, summary.failed)
+	}
+
+	if summary.failed > 0 {
+		return errs.New("some operations failed")
+	}
+	return nil
+}
+
+// upToDate returns true if the destination doesn't have to be copied again. The
+// hash of the source is returned when it is compared by checksum.
+func (c *cmdSync) upToDate(ctx clingy.Context, fs ulfs.Filesystem, src, dst ulfs.ObjectInfo, exists bool) (hash string, upToDate bool, err error) {
+	if c.checksum {
+		hash, err = contentHash(ctx, fs, src)
+		if err != nil {
+			return "", false, err
+		}
+	}
+
+	if !exists || src.ContentLength != dst.ContentLength {
+		return hash, false, nil
+	}
+
+	if c.checksum {
+		dstHash, err := contentHash(ctx, fs, dst)
+		if err != nil {
+			return "", false, err
+		}
+		return hash, hash == dstHash, nil
+	}
+
+	// modification times are compared with a second precision because not every
+	// filesystem keeps more.
+	return hash, modifiedTime(src).Truncate(time.Second).Equal(modifiedTime(dst).Truncate(time.Second)), nil
+}
+
+// copy copies the source to dest keeping the modification time of the source.
+func (c *cmdSync) copy(ctx clingy.Context, fs ulfs.Filesystem, src ulfs.ObjectInfo, dest ulloc.Location, hash string) error {
+	rh, err := fs.Open(ctx, src.Loc)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rh.Close() }()
+
+	wh, err := fs.Create(ctx, dest, &ulfs.CreateOptions{
+		Modified: modifiedTime(src),
+		Hash:     hash,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = wh.Abort() }()
+
+	if _, err := io.Copy(wh, rh); err != nil {
+		return errs.Combine(err, wh.Abort())
+	}
+	return errs.Wrap(wh.Commit())
+}
+
+type syncSummary struct {
+	copied   int
+	upToDate int
+	removed  int
+	failed   int
+}
+
+// syncDir returns the location as a directory or prefix that keys are relative to.
+func syncDir(loc ulloc.Location) (ulloc.Location, error) {
+	if loc.Remote() {
+		key := loc.Key()
+		if key != "" && !strings.HasSuffix(key, "/") {
+			key += "/"
+		}
+		return loc.SetKey(key), nil
+	}
+
+	abs, err := filepath.Abs(loc.Key())
+	if err != nil {
+		return ulloc.Location{}, errs.Wrap(err)
+	}
+	if !strings.HasSuffix(abs, string(filepath.Separator)) {
+		abs += string(filepath.Separator)
+	}
+	return loc.SetKey(abs), nil
+}
+
+// listRelative returns the objects under dir keyed by their key relative to dir.
+func listRelative(ctx clingy.Context, fs ulfs.Filesystem, dir ulloc.Location) (map[string]ulfs.ObjectInfo, error) {
+	iter, err := fs.ListObjects(ctx, dir, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// listed local keys always use forward slashes.
+	prefix := dir.Key()
+	if dir.Local() {
+		prefix = filepath.ToSlash(prefix)
+	}
+
+	infos := make(map[string]ulfs.ObjectInfo)
+	for iter.Next() {
+		item := iter.Item()
+		if item.IsPrefix || !strings.HasPrefix(item.Loc.Key(), prefix) {
+			continue
+		}
+		if rel := item.Loc.Key()[len(prefix):]; rel != "" {
+			infos[rel] = item
+		}
+	}
+	return infos, errs.Wrap(iter.Err())
+}
+
+// contentHash returns the hex encoded SHA-256 of the contents of the object, reading
+// the contents when the hash isn't recorded.
+func contentHash(ctx clingy.Context, fs ulfs.Filesystem, info ulfs.ObjectInfo) (string, error) {
+	if info.Hash != "" {
+		return info.Hash, nil
+	}
+
+	rh, err := fs.Open(ctx, info.Loc)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = rh.Close() }()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, rh); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// modifiedTime returns the modification time of the object, which is the creation
+// time when it isn't recorded.
+func modifiedTime(info ulfs.ObjectInfo) time.Time {
+	if !info.Modified.IsZero() {
+		return info.Modified
+	}
+	return info.Created
+}
+
+func sortedKeys(infos map[string]ulfs.ObjectInfo) []string {
+	keys := make([]string, 0, len(infos))
+	for key := range infos {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
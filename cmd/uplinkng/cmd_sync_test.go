@@ -0,0 +1,101 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"storj.io/storj/cmd/uplinkng/ultest"
+)
+
+func TestSyncUpload(t *testing.T) {
+	modified := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	state := ultest.Setup(commands,
+		ultest.WithModifiedFile("/home/user/same.txt", modified, "same"),
+		ultest.WithModifiedFile("/home/user/folder/changed.txt", modified, "new"),
+		ultest.WithModifiedFile("/home/user/new.txt", modified, "new"),
+		ultest.WithModifiedFile("/home/user/new.tmp", modified, "tmp"),
+
+		ultest.WithModifiedFile("sj://user/dest/same.txt", modified, "same"),
+		ultest.WithModifiedFile("sj://user/dest/folder/changed.txt", modified.Add(-time.Hour), "old"),
+		ultest.WithModifiedFile("sj://user/dest/extra.txt", modified, "extra"),
+	)
+
+	state.Succeed(t, "sync", "/home/user", "sj://user/dest", "--exclude", "*.tmp").RequireStdout(t, `
+		upload /home/user/folder/changed.txt to sj://user/dest/folder/changed.txt
+		upload /home/user/new.txt to sj://user/dest/new.txt
+		2 copied, 1 up to date, 0 removed, 0 failed
+	`).RequireFiles(t,
+		ultest.File{Loc: "/home/user/same.txt", Contents: "same"},
+		ultest.File{Loc: "/home/user/folder/changed.txt", Contents: "new"},
+		ultest.File{Loc: "/home/user/new.txt", Contents: "new"},
+		ultest.File{Loc: "/home/user/new.tmp", Contents: "tmp"},
+
+		ultest.File{Loc: "sj://user/dest/same.txt", Contents: "same"},
+		ultest.File{Loc: "sj://user/dest/folder/changed.txt", Contents: "new"},
+		ultest.File{Loc: "sj://user/dest/new.txt", Contents: "new"},
+		ultest.File{Loc: "sj://user/dest/extra.txt", Contents: "extra"},
+	)
+
+	state.Succeed(t, "sync", "/home/user", "sj://user/dest", "--include", "folder/*", "--delete").RequireStdout(t, `
+		upload /home/user/folder/changed.txt to sj://user/dest/folder/changed.txt
+		1 copied, 0 up to date, 0 removed, 0 failed
+	`)
+
+	state.Succeed(t, "sync", "/home/user", "sj://user/dest", "--delete", "--dryrun").RequireStdout(t, `
+		upload /home/user/folder/changed.txt to sj://user/dest/folder/changed.txt
+		upload /home/user/new.tmp to sj://user/dest/new.tmp
+		upload /home/user/new.txt to sj://user/dest/new.txt
+		remove sj://user/dest/extra.txt
+		3 copied, 1 up to date, 1 removed, 0 failed
+	`).RequireFiles(t,
+		ultest.File{Loc: "/home/user/same.txt", Contents: "same"},
+		ultest.File{Loc: "/home/user/folder/changed.txt", Contents: "new"},
+		ultest.File{Loc: "/home/user/new.txt", Contents: "new"},
+		ultest.File{Loc: "/home/user/new.tmp", Contents: "tmp"},
+
+		ultest.File{Loc: "sj://user/dest/same.txt", Contents: "same"},
+		ultest.File{Loc: "sj://user/dest/folder/changed.txt", Contents: "old"},
+		ultest.File{Loc: "sj://user/dest/extra.txt", Contents: "extra"},
+	)
+}
+
+func TestSyncChecksum(t *testing.T) {
+	modified := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// the modification times differ, but only the contents of changed.txt do.
+	state := ultest.Setup(commands,
+		ultest.WithModifiedFile("sj://user/src/same.txt", modified, "same"),
+		ultest.WithModifiedFile("sj://user/src/changed.txt", modified, "new"),
+
+		ultest.WithModifiedFile("/home/user/same.txt", modified.Add(time.Hour), "same"),
+		ultest.WithModifiedFile("/home/user/changed.txt", modified.Add(time.Hour), "old"),
+	)
+
+	state.Succeed(t, "sync", "sj://user/src", "/home/user", "--checksum").RequireStdout(t, `
+		download sj://user/src/changed.txt to /home/user/changed.txt
+		1 copied, 1 up to date, 0 removed, 0 failed
+	`).RequireFiles(t,
+		ultest.File{Loc: "sj://user/src/same.txt", Contents: "same"},
+		ultest.File{Loc: "sj://user/src/changed.txt", Contents: "new"},
+
+		ultest.File{Loc: "/home/user/same.txt", Contents: "same"},
+		ultest.File{Loc: "/home/user/changed.txt", Contents: "new"},
+	)
+
+	state.Succeed(t, "sync", "sj://user/src", "/home/user").RequireStdout(t, `
+		download sj://user/src/changed.txt to /home/user/changed.txt
+		download sj://user/src/same.txt to /home/user/same.txt
+		2 copied, 0 up to date, 0 removed, 0 failed
+	`)
+}
+
+func TestSyncErrors(t *testing.T) {
+	state := ultest.Setup(commands)
+
+	state.Fail(t, "sync", "-", "sj://user/dest")
+	state.Fail(t, "sync", "/home/user", "sj://user/dest", "--include", "[")
+}
@@ -13,10 +13,13 @@ import (
 
 	"github.com/zeebo/clingy"
 	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplinkng/ulext"
 )
 
 type external struct {
-	interactive bool // controls if interactive input is allowed
+	interactive bool               // controls if interactive input is allowed
+	output      ulext.OutputFormat // the format that results and errors are written in
 
 	dirs struct {
 		loaded  bool   // true if Setup has been called
@@ -52,6 +55,12 @@ func (ex *external) Setup(f clingy.Flags) {
 		clingy.Advanced,
 	).(bool)
 
+	ex.output = f.Flag(
+		"output", "Format of the results and errors: text, json or ndjson", ulext.OutputText,
+		clingy.Transform(ulext.ParseOutputFormat),
+		clingy.Type("format"),
+	).(ulext.OutputFormat)
+
 	ex.dirs.current = f.Flag(
 		"config-dir", "Directory that stores the configuration",
 		appDir(false, "storj", "uplink"),
@@ -109,6 +118,9 @@ func (ex *external) Wrap(ctx clingy.Context, cmd clingy.Command) error {
 	return cmd.Execute(ctx)
 }
 
+// OutputFormat returns the format that commands write their results and errors in.
+func (ex *external) OutputFormat() ulext.OutputFormat { return ex.output }
+
 // PromptInput gets a line of input text from the user and returns an error if
 // interactive mode is disabled.
 func (ex *external) PromptInput(ctx clingy.Context, prompt string) (input string, err error) {
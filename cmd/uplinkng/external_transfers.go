@@ -0,0 +1,67 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/zeebo/errs"
+)
+
+// transferFile returns the file that stores the state of the transfer identified by key.
+func (ex *external) transferFile(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(ex.dirs.current, "transfers", hex.EncodeToString(sum[:])+".json")
+}
+
+// LoadTransferState loads the state of the transfer identified by key into state. It
+// returns false if there is no state for the transfer.
+func (ex *external) LoadTransferState(key string, state interface{}) (bool, error) {
+	data, err := ioutil.ReadFile(ex.transferFile(key))
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, errs.Wrap(err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return false, errs.Wrap(err)
+	}
+	return true, nil
+}
+
+// SaveTransferState writes out the state of the transfer identified by key.
+func (ex *external) SaveTransferState(key string, state interface{}) error {
+	data, err := json.MarshalIndent(state, "", "\t")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	path := ex.transferFile(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errs.Wrap(err)
+	}
+
+	// write to a temporary file first so that an interruption doesn't leave a
+	// truncated state behind.
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(os.Rename(tmp, path))
+}
+
+// RemoveTransferState removes the state of the transfer identified by key. It is not
+// an error if there is no state for the transfer.
+func (ex *external) RemoveTransferState(key string) error {
+	if err := os.Remove(ex.transferFile(key)); err != nil && !os.IsNotExist(err) {
+		return errs.Wrap(err)
+	}
+	return nil
+}
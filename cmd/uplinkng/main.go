@@ -6,7 +6,6 @@ package main
 import (
 	"context"
 	"flag"
-	"fmt"
 	"os"
 
 	"github.com/zeebo/clingy"
@@ -28,10 +27,10 @@ func main() {
 		commands(cmds, ex)
 	})
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		ulext.WriteError(os.Stderr, ex.OutputFormat(), err)
 	}
-	if !ok || err != nil {
-		os.Exit(1)
+	if code := ulext.ExitCode(ok, err); code != ulext.ExitSuccess {
+		os.Exit(code)
 	}
 }
 
@@ -49,6 +48,8 @@ func commands(cmds clingy.Commands, ex ulext.External) {
 	cmds.New("rb", "Remove a bucket bucket", newCmdRb(ex))
 	cmds.New("cp", "Copies files or objects into or out of tardigrade", newCmdCp(ex))
 	cmds.New("ls", "Lists buckets, prefixes, or objects", newCmdLs(ex))
+	cmds.New("mv", "Moves files or objects", newCmdMv(ex))
+	cmds.New("sync", "Synchronizes files or objects from a source to a destination", newCmdSync(ex))
 	cmds.New("rm", "Remove an object", newCmdRm(ex))
 	cmds.Group("meta", "Object metadata related commands", func() {
 		cmds.New("get", "Get an object's metadata", newCmdMetaGet(ex))
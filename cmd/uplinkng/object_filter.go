@@ -0,0 +1,102 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/storj/cmd/uplinkng/ulfs"
+)
+
+// objectFilter holds flags and provides a Setup method for commands that select
+// the objects they operate on with filters.
+type objectFilter struct {
+	include       []string
+	exclude       []string
+	includeRegexp []string
+	excludeRegexp []string
+
+	minSize memory.Size
+	maxSize memory.Size
+
+	olderThan time.Time
+	newerThan time.Time
+}
+
+func (of *objectFilter) Setup(params clingy.Parameters) {
+	of.include = params.Flag("include", "Only select the keys matching the glob pattern", []string{},
+		clingy.Repeated).([]string)
+	of.exclude = params.Flag("exclude", "Don't select the keys matching the glob pattern", []string{},
+		clingy.Repeated).([]string)
+	of.includeRegexp = params.Flag("include-regex", "Only select the keys matching the regular expression", []string{},
+		clingy.Repeated).([]string)
+	of.excludeRegexp = params.Flag("exclude-regex", "Don't select the keys matching the regular expression", []string{},
+		clingy.Repeated).([]string)
+
+	of.minSize = params.Flag("min-size", "Only select objects at least this large", memory.Size(0),
+		clingy.Transform(parseSize), clingy.Type("size")).(memory.Size)
+	of.maxSize = params.Flag("max-size", "Only select objects at most this large", memory.Size(0),
+		clingy.Transform(parseSize), clingy.Type("size")).(memory.Size)
+
+	of.olderThan = params.Flag("older-than",
+		"Only select objects created before this age or time (e.g. '7d', '2h', '2020-01-02T15:04:05Z')",
+		time.Time{}, clingy.Transform(parseAge), clingy.Type("age")).(time.Time)
+	of.newerThan = params.Flag("newer-than",
+		"Only select objects created after this age or time (e.g. '7d', '2h', '2020-01-02T15:04:05Z')",
+		time.Time{}, clingy.Transform(parseAge), clingy.Type("age")).(time.Time)
+}
+
+// filter returns the ulfs.Filter for the flags.
+func (of *objectFilter) filter() (*ulfs.Filter, error) {
+	filter := &ulfs.Filter{
+		Include:       of.include,
+		Exclude:       of.exclude,
+		MinSize:       of.minSize.Int64(),
+		MaxSize:       of.maxSize.Int64(),
+		CreatedBefore: of.olderThan,
+		CreatedAfter:  of.newerThan,
+	}
+
+	for _, expr := range of.includeRegexp {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errs.New("invalid regular expression %q: %v", expr, err)
+		}
+		filter.IncludeRegexp = append(filter.IncludeRegexp, re)
+	}
+	for _, expr := range of.excludeRegexp {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errs.New("invalid regular expression %q: %v", expr, err)
+		}
+		filter.ExcludeRegexp = append(filter.ExcludeRegexp, re)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// parseAge returns the time that is the age ago, where the age is a duration that may
+// be in days, or the time itself.
+func parseAge(v string) (time.Time, error) {
+	if len(v) == 0 {
+		return time.Time{}, nil
+	} else if strings.HasSuffix(v, "d") {
+		if days, err := strconv.Atoi(v[:len(v)-1]); err == nil {
+			return time.Now().AddDate(0, 0, -days), nil
+		}
+	} else if d, err := time.ParseDuration(v); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	return parseRelativeTime(v)
+}
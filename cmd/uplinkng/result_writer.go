@@ -0,0 +1,84 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplinkng/ulext"
+)
+
+// resultWriter writes the results of a command in the output format. Text results
+// are written as a table when there are headers and as lines otherwise. JSON results
+// are collected and written as an array by Done, and NDJSON results are written one
+// per line as they happen. It is safe to write results concurrently.
+type resultWriter struct {
+	format ulext.OutputFormat
+	w      io.Writer
+
+	mu      sync.Mutex
+	tw      *tabbedWriter
+	results []interface{}
+	err     error
+}
+
+func newResultWriter(w io.Writer, format ulext.OutputFormat, headers ...string) *resultWriter {
+	rw := &resultWriter{
+		format:  format,
+		w:       w,
+		results: []interface{}{},
+	}
+	if format == ulext.OutputText && len(headers) > 0 {
+		rw.tw = newTabbedWriter(w, headers...)
+	}
+	return rw
+}
+
+// Text returns true if the results are written as text.
+func (rw *resultWriter) Text() bool { return rw.format == ulext.OutputText }
+
+// Write writes the result, or the columns of the result when the output is text.
+func (rw *resultWriter) Write(result interface{}, columns ...interface{}) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+
+	switch {
+	case rw.tw != nil:
+		rw.tw.WriteLine(columns...)
+	case rw.Text():
+		fmt.Fprintln(rw.w, columns...)
+	case rw.format == ulext.OutputNDJSON:
+		data, err := json.Marshal(result)
+		if err != nil {
+			rw.err = errs.Combine(rw.err, errs.Wrap(err))
+			return
+		}
+		fmt.Fprintln(rw.w, string(data))
+	default:
+		rw.results = append(rw.results, result)
+	}
+}
+
+// Done flushes any buffered results and returns any error encoding them.
+func (rw *resultWriter) Done() error {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+
+	if rw.tw != nil {
+		rw.tw.Done()
+	}
+	if rw.format == ulext.OutputJSON {
+		data, err := json.Marshal(rw.results)
+		if err != nil {
+			return errs.Combine(rw.err, errs.Wrap(err))
+		}
+		fmt.Fprintln(rw.w, string(data))
+	}
+	return rw.err
+}
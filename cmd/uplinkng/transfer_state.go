@@ -0,0 +1,40 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"time"
+
+	"storj.io/storj/cmd/uplinkng/ulloc"
+)
+
+// transferState is the progress of a resumable copy that is recorded so that an
+// interrupted copy can continue where it stopped.
+type transferState struct {
+	Source   string    `json:"source"`
+	Dest     string    `json:"dest"`
+	Length   int64     `json:"length"`
+	Created  time.Time `json:"created"`
+	PartSize int64     `json:"partSize"`
+
+	// ID identifies the pending multipart write of the destination.
+	ID string `json:"id"`
+	// Parts are the indexes of the parts that are committed.
+	Parts []int `json:"parts"`
+}
+
+// transferStateKey returns the key that identifies the transfer from source to dest.
+func transferStateKey(source, dest ulloc.Location) string {
+	return source.String() + " -> " + dest.String()
+}
+
+// matches returns true if the state is for the same source contents and the same
+// part size, ignoring the progress.
+func (state transferState) matches(other transferState) bool {
+	return state.Source == other.Source &&
+		state.Dest == other.Dest &&
+		state.Length == other.Length &&
+		state.Created.Equal(other.Created) &&
+		state.PartSize == other.PartSize
+}
@@ -23,6 +23,12 @@ type External interface {
 	SaveAccessInfo(defaultName string, accesses map[string]string) error
 
 	PromptInput(ctx clingy.Context, prompt string) (input string, err error)
+
+	OutputFormat() OutputFormat
+
+	LoadTransferState(key string, state interface{}) (bool, error)
+	SaveTransferState(key string, state interface{}) error
+	RemoveTransferState(key string) error
 }
 
 // Options contains all of the possible options for opening a filesystem or project.
@@ -0,0 +1,77 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ulext
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/zeebo/errs"
+)
+
+// OutputFormat is the format that commands write their results and errors in.
+type OutputFormat string
+
+const (
+	// OutputText writes results as tables or lines of text meant to be read by people.
+	OutputText OutputFormat = "text"
+
+	// OutputJSON writes the results of a command as a single JSON array once the command
+	// finishes, and errors as a JSON object with an "error" field.
+	OutputJSON OutputFormat = "json"
+
+	// OutputNDJSON writes every result as a JSON object on its own line as soon as it is
+	// available, and errors as a JSON object with an "error" field.
+	OutputNDJSON OutputFormat = "ndjson"
+)
+
+// ParseOutputFormat parses the name of an output format.
+func ParseOutputFormat(v string) (OutputFormat, error) {
+	switch format := OutputFormat(v); format {
+	case OutputText, OutputJSON, OutputNDJSON:
+		return format, nil
+	default:
+		return "", errs.New("invalid output format %q: must be one of text, json or ndjson", v)
+	}
+}
+
+// Exit codes returned by the uplink command.
+const (
+	// ExitSuccess is returned when the command succeeded.
+	ExitSuccess = 0
+
+	// ExitFailure is returned when the command ran and returned an error. Commands that
+	// operate on many objects return it if any of the operations failed.
+	ExitFailure = 1
+
+	// ExitUsage is returned when the command line could not be parsed. It is the same
+	// as ExitFailure because scripts already depend on uplink exiting with 1.
+	ExitUsage = 1
+)
+
+// ExitCode returns the exit code for the results of running a command with clingy.
+func ExitCode(ok bool, err error) int {
+	switch {
+	case !ok:
+		return ExitUsage
+	case err != nil:
+		return ExitFailure
+	default:
+		return ExitSuccess
+	}
+}
+
+// WriteError writes the error returned by a command to w in the output format.
+func WriteError(w io.Writer, format OutputFormat, err error) {
+	if format == OutputText {
+		fmt.Fprintf(w, "%+v\n", err)
+		return
+	}
+
+	data, _ := json.Marshal(struct {
+		Error string `json:"error"`
+	}{err.Error()})
+	fmt.Fprintln(w, string(data))
+}
@@ -21,17 +21,37 @@ import (
 type Filesystem interface {
 	Close() error
 	Open(ctx clingy.Context, loc ulloc.Location) (ReadHandle, error)
-	Create(ctx clingy.Context, loc ulloc.Location) (WriteHandle, error)
+	OpenRange(ctx clingy.Context, loc ulloc.Location, offset, length int64) (ReadHandle, error)
+	Stat(ctx context.Context, loc ulloc.Location) (ObjectInfo, error)
+	Create(ctx clingy.Context, loc ulloc.Location, opts *CreateOptions) (WriteHandle, error)
+	CreateMultipart(ctx clingy.Context, loc ulloc.Location) (MultiWriteHandle, error)
+	ResumeMultipart(ctx clingy.Context, loc ulloc.Location, id string) (MultiWriteHandle, error)
 	Remove(ctx context.Context, loc ulloc.Location) error
+	Move(ctx clingy.Context, source, dest ulloc.Location) error
 	ListObjects(ctx context.Context, prefix ulloc.Location, recursive bool) (ObjectIterator, error)
 	ListUploads(ctx context.Context, prefix ulloc.Location, recursive bool) (ObjectIterator, error)
 	IsLocalDir(ctx context.Context, loc ulloc.Location) bool
 }
 
+// CreateOptions contains the metadata that is stored with a created file or object.
+type CreateOptions struct {
+	// Modified is the modification time of the contents. It's ignored when zero.
+	Modified time.Time
+	// Hash is the hex encoded SHA-256 of the contents. It's only stored with
+	// remote objects and ignored when empty.
+	Hash string
+}
+
 //
 // object info
 //
 
+// The custom metadata keys that store the CreateOptions of remote objects.
+const (
+	MetadataModified = "mtime"
+	MetadataHash     = "sha256"
+)
+
 // ObjectInfo is a simpler *uplink.Object that contains the minimal information the
 // uplink command needs that multiple types can be converted to.
 type ObjectInfo struct {
@@ -39,16 +59,43 @@ type ObjectInfo struct {
 	IsPrefix      bool
 	Created       time.Time
 	ContentLength int64
+
+	// Modified and Hash are the values of the CreateOptions when they are known.
+	Modified time.Time
+	Hash     string
+
+	// UploadID is only set when listing pending uploads.
+	UploadID string
 }
 
 // uplinkObjectToObjectInfo returns an objectInfo converted from an *uplink.Object.
 func uplinkObjectToObjectInfo(bucket string, obj *uplink.Object) ObjectInfo {
-	return ObjectInfo{
+	info := ObjectInfo{
 		Loc:           ulloc.NewRemote(bucket, obj.Key),
 		IsPrefix:      obj.IsPrefix,
 		Created:       obj.System.Created,
 		ContentLength: obj.System.ContentLength,
+		Hash:          obj.Custom[MetadataHash],
+	}
+	if modified, err := time.Parse(time.RFC3339Nano, obj.Custom[MetadataModified]); err == nil {
+		info.Modified = modified
 	}
+	return info
+}
+
+// createOptionsToCustomMetadata returns the custom metadata that stores the options.
+func createOptionsToCustomMetadata(opts *CreateOptions) uplink.CustomMetadata {
+	custom := uplink.CustomMetadata{}
+	if opts == nil {
+		return custom
+	}
+	if !opts.Modified.IsZero() {
+		custom[MetadataModified] = opts.Modified.UTC().Format(time.RFC3339Nano)
+	}
+	if opts.Hash != "" {
+		custom[MetadataHash] = opts.Hash
+	}
+	return custom
 }
 
 // uplinkUploadInfoToObjectInfo returns an objectInfo converted from an *uplink.Object.
@@ -58,6 +105,7 @@ func uplinkUploadInfoToObjectInfo(bucket string, upl *uplink.UploadInfo) ObjectI
 		IsPrefix:      upl.IsPrefix,
 		Created:       upl.System.Created,
 		ContentLength: upl.System.ContentLength,
+		UploadID:      upl.UploadID,
 	}
 }
 
@@ -92,8 +140,9 @@ func (u *uplinkReadHandle) Info() ObjectInfo           { return uplinkObjectToOb
 
 // osReadHandle implements readHandle for *os.Files.
 type osReadHandle struct {
-	raw  *os.File
-	info ObjectInfo
+	raw   *os.File
+	limit io.Reader
+	info  ObjectInfo
 }
 
 // newOsReadHandle constructs an *osReadHandle from an *os.File.
@@ -109,13 +158,20 @@ func newOSReadHandle(fh *os.File) (*osReadHandle, error) {
 			IsPrefix:      false,
 			Created:       fi.ModTime(), // TODO: os specific crtime
 			ContentLength: fi.Size(),
+			Modified:      fi.ModTime(),
 		},
 	}, nil
 }
 
-func (o *osReadHandle) Read(p []byte) (int, error) { return o.raw.Read(p) }
-func (o *osReadHandle) Close() error               { return o.raw.Close() }
-func (o *osReadHandle) Info() ObjectInfo           { return o.info }
+func (o *osReadHandle) Read(p []byte) (int, error) {
+	if o.limit != nil {
+		return o.limit.Read(p)
+	}
+	return o.raw.Read(p)
+}
+
+func (o *osReadHandle) Close() error     { return o.raw.Close() }
+func (o *osReadHandle) Info() ObjectInfo { return o.info }
 
 // genericReadHandle implements readHandle for an io.Reader.
 type genericReadHandle struct{ r io.Reader }
@@ -158,8 +214,9 @@ func (u *uplinkWriteHandle) Abort() error                { return u.raw().Abort(
 
 // osWriteHandle implements writeHandle for *os.Files.
 type osWriteHandle struct {
-	fh   *os.File
-	done bool
+	fh       *os.File
+	modified time.Time
+	done     bool
 }
 
 // newOSWriteHandle constructs an *osWriteHandle from an *os.File.
@@ -175,7 +232,13 @@ func (o *osWriteHandle) Commit() error {
 	}
 	o.done = true
 
-	return o.fh.Close()
+	if err := o.fh.Close(); err != nil {
+		return err
+	}
+	if !o.modified.IsZero() {
+		return errs.Wrap(os.Chtimes(o.fh.Name(), o.modified, o.modified))
+	}
+	return nil
 }
 
 func (o *osWriteHandle) Abort() error {
@@ -202,6 +265,113 @@ func (g *genericWriteHandle) Write(p []byte) (int, error) { return g.w.Write(p)
 func (g *genericWriteHandle) Commit() error               { return nil }
 func (g *genericWriteHandle) Abort() error                { return nil }
 
+//
+// multipart write handles
+//
+
+// MultiWriteHandle is something that can be written to concurrently in parts with
+// commit/abort semantics for the whole object. The parts are identified by their
+// index and start at the given offset. Every part must be committed before the
+// handle is committed.
+//
+// The ID identifies the handle so that an interrupted write can be resumed, and
+// Committed returns the indexes of the parts that are known to be committed.
+type MultiWriteHandle interface {
+	ID() string
+	Part(ctx context.Context, index int, offset int64) (WriteHandle, error)
+	Committed(ctx context.Context) ([]int, error)
+	Commit(ctx context.Context) error
+	Abort(ctx context.Context) error
+}
+
+// uplinkMultiWriteHandle implements MultiWriteHandle for uplink multipart uploads.
+type uplinkMultiWriteHandle struct {
+	project  *uplink.Project
+	bucket   string
+	key      string
+	uploadID string
+}
+
+// newUplinkMultiWriteHandle constructs an *uplinkMultiWriteHandle for a begun upload.
+func newUplinkMultiWriteHandle(project *uplink.Project, bucket, key, uploadID string) *uplinkMultiWriteHandle {
+	return &uplinkMultiWriteHandle{
+		project:  project,
+		bucket:   bucket,
+		key:      key,
+		uploadID: uploadID,
+	}
+}
+
+func (u *uplinkMultiWriteHandle) ID() string { return u.uploadID }
+
+func (u *uplinkMultiWriteHandle) Part(ctx context.Context, index int, offset int64) (WriteHandle, error) {
+	// part numbers start at 1 to match the numbering of the other multipart clients.
+	pu, err := u.project.UploadPart(ctx, u.bucket, u.key, u.uploadID, uint32(index+1))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return pu, nil
+}
+
+func (u *uplinkMultiWriteHandle) Committed(ctx context.Context) ([]int, error) {
+	var committed []int
+
+	iter := u.project.ListUploadParts(ctx, u.bucket, u.key, u.uploadID, nil)
+	for iter.Next() {
+		committed = append(committed, int(iter.Item().PartNumber)-1)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return committed, nil
+}
+
+func (u *uplinkMultiWriteHandle) Commit(ctx context.Context) error {
+	_, err := u.project.CommitUpload(ctx, u.bucket, u.key, u.uploadID, nil)
+	return errs.Wrap(err)
+}
+
+func (u *uplinkMultiWriteHandle) Abort(ctx context.Context) error {
+	return errs.Wrap(u.project.AbortUpload(ctx, u.bucket, u.key, u.uploadID))
+}
+
+// osMultiWriteHandle implements MultiWriteHandle for *os.Files.
+type osMultiWriteHandle struct {
+	wh *osWriteHandle
+}
+
+// newOSMultiWriteHandle constructs an *osMultiWriteHandle from an *os.File.
+func newOSMultiWriteHandle(fh *os.File) *osMultiWriteHandle {
+	return &osMultiWriteHandle{wh: newOSWriteHandle(fh)}
+}
+
+func (o *osMultiWriteHandle) ID() string { return o.wh.fh.Name() }
+
+func (o *osMultiWriteHandle) Part(ctx context.Context, index int, offset int64) (WriteHandle, error) {
+	return &osPartWriteHandle{fh: o.wh.fh, offset: offset}, nil
+}
+
+// Committed returns no parts because a file doesn't know which parts were written.
+func (o *osMultiWriteHandle) Committed(ctx context.Context) ([]int, error) { return nil, nil }
+
+func (o *osMultiWriteHandle) Commit(ctx context.Context) error { return o.wh.Commit() }
+func (o *osMultiWriteHandle) Abort(ctx context.Context) error  { return o.wh.Abort() }
+
+// osPartWriteHandle implements writeHandle for a part of an *os.File.
+type osPartWriteHandle struct {
+	fh     *os.File
+	offset int64
+}
+
+func (o *osPartWriteHandle) Write(p []byte) (int, error) {
+	n, err := o.fh.WriteAt(p, o.offset)
+	o.offset += int64(n)
+	return n, err
+}
+
+func (o *osPartWriteHandle) Commit() error { return nil }
+func (o *osPartWriteHandle) Abort() error  { return nil }
+
 //
 // object iteration
 //
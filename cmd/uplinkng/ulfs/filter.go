@@ -0,0 +1,160 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ulfs
+
+import (
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplinkng/ulloc"
+)
+
+// Filter selects objects by their key, size and creation time. Keys are matched
+// relative to the listed prefix, using forward slashes. The zero value selects
+// every object.
+type Filter struct {
+	// Include and Exclude are glob patterns. A pattern matches a key if it matches
+	// the whole key or the part of the key after any slash.
+	Include []string
+	Exclude []string
+
+	// IncludeRegexp and ExcludeRegexp match anywhere in the key.
+	IncludeRegexp []*regexp.Regexp
+	ExcludeRegexp []*regexp.Regexp
+
+	// MinSize and MaxSize limit the content length when they are positive.
+	MinSize int64
+	MaxSize int64
+
+	// CreatedBefore and CreatedAfter limit the creation time when they are not zero.
+	CreatedBefore time.Time
+	CreatedAfter  time.Time
+}
+
+// Validate returns an error if any of the glob patterns is malformed.
+func (f *Filter) Validate() error {
+	for _, pattern := range append(append([]string(nil), f.Include...), f.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errs.New("invalid pattern %q: %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+// IsZero returns true if the filter selects every object.
+func (f *Filter) IsZero() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0 &&
+		len(f.IncludeRegexp) == 0 && len(f.ExcludeRegexp) == 0 &&
+		f.MinSize <= 0 && f.MaxSize <= 0 &&
+		f.CreatedBefore.IsZero() && f.CreatedAfter.IsZero()
+}
+
+// Matches returns true if the object with the info is selected when its key
+// relative to the listed prefix is the given key. Prefixes are only matched by
+// the key patterns.
+func (f *Filter) Matches(key string, info ObjectInfo) bool {
+	if len(f.Include) > 0 || len(f.IncludeRegexp) > 0 {
+		if !matchGlobs(f.Include, key) && !matchRegexps(f.IncludeRegexp, key) {
+			return false
+		}
+	}
+	if matchGlobs(f.Exclude, key) || matchRegexps(f.ExcludeRegexp, key) {
+		return false
+	}
+	if info.IsPrefix {
+		return true
+	}
+
+	switch {
+	case f.MinSize > 0 && info.ContentLength < f.MinSize:
+		return false
+	case f.MaxSize > 0 && info.ContentLength > f.MaxSize:
+		return false
+	case !f.CreatedBefore.IsZero() && !info.Created.Before(f.CreatedBefore):
+		return false
+	case !f.CreatedAfter.IsZero() && !info.Created.After(f.CreatedAfter):
+		return false
+	}
+	return true
+}
+
+// matchGlobs returns true if the key or the part of the key after any slash matches
+// any of the patterns.
+func matchGlobs(patterns []string, key string) bool {
+	for _, pattern := range patterns {
+		for suffix := key; ; {
+			if ok, _ := path.Match(pattern, suffix); ok {
+				return true
+			}
+			idx := strings.IndexByte(suffix, '/')
+			if idx < 0 {
+				break
+			}
+			suffix = suffix[idx+1:]
+		}
+	}
+	return false
+}
+
+// matchRegexps returns true if any of the regular expressions matches the key.
+func matchRegexps(regexps []*regexp.Regexp, key string) bool {
+	for _, re := range regexps {
+		if re.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterObjects returns an ObjectIterator that only returns the items of iter that
+// are selected by the filter using their key relative to the directory of prefix.
+func FilterObjects(iter ObjectIterator, prefix ulloc.Location, filter *Filter) ObjectIterator {
+	if filter == nil || filter.IsZero() {
+		return iter
+	}
+	return &selectedObjectIterator{
+		filter: filter,
+		dir:    listedDir(prefix),
+		iter:   iter,
+	}
+}
+
+// listedDir returns the directory of the prefix the way it appears in the keys
+// of the listed items. Listed local keys are absolute and use forward slashes.
+func listedDir(prefix ulloc.Location) string {
+	dir := prefix.Parent()
+	if !prefix.Local() {
+		return dir
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return filepath.ToSlash(dir)
+	}
+	return strings.TrimSuffix(filepath.ToSlash(abs), "/") + "/"
+}
+
+// selectedObjectIterator removes any iteration entries that are not selected by the filter.
+type selectedObjectIterator struct {
+	filter *Filter
+	dir    string
+	iter   ObjectIterator
+}
+
+func (s *selectedObjectIterator) Next() bool {
+	for s.iter.Next() {
+		item := s.iter.Item()
+		if s.filter.Matches(strings.TrimPrefix(item.Loc.Key(), s.dir), item) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *selectedObjectIterator) Err() error       { return s.iter.Err() }
+func (s *selectedObjectIterator) Item() ObjectInfo { return s.iter.Item() }
@@ -0,0 +1,108 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ulfs_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/cmd/uplinkng/ulfs"
+	"storj.io/storj/cmd/uplinkng/ulloc"
+)
+
+func TestFilterMatches(t *testing.T) {
+	created := time.Unix(10, 0)
+	object := ulfs.ObjectInfo{Created: created, ContentLength: 100}
+	prefix := ulfs.ObjectInfo{IsPrefix: true}
+
+	for _, tt := range []struct {
+		name    string
+		filter  ulfs.Filter
+		key     string
+		info    ulfs.ObjectInfo
+		matches bool
+	}{
+		{name: "zero", key: "a/b.txt", info: object, matches: true},
+
+		{name: "include whole key", filter: ulfs.Filter{Include: []string{"a/*.txt"}}, key: "a/b.txt", info: object, matches: true},
+		{name: "include suffix", filter: ulfs.Filter{Include: []string{"*.txt"}}, key: "a/b/c.txt", info: object, matches: true},
+		{name: "include no match", filter: ulfs.Filter{Include: []string{"*.txt"}}, key: "a/b.tmp", info: object, matches: false},
+		{name: "include anchored", filter: ulfs.Filter{Include: []string{"b/*.txt"}}, key: "a/c.txt", info: object, matches: false},
+		{name: "exclude", filter: ulfs.Filter{Exclude: []string{"*.tmp"}}, key: "a/b.tmp", info: object, matches: false},
+		{name: "exclude overrides include", filter: ulfs.Filter{Include: []string{"a/*"}, Exclude: []string{"*.tmp"}}, key: "a/b.tmp", info: object, matches: false},
+
+		{name: "include regexp", filter: ulfs.Filter{IncludeRegexp: []*regexp.Regexp{regexp.MustCompile(`^a/`)}}, key: "a/b.tmp", info: object, matches: true},
+		{name: "include regexp no match", filter: ulfs.Filter{IncludeRegexp: []*regexp.Regexp{regexp.MustCompile(`^b/`)}}, key: "a/b.tmp", info: object, matches: false},
+		{name: "include glob or regexp", filter: ulfs.Filter{Include: []string{"*.txt"}, IncludeRegexp: []*regexp.Regexp{regexp.MustCompile(`\.tmp$`)}}, key: "a/b.tmp", info: object, matches: true},
+		{name: "exclude regexp", filter: ulfs.Filter{ExcludeRegexp: []*regexp.Regexp{regexp.MustCompile(`b`)}}, key: "a/b.tmp", info: object, matches: false},
+
+		{name: "min size", filter: ulfs.Filter{MinSize: 100}, key: "a", info: object, matches: true},
+		{name: "min size too small", filter: ulfs.Filter{MinSize: 101}, key: "a", info: object, matches: false},
+		{name: "max size", filter: ulfs.Filter{MaxSize: 100}, key: "a", info: object, matches: true},
+		{name: "max size too large", filter: ulfs.Filter{MaxSize: 99}, key: "a", info: object, matches: false},
+
+		{name: "created before", filter: ulfs.Filter{CreatedBefore: created.Add(time.Second)}, key: "a", info: object, matches: true},
+		{name: "created before not before", filter: ulfs.Filter{CreatedBefore: created}, key: "a", info: object, matches: false},
+		{name: "created after", filter: ulfs.Filter{CreatedAfter: created.Add(-time.Second)}, key: "a", info: object, matches: true},
+		{name: "created after not after", filter: ulfs.Filter{CreatedAfter: created}, key: "a", info: object, matches: false},
+
+		{name: "prefix ignores size and time", filter: ulfs.Filter{MinSize: 1, CreatedAfter: created}, key: "a/", info: prefix, matches: true},
+		{name: "prefix excluded by key", filter: ulfs.Filter{Exclude: []string{"a/"}}, key: "a/", info: prefix, matches: false},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.matches, tt.filter.Matches(tt.key, tt.info))
+		})
+	}
+}
+
+func TestFilterValidate(t *testing.T) {
+	require.NoError(t, (&ulfs.Filter{Include: []string{"*.txt"}, Exclude: []string{"dir/[a-z]*"}}).Validate())
+	require.Error(t, (&ulfs.Filter{Include: []string{"[a-"}}).Validate())
+	require.Error(t, (&ulfs.Filter{Exclude: []string{"["}}).Validate())
+}
+
+func TestFilterIsZero(t *testing.T) {
+	require.True(t, (&ulfs.Filter{}).IsZero())
+	require.False(t, (&ulfs.Filter{Include: []string{"*"}}).IsZero())
+	require.False(t, (&ulfs.Filter{MaxSize: 1}).IsZero())
+	require.False(t, (&ulfs.Filter{CreatedAfter: time.Unix(1, 0)}).IsZero())
+}
+
+func TestFilterObjectsRelativeKeys(t *testing.T) {
+	infos := []ulfs.ObjectInfo{
+		{Loc: ulloc.NewRemote("bucket", "dir/a.txt")},
+		{Loc: ulloc.NewRemote("bucket", "dir/sub/b.txt")},
+		{Loc: ulloc.NewRemote("bucket", "dir/sub/c.tmp")},
+	}
+
+	filter := &ulfs.Filter{Include: []string{"sub/*"}}
+	iter := ulfs.FilterObjects(&sliceIterator{infos: infos}, ulloc.NewRemote("bucket", "dir/"), filter)
+
+	var keys []string
+	for iter.Next() {
+		keys = append(keys, iter.Item().Loc.Key())
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, []string{"dir/sub/b.txt", "dir/sub/c.tmp"}, keys)
+}
+
+type sliceIterator struct {
+	infos   []ulfs.ObjectInfo
+	current ulfs.ObjectInfo
+}
+
+func (s *sliceIterator) Next() bool {
+	if len(s.infos) == 0 {
+		return false
+	}
+	s.current, s.infos = s.infos[0], s.infos[1:]
+	return true
+}
+
+func (s *sliceIterator) Err() error            { return nil }
+func (s *sliceIterator) Item() ulfs.ObjectInfo { return s.current }
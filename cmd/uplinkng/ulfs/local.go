@@ -5,6 +5,7 @@ package ulfs
 
 import (
 	"context"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -49,8 +50,91 @@ func (l *Local) Open(ctx context.Context, path string) (ReadHandle, error) {
 	return newOSReadHandle(fh)
 }
 
+// OpenRange returns a ReadHandle for length bytes starting at offset of the given local
+// path. A negative length reads until the end of the file.
+func (l *Local) OpenRange(ctx context.Context, path string, offset, length int64) (ReadHandle, error) {
+	path, err := l.abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fh, err := os.Open(path)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if _, err := fh.Seek(offset, io.SeekStart); err != nil {
+		return nil, errs.Combine(err, fh.Close())
+	}
+
+	rh, err := newOSReadHandle(fh)
+	if err != nil {
+		return nil, errs.Combine(err, fh.Close())
+	}
+	if length >= 0 {
+		rh.limit = io.LimitReader(fh, length)
+	}
+	return rh, nil
+}
+
+// Stat returns the information of the given local path.
+func (l *Local) Stat(ctx context.Context, path string) (ObjectInfo, error) {
+	path, err := l.abs(path)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return ObjectInfo{}, errs.Wrap(err)
+	}
+	return ObjectInfo{
+		Loc:           ulloc.NewLocal(path),
+		IsPrefix:      fi.IsDir(),
+		Created:       fi.ModTime(), // TODO: os specific crtime
+		ContentLength: fi.Size(),
+		Modified:      fi.ModTime(),
+	}, nil
+}
+
 // Create makes any directories necessary to create a file at path and returns a WriteHandle.
-func (l *Local) Create(ctx context.Context, path string) (WriteHandle, error) {
+func (l *Local) Create(ctx context.Context, path string, opts *CreateOptions) (WriteHandle, error) {
+	fh, err := l.create(path)
+	if err != nil {
+		return nil, err
+	}
+	wh := newOSWriteHandle(fh)
+	if opts != nil {
+		wh.modified = opts.Modified
+	}
+	return wh, nil
+}
+
+// CreateMultipart makes any directories necessary to create a file at path and returns
+// a MultiWriteHandle that writes every part at its offset in the file.
+func (l *Local) CreateMultipart(ctx context.Context, path string) (MultiWriteHandle, error) {
+	fh, err := l.create(path)
+	if err != nil {
+		return nil, err
+	}
+	return newOSMultiWriteHandle(fh), nil
+}
+
+// ResumeMultipart returns a MultiWriteHandle that writes every part at its offset in the
+// existing file at path. The id is ignored because a path has a single pending write.
+func (l *Local) ResumeMultipart(ctx context.Context, path, id string) (MultiWriteHandle, error) {
+	path, err := l.abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fh, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return newOSMultiWriteHandle(fh), nil
+}
+
+func (l *Local) create(path string) (*os.File, error) {
 	path, err := l.abs(path)
 	if err != nil {
 		return nil, err
@@ -72,7 +156,7 @@ func (l *Local) Create(ctx context.Context, path string) (WriteHandle, error) {
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
-	return newOSWriteHandle(fh), nil
+	return fh, nil
 }
 
 // Remove unlinks the file at the path. It is not an error if the file does not exist.
@@ -91,6 +175,31 @@ func (l *Local) Remove(ctx context.Context, path string) error {
 	return nil
 }
 
+// Move renames the file at oldpath to newpath, creating any directories necessary.
+func (l *Local) Move(ctx context.Context, oldpath, newpath string) error {
+	oldpath, err := l.abs(oldpath)
+	if err != nil {
+		return err
+	}
+	newpath, err = l.abs(newpath)
+	if err != nil {
+		return err
+	}
+
+	fi, err := os.Stat(oldpath)
+	if err != nil {
+		return errs.Wrap(err)
+	} else if fi.IsDir() {
+		return errs.New("path is a directory")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(newpath), 0755); err != nil {
+		return errs.Wrap(err)
+	}
+
+	return errs.Wrap(os.Rename(oldpath, newpath))
+}
+
 // ListObjects returns an ObjectIterator listing files and directories that have string prefix
 // with the provided path.
 func (l *Local) ListObjects(ctx context.Context, path string, recursive bool) (ObjectIterator, error) {
@@ -187,5 +296,6 @@ func (fi *fileinfoObjectIterator) Item() ObjectInfo {
 		IsPrefix:      isDir,
 		Created:       fi.current.ModTime(), // TODO: use real crtime
 		ContentLength: fi.current.Size(),
+		Modified:      fi.current.ModTime(),
 	}
 }
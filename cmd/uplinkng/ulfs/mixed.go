@@ -41,16 +41,57 @@ func (m *Mixed) Open(ctx clingy.Context, loc ulloc.Location) (ReadHandle, error)
 	return newGenericReadHandle(ctx.Stdin()), nil
 }
 
+// OpenRange returns a ReadHandle to a range of either a local file or remote object.
+func (m *Mixed) OpenRange(ctx clingy.Context, loc ulloc.Location, offset, length int64) (ReadHandle, error) {
+	if bucket, key, ok := loc.RemoteParts(); ok {
+		return m.remote.OpenRange(ctx, bucket, key, offset, length)
+	} else if path, ok := loc.LocalParts(); ok {
+		return m.local.OpenRange(ctx, path, offset, length)
+	}
+	return nil, errs.New("unable to open a range of %q", loc)
+}
+
+// Stat returns the information of either a local file or remote object.
+func (m *Mixed) Stat(ctx context.Context, loc ulloc.Location) (ObjectInfo, error) {
+	if bucket, key, ok := loc.RemoteParts(); ok {
+		return m.remote.Stat(ctx, bucket, key)
+	} else if path, ok := loc.LocalParts(); ok {
+		return m.local.Stat(ctx, path)
+	}
+	return ObjectInfo{}, errs.New("unable to stat %q", loc)
+}
+
 // Create returns a WriteHandle to either a local file, remote object, or stdout.
-func (m *Mixed) Create(ctx clingy.Context, loc ulloc.Location) (WriteHandle, error) {
+func (m *Mixed) Create(ctx clingy.Context, loc ulloc.Location, opts *CreateOptions) (WriteHandle, error) {
 	if bucket, key, ok := loc.RemoteParts(); ok {
-		return m.remote.Create(ctx, bucket, key)
+		return m.remote.Create(ctx, bucket, key, opts)
 	} else if path, ok := loc.LocalParts(); ok {
-		return m.local.Create(ctx, path)
+		return m.local.Create(ctx, path, opts)
 	}
 	return newGenericWriteHandle(ctx.Stdout()), nil
 }
 
+// CreateMultipart returns a MultiWriteHandle to either a local file or remote object.
+func (m *Mixed) CreateMultipart(ctx clingy.Context, loc ulloc.Location) (MultiWriteHandle, error) {
+	if bucket, key, ok := loc.RemoteParts(); ok {
+		return m.remote.CreateMultipart(ctx, bucket, key)
+	} else if path, ok := loc.LocalParts(); ok {
+		return m.local.CreateMultipart(ctx, path)
+	}
+	return nil, errs.New("unable to create parts of %q", loc)
+}
+
+// ResumeMultipart returns a MultiWriteHandle to continue an interrupted write of either a
+// local file or remote object.
+func (m *Mixed) ResumeMultipart(ctx clingy.Context, loc ulloc.Location, id string) (MultiWriteHandle, error) {
+	if bucket, key, ok := loc.RemoteParts(); ok {
+		return m.remote.ResumeMultipart(ctx, bucket, key, id)
+	} else if path, ok := loc.LocalParts(); ok {
+		return m.local.ResumeMultipart(ctx, path, id)
+	}
+	return nil, errs.New("unable to resume parts of %q", loc)
+}
+
 // Remove deletes either a local file or remote object.
 func (m *Mixed) Remove(ctx context.Context, loc ulloc.Location) error {
 	if bucket, key, ok := loc.RemoteParts(); ok {
@@ -61,6 +102,21 @@ func (m *Mixed) Remove(ctx context.Context, loc ulloc.Location) error {
 	return nil
 }
 
+// Move moves either a local file or remote object. Moving between local and remote
+// locations is not supported.
+func (m *Mixed) Move(ctx clingy.Context, source, dest ulloc.Location) error {
+	if oldbucket, oldkey, ok := source.RemoteParts(); ok {
+		if newbucket, newkey, ok := dest.RemoteParts(); ok {
+			return m.remote.Move(ctx, oldbucket, oldkey, newbucket, newkey)
+		}
+	} else if oldpath, ok := source.LocalParts(); ok {
+		if newpath, ok := dest.LocalParts(); ok {
+			return m.local.Move(ctx, oldpath, newpath)
+		}
+	}
+	return errs.New("moving objects between local and remote is not supported")
+}
+
 // ListObjects lists either files and directories with some local path prefix or remote objects
 // with a given bucket and key.
 func (m *Mixed) ListObjects(ctx context.Context, prefix ulloc.Location, recursive bool) (ObjectIterator, error) {
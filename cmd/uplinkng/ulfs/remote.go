@@ -5,6 +5,7 @@ package ulfs
 
 import (
 	"context"
+	"io"
 	"strings"
 
 	"github.com/zeebo/errs"
@@ -38,15 +39,64 @@ func (r *Remote) Open(ctx context.Context, bucket, key string) (ReadHandle, erro
 	return newUplinkReadHandle(bucket, fh), nil
 }
 
+// OpenRange returns a ReadHandle for length bytes starting at offset of the object
+// identified by a given bucket and key. A negative length reads until the end.
+func (r *Remote) OpenRange(ctx context.Context, bucket, key string, offset, length int64) (ReadHandle, error) {
+	fh, err := r.project.DownloadObject(ctx, bucket, key, &uplink.DownloadOptions{
+		Offset: offset,
+		Length: length,
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return newUplinkReadHandle(bucket, fh), nil
+}
+
+// Stat returns the information of the object identified by a given bucket and key.
+func (r *Remote) Stat(ctx context.Context, bucket, key string) (ObjectInfo, error) {
+	obj, err := r.project.StatObject(ctx, bucket, key)
+	if err != nil {
+		return ObjectInfo{}, errs.Wrap(err)
+	}
+	return uplinkObjectToObjectInfo(bucket, obj), nil
+}
+
 // Create returns a WriteHandle for the object identified by a given bucket and key.
-func (r *Remote) Create(ctx context.Context, bucket, key string) (WriteHandle, error) {
+func (r *Remote) Create(ctx context.Context, bucket, key string, opts *CreateOptions) (WriteHandle, error) {
 	fh, err := r.project.UploadObject(ctx, bucket, key, nil)
 	if err != nil {
 		return nil, err
 	}
+	if opts != nil {
+		if err := fh.SetCustomMetadata(ctx, createOptionsToCustomMetadata(opts)); err != nil {
+			return nil, errs.Combine(err, fh.Abort())
+		}
+	}
 	return newUplinkWriteHandle(fh), nil
 }
 
+// CreateMultipart returns a MultiWriteHandle for the object identified by a given bucket
+// and key that uploads every part concurrently.
+func (r *Remote) CreateMultipart(ctx context.Context, bucket, key string) (MultiWriteHandle, error) {
+	info, err := r.project.BeginUpload(ctx, bucket, key, nil)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return newUplinkMultiWriteHandle(r.project, bucket, key, info.UploadID), nil
+}
+
+// ResumeMultipart returns a MultiWriteHandle for the pending upload with the given id
+// of the object identified by a given bucket and key.
+func (r *Remote) ResumeMultipart(ctx context.Context, bucket, key, uploadID string) (MultiWriteHandle, error) {
+	mwh := newUplinkMultiWriteHandle(r.project, bucket, key, uploadID)
+
+	// listing the parts fails if the upload is no longer pending.
+	if _, err := mwh.Committed(ctx); err != nil {
+		return nil, err
+	}
+	return mwh, nil
+}
+
 // Remove deletes the object at the provided key and bucket.
 func (r *Remote) Remove(ctx context.Context, bucket, key string) error {
 	_, err := r.project.DeleteObject(ctx, bucket, key)
@@ -56,6 +106,42 @@ func (r *Remote) Remove(ctx context.Context, bucket, key string) error {
 	return nil
 }
 
+// Move moves the object from one bucket and key to another.
+//
+// TODO: the object is downloaded and uploaded again until the uplink library
+// exposes the server-side move.
+func (r *Remote) Move(ctx context.Context, oldbucket, oldkey, newbucket, newkey string) (err error) {
+	if oldbucket == newbucket && oldkey == newkey {
+		return nil
+	}
+
+	dl, err := r.project.DownloadObject(ctx, oldbucket, oldkey, nil)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, dl.Close()) }()
+
+	ul, err := r.project.UploadObject(ctx, newbucket, newkey, &uplink.UploadOptions{
+		Expires: dl.Info().System.Expires,
+	})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	if err := ul.SetCustomMetadata(ctx, dl.Info().Custom); err != nil {
+		return errs.Combine(err, ul.Abort())
+	}
+	if _, err := io.Copy(ul, dl); err != nil {
+		return errs.Combine(err, ul.Abort())
+	}
+	if err := ul.Commit(); err != nil {
+		return errs.Wrap(err)
+	}
+
+	_, err = r.project.DeleteObject(ctx, oldbucket, oldkey)
+	return errs.Wrap(err)
+}
+
 // ListObjects lists all of the objects in some bucket that begin with the given prefix.
 func (r *Remote) ListObjects(ctx context.Context, bucket, prefix string, recursive bool) ObjectIterator {
 	parentPrefix := ""
@@ -76,6 +162,7 @@ func (r *Remote) ListObjects(ctx context.Context, bucket, prefix string, recursi
 				Prefix:    parentPrefix,
 				Recursive: recursive,
 				System:    true,
+				Custom:    true,
 			})),
 	}
 }
@@ -15,17 +15,25 @@ import (
 )
 
 type external struct {
-	fs      ulfs.Filesystem
+	fs      *testFilesystem
 	project *uplink.Project
+	output  ulext.OutputFormat
 }
 
-func newExternal(fs ulfs.Filesystem, project *uplink.Project) *external {
+func newExternal(fs *testFilesystem, project *uplink.Project) *external {
 	return &external{
 		fs:      fs,
 		project: project,
 	}
 }
 
+func (ex *external) Setup(f clingy.Flags) {
+	ex.output = f.Flag(
+		"output", "Format of the results and errors: text, json or ndjson", ulext.OutputText,
+		clingy.Transform(ulext.ParseOutputFormat),
+	).(ulext.OutputFormat)
+}
+
 func (ex *external) OpenFilesystem(ctx context.Context, access string, options ...ulext.Option) (ulfs.Filesystem, error) {
 	return ex.fs, nil
 }
@@ -42,6 +50,22 @@ func (ex *external) SaveAccessInfo(accessDefault string, accesses map[string]str
 	return errs.New("not implemented")
 }
 
+func (ex *external) LoadTransferState(key string, state interface{}) (bool, error) {
+	return ex.fs.loadTransferState(key, state)
+}
+
+func (ex *external) SaveTransferState(key string, state interface{}) error {
+	return ex.fs.saveTransferState(key, state)
+}
+
+func (ex *external) RemoveTransferState(key string) error {
+	return ex.fs.removeTransferState(key)
+}
+
 func (ex *external) PromptInput(ctx clingy.Context, prompt string) (input string, err error) {
 	return "", errs.New("not implemented")
 }
+
+func (ex *external) OutputFormat() ulext.OutputFormat {
+	return ex.output
+}
@@ -6,7 +6,10 @@ package ultest
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/zeebo/clingy"
@@ -21,31 +24,54 @@ import (
 //
 
 type testFilesystem struct {
-	stdin   string
-	created int64
-	files   map[ulloc.Location]memFileData
-	pending map[ulloc.Location][]*memWriteHandle
-	buckets map[string]struct{}
+	stdin string
+
+	mu        sync.Mutex
+	created   int64
+	files     map[ulloc.Location]memFileData
+	pending   map[ulloc.Location][]*memWriteHandle
+	multipart map[string]*memMultiWriteHandle
+	buckets   map[string]struct{}
+	transfers map[string][]byte
 }
 
 func newTestFilesystem() *testFilesystem {
 	return &testFilesystem{
-		files:   make(map[ulloc.Location]memFileData),
-		pending: make(map[ulloc.Location][]*memWriteHandle),
-		buckets: make(map[string]struct{}),
+		files:     make(map[ulloc.Location]memFileData),
+		pending:   make(map[ulloc.Location][]*memWriteHandle),
+		multipart: make(map[string]*memMultiWriteHandle),
+		buckets:   make(map[string]struct{}),
+		transfers: make(map[string][]byte),
 	}
 }
 
 type memFileData struct {
 	contents string
 	created  int64
+	modified time.Time
+	hash     string
+}
+
+func (mf memFileData) info(loc ulloc.Location) ulfs.ObjectInfo {
+	return ulfs.ObjectInfo{
+		Loc:      loc,
+		Created:  time.Unix(mf.created, 0),
+		Modified: mf.modified,
+		Hash:     mf.hash,
+	}
 }
 
 func (tfs *testFilesystem) ensureBucket(name string) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
 	tfs.buckets[name] = struct{}{}
 }
 
 func (tfs *testFilesystem) Files() (files []File) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
 	for loc, mf := range tfs.files {
 		files = append(files, File{
 			Loc:      loc.String(),
@@ -61,14 +87,122 @@ func (tfs *testFilesystem) Close() error {
 }
 
 func (tfs *testFilesystem) Open(ctx clingy.Context, loc ulloc.Location) (_ ulfs.ReadHandle, err error) {
+	return tfs.OpenRange(ctx, loc, 0, -1)
+}
+
+func (tfs *testFilesystem) OpenRange(ctx clingy.Context, loc ulloc.Location, offset, length int64) (_ ulfs.ReadHandle, err error) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
 	mf, ok := tfs.files[loc]
 	if !ok {
 		return nil, errs.New("file does not exist")
 	}
-	return &byteReadHandle{Buffer: bytes.NewBufferString(mf.contents)}, nil
+
+	contents := mf.contents
+	if offset > int64(len(contents)) {
+		return nil, errs.New("offset past the end of the file")
+	}
+	contents = contents[offset:]
+	if length >= 0 && length < int64(len(contents)) {
+		contents = contents[:length]
+	}
+
+	info := mf.info(loc)
+	info.ContentLength = int64(len(mf.contents))
+
+	return &byteReadHandle{
+		Buffer: bytes.NewBufferString(contents),
+		info:   info,
+	}, nil
 }
 
-func (tfs *testFilesystem) Create(ctx clingy.Context, loc ulloc.Location) (_ ulfs.WriteHandle, err error) {
+func (tfs *testFilesystem) Stat(ctx context.Context, loc ulloc.Location) (ulfs.ObjectInfo, error) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	mf, ok := tfs.files[loc]
+	if !ok {
+		return ulfs.ObjectInfo{}, errs.New("file does not exist")
+	}
+
+	info := mf.info(loc)
+	info.ContentLength = int64(len(mf.contents))
+	return info, nil
+}
+
+func (tfs *testFilesystem) Create(ctx clingy.Context, loc ulloc.Location, opts *ulfs.CreateOptions) (_ ulfs.WriteHandle, err error) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	wh, err := tfs.create(loc)
+	if err != nil {
+		return nil, err
+	}
+	if opts != nil {
+		wh.modified = opts.Modified
+		if loc.Remote() {
+			wh.hash = opts.Hash
+		}
+	}
+	return wh, nil
+}
+
+func (tfs *testFilesystem) CreateMultipart(ctx clingy.Context, loc ulloc.Location) (_ ulfs.MultiWriteHandle, err error) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	wh, err := tfs.create(loc)
+	if err != nil {
+		return nil, err
+	}
+	return tfs.newMultiWriteHandle(wh), nil
+}
+
+func (tfs *testFilesystem) ResumeMultipart(ctx clingy.Context, loc ulloc.Location, id string) (_ ulfs.MultiWriteHandle, err error) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	if loc.Remote() {
+		mwh, ok := tfs.multipart[id]
+		if !ok || mwh.wh.loc != loc {
+			return nil, errs.New("upload %q does not exist", id)
+		}
+		return mwh, nil
+	}
+
+	// resuming a local file continues writing to the existing contents.
+	mf, ok := tfs.files[loc]
+	if !ok {
+		return nil, errs.New("file does not exist")
+	}
+
+	wh, err := tfs.create(loc)
+	if err != nil {
+		return nil, err
+	}
+	mwh := tfs.newMultiWriteHandle(wh)
+	mwh.parts[-1] = &memPartWriteHandle{committed: true}
+	mwh.parts[-1].buf.WriteString(mf.contents)
+	return mwh, nil
+}
+
+func (tfs *testFilesystem) newMultiWriteHandle(wh *memWriteHandle) *memMultiWriteHandle {
+	mwh := &memMultiWriteHandle{
+		id:    uploadID(wh),
+		wh:    wh,
+		parts: make(map[int]*memPartWriteHandle),
+	}
+	tfs.multipart[mwh.id] = mwh
+	return mwh
+}
+
+// uploadID returns the id of the pending upload of the write handle.
+func uploadID(wh *memWriteHandle) string {
+	return fmt.Sprint("upload-", wh.cre)
+}
+
+func (tfs *testFilesystem) create(loc ulloc.Location) (*memWriteHandle, error) {
 	if bucket, _, ok := loc.RemoteParts(); ok {
 		if _, ok := tfs.buckets[bucket]; !ok {
 			return nil, errs.New("bucket %q does not exist", bucket)
@@ -89,18 +223,43 @@ func (tfs *testFilesystem) Create(ctx clingy.Context, loc ulloc.Location) (_ ulf
 }
 
 func (tfs *testFilesystem) Remove(ctx context.Context, loc ulloc.Location) error {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
 	delete(tfs.files, loc)
 	return nil
 }
 
+func (tfs *testFilesystem) Move(ctx clingy.Context, source, dest ulloc.Location) error {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	if source.Remote() != dest.Remote() {
+		return errs.New("moving objects between local and remote is not supported")
+	}
+	if bucket, _, ok := dest.RemoteParts(); ok {
+		if _, ok := tfs.buckets[bucket]; !ok {
+			return errs.New("bucket %q does not exist", bucket)
+		}
+	}
+
+	mf, ok := tfs.files[source]
+	if !ok {
+		return errs.New("file does not exist")
+	}
+	delete(tfs.files, source)
+	tfs.files[dest] = mf
+	return nil
+}
+
 func (tfs *testFilesystem) ListObjects(ctx context.Context, prefix ulloc.Location, recursive bool) (ulfs.ObjectIterator, error) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
 	var infos []ulfs.ObjectInfo
 	for loc, mf := range tfs.files {
 		if loc.HasPrefix(prefix) {
-			infos = append(infos, ulfs.ObjectInfo{
-				Loc:     loc,
-				Created: time.Unix(mf.created, 0),
-			})
+			infos = append(infos, mf.info(loc))
 		}
 	}
 
@@ -114,13 +273,17 @@ func (tfs *testFilesystem) ListObjects(ctx context.Context, prefix ulloc.Locatio
 }
 
 func (tfs *testFilesystem) ListUploads(ctx context.Context, prefix ulloc.Location, recursive bool) (ulfs.ObjectIterator, error) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
 	var infos []ulfs.ObjectInfo
 	for loc, whs := range tfs.pending {
 		if loc.HasPrefix(prefix) {
 			for _, wh := range whs {
 				infos = append(infos, ulfs.ObjectInfo{
-					Loc:     loc,
-					Created: time.Unix(wh.cre, 0),
+					Loc:      loc,
+					Created:  time.Unix(wh.cre, 0),
+					UploadID: uploadID(wh),
 				})
 			}
 		}
@@ -141,16 +304,72 @@ func (tfs *testFilesystem) IsLocalDir(ctx context.Context, loc ulloc.Location) b
 	return false
 }
 
+func (tfs *testFilesystem) loadTransferState(key string, state interface{}) (bool, error) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	data, ok := tfs.transfers[key]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(data, state)
+}
+
+func (tfs *testFilesystem) saveTransferState(key string, state interface{}) error {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tfs.transfers[key] = data
+	return nil
+}
+
+func (tfs *testFilesystem) removeTransferState(key string) error {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	delete(tfs.transfers, key)
+	return nil
+}
+
+func (tfs *testFilesystem) Transfers() (transfers []string) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	for key := range tfs.transfers {
+		transfers = append(transfers, key)
+	}
+	sort.Strings(transfers)
+	return transfers
+}
+
+func (tfs *testFilesystem) Pending() (pending []string) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	for loc, whs := range tfs.pending {
+		for range whs {
+			pending = append(pending, loc.String())
+		}
+	}
+	sort.Strings(pending)
+	return pending
+}
+
 //
 // ulfs.ReadHandle
 //
 
 type byteReadHandle struct {
 	*bytes.Buffer
+	info ulfs.ObjectInfo
 }
 
 func (b *byteReadHandle) Close() error          { return nil }
-func (b *byteReadHandle) Info() ulfs.ObjectInfo { return ulfs.ObjectInfo{} }
+func (b *byteReadHandle) Info() ulfs.ObjectInfo { return b.info }
 
 //
 // ulfs.WriteHandle
@@ -162,6 +381,9 @@ type memWriteHandle struct {
 	tfs  *testFilesystem
 	cre  int64
 	done bool
+
+	modified time.Time
+	hash     string
 }
 
 func (b *memWriteHandle) Write(p []byte) (int, error) {
@@ -169,6 +391,9 @@ func (b *memWriteHandle) Write(p []byte) (int, error) {
 }
 
 func (b *memWriteHandle) Commit() error {
+	b.tfs.mu.Lock()
+	defer b.tfs.mu.Unlock()
+
 	if err := b.close(); err != nil {
 		return err
 	}
@@ -176,11 +401,16 @@ func (b *memWriteHandle) Commit() error {
 	b.tfs.files[b.loc] = memFileData{
 		contents: b.buf.String(),
 		created:  b.cre,
+		modified: b.modified,
+		hash:     b.hash,
 	}
 	return nil
 }
 
 func (b *memWriteHandle) Abort() error {
+	b.tfs.mu.Lock()
+	defer b.tfs.mu.Unlock()
+
 	if err := b.close(); err != nil {
 		return err
 	}
@@ -211,6 +441,111 @@ func (b *memWriteHandle) close() error {
 	return nil
 }
 
+//
+// ulfs.MultiWriteHandle
+//
+
+type memMultiWriteHandle struct {
+	id string
+	wh *memWriteHandle
+
+	mu    sync.Mutex
+	parts map[int]*memPartWriteHandle
+}
+
+func (m *memMultiWriteHandle) ID() string { return m.id }
+
+func (m *memMultiWriteHandle) Part(ctx context.Context, index int, offset int64) (ulfs.WriteHandle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if part, ok := m.parts[index]; ok && part.committed {
+		return nil, errs.New("part %d already exists", index)
+	}
+
+	part := &memPartWriteHandle{mu: &m.mu, offset: offset}
+	m.parts[index] = part
+	return part, nil
+}
+
+func (m *memMultiWriteHandle) Committed(ctx context.Context) ([]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// local files don't know which of their parts are written.
+	if !m.wh.loc.Remote() {
+		return nil, nil
+	}
+
+	var committed []int
+	for index, part := range m.parts {
+		if part.committed {
+			committed = append(committed, index)
+		}
+	}
+	sort.Ints(committed)
+	return committed, nil
+}
+
+func (m *memMultiWriteHandle) Commit(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	indexes := make([]int, 0, len(m.parts))
+	for index, part := range m.parts {
+		if !part.committed {
+			return errs.New("part %d is not committed", index)
+		}
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	// parts are written at their offsets and may overwrite the data of a resumed
+	// file, but they must not leave any holes.
+	var data []byte
+	for _, index := range indexes {
+		part := m.parts[index]
+		if part.offset > int64(len(data)) {
+			return errs.New("part %d starts at %d after the end %d", index, part.offset, len(data))
+		}
+		data = append(data[:part.offset], part.buf.Bytes()...)
+	}
+	_, _ = m.wh.buf.Write(data)
+
+	m.forget()
+	return m.wh.Commit()
+}
+
+func (m *memMultiWriteHandle) Abort(ctx context.Context) error {
+	m.forget()
+	return m.wh.Abort()
+}
+
+func (m *memMultiWriteHandle) forget() {
+	m.wh.tfs.mu.Lock()
+	defer m.wh.tfs.mu.Unlock()
+
+	delete(m.wh.tfs.multipart, m.id)
+}
+
+type memPartWriteHandle struct {
+	mu        *sync.Mutex
+	buf       bytes.Buffer
+	offset    int64
+	committed bool
+}
+
+func (p *memPartWriteHandle) Write(b []byte) (int, error) { return p.buf.Write(b) }
+func (p *memPartWriteHandle) Abort() error                { return nil }
+
+func (p *memPartWriteHandle) Commit() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.committed = true
+	return nil
+}
+
 //
 // ulfs.ObjectIterator
 //
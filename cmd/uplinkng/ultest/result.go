@@ -20,6 +20,15 @@ type Result struct {
 	Ok     bool
 	Err    error
 	Files  []File
+
+	// ExitCode is the code the uplink command would have exited with.
+	ExitCode int
+
+	// Transfers are the keys of the transfer states left behind.
+	Transfers []string
+
+	// Pending are the locations of the pending uploads left behind.
+	Pending []string
 }
 
 // RequireSuccess fails if the Result did not observe a successful execution.
@@ -52,6 +61,30 @@ func (r Result) RequireStderr(t *testing.T, stderr string) Result {
 	return r
 }
 
+// RequireExitCode requires that the execution would have exited with the provided code.
+func (r Result) RequireExitCode(t *testing.T, code int) Result {
+	require.Equal(t, code, r.ExitCode)
+	return r
+}
+
+// RequireTransfers requires that the keys of the transfer states left behind by the
+// execution are exactly the provided keys.
+func (r Result) RequireTransfers(t *testing.T, keys ...string) Result {
+	keys = append([]string(nil), keys...)
+	sort.Strings(keys)
+	require.Equal(t, keys, append([]string(nil), r.Transfers...))
+	return r
+}
+
+// RequirePending requires that the locations of the pending uploads left behind by the
+// execution are exactly the provided locations.
+func (r Result) RequirePending(t *testing.T, locs ...string) Result {
+	locs = append([]string(nil), locs...)
+	sort.Strings(locs)
+	require.Equal(t, locs, append([]string(nil), r.Pending...))
+	return r
+}
+
 // RequireFiles requires that the set of files provided are all of the files that
 // existed at the end of the execution. It assumes any passed in files with no
 // contents contain the filename as the contents instead.
@@ -6,7 +6,10 @@ package ultest
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/clingy"
@@ -63,6 +66,7 @@ func (st State) Run(t *testing.T, args ...string) Result {
 	var ran bool
 
 	tfs := newTestFilesystem()
+	ex := newExternal(tfs, nil)
 
 	ok, err := clingy.Environment{
 		Name: "uplink-test",
@@ -85,8 +89,12 @@ func (st State) Run(t *testing.T, args ...string) Result {
 			return cmd.Execute(ctx)
 		},
 	}.Run(context.Background(), func(cmds clingy.Commands) {
-		st.cmds(cmds, newExternal(tfs, nil))
+		ex.Setup(cmds)
+		st.cmds(cmds, ex)
 	})
+	if err != nil {
+		ulext.WriteError(&stderr, ex.OutputFormat(), err)
+	}
 
 	if ok && err == nil {
 		require.True(t, ran, "no command was executed: %q", args)
@@ -98,6 +106,11 @@ func (st State) Run(t *testing.T, args ...string) Result {
 		Ok:     ok,
 		Err:    err,
 		Files:  tfs.Files(),
+
+		ExitCode: ulext.ExitCode(ok, err),
+
+		Transfers: tfs.Transfers(),
+		Pending:   tfs.Pending(),
 	}
 }
 
@@ -138,7 +151,7 @@ func WithFile(location string, contents ...string) ExecuteOption {
 			tfs.ensureBucket(bucket)
 		}
 
-		wh, err := tfs.Create(ctx, loc)
+		wh, err := tfs.Create(ctx, loc, nil)
 		require.NoError(t, err)
 		defer func() { _ = wh.Abort() }()
 
@@ -155,6 +168,71 @@ func WithFile(location string, contents ...string) ExecuteOption {
 	}}
 }
 
+// WithModifiedFile sets the command to execute with a file created at the given location
+// with the modification time and, for remote files, the hash of the contents recorded
+// like a synchronized file.
+func WithModifiedFile(location string, modified time.Time, contents string) ExecuteOption {
+	return ExecuteOption{func(t *testing.T, ctx clingy.Context, tfs *testFilesystem) {
+		loc, err := ulloc.Parse(location)
+		require.NoError(t, err)
+
+		if bucket, _, ok := loc.RemoteParts(); ok {
+			tfs.ensureBucket(bucket)
+		}
+
+		sum := sha256.Sum256([]byte(contents))
+		wh, err := tfs.Create(ctx, loc, &ulfs.CreateOptions{
+			Modified: modified,
+			Hash:     hex.EncodeToString(sum[:]),
+		})
+		require.NoError(t, err)
+
+		_, err = wh.Write([]byte(contents))
+		require.NoError(t, err)
+		require.NoError(t, wh.Commit())
+	}}
+}
+
+// WithTransferState sets the command to execute with the state of a transfer saved
+// under the given key.
+func WithTransferState(key string, state interface{}) ExecuteOption {
+	return ExecuteOption{func(t *testing.T, _ clingy.Context, tfs *testFilesystem) {
+		require.NoError(t, tfs.saveTransferState(key, state))
+	}}
+}
+
+// WithPendingParts sets the command to execute with a pending multipart upload to the
+// provided location that has the given parts committed. It returns the id of the
+// upload through the id pointer when the command executes.
+func WithPendingParts(location string, id *string, parts ...string) ExecuteOption {
+	parts = append([]string(nil), parts...)
+	return ExecuteOption{func(t *testing.T, ctx clingy.Context, tfs *testFilesystem) {
+		loc, err := ulloc.Parse(location)
+		require.NoError(t, err)
+
+		if bucket, _, ok := loc.RemoteParts(); ok {
+			tfs.ensureBucket(bucket)
+		}
+
+		mwh, err := tfs.CreateMultipart(ctx, loc)
+		require.NoError(t, err)
+
+		var offset int64
+		for index, part := range parts {
+			wh, err := mwh.Part(ctx, index, offset)
+			require.NoError(t, err)
+
+			_, err = wh.Write([]byte(part))
+			require.NoError(t, err)
+			require.NoError(t, wh.Commit())
+
+			offset += int64(len(part))
+		}
+
+		*id = mwh.ID()
+	}}
+}
+
 // WithPendingFile sets the command to execute with a pending upload happening to
 // the provided location.
 func WithPendingFile(location string) ExecuteOption {
@@ -166,7 +244,7 @@ func WithPendingFile(location string) ExecuteOption {
 			tfs.ensureBucket(bucket)
 		}
 
-		_, err = tfs.Create(ctx, loc)
+		_, err = tfs.Create(ctx, loc, nil)
 		require.NoError(t, err)
 	}}
 }
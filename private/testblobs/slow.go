@@ -90,6 +90,12 @@ func (slow *SlowBlobs) Trash(ctx context.Context, ref storage.BlobRef) error {
 	return slow.blobs.Trash(ctx, ref)
 }
 
+// Quarantine moves the blob with the namespace and key to the quarantine.
+func (slow *SlowBlobs) Quarantine(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) error {
+	slow.sleep()
+	return slow.blobs.Quarantine(ctx, ref, formatVer)
+}
+
 // RestoreTrash restores all files in the trash.
 func (slow *SlowBlobs) RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error) {
 	slow.sleep()
@@ -38,6 +38,7 @@ import (
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/preflight"
 	"storj.io/storj/storagenode/retain"
+	"storj.io/storj/storagenode/scrub"
 	"storj.io/storj/storagenode/storagenodedb"
 	"storj.io/storj/storagenode/trust"
 )
@@ -139,6 +140,11 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 		Collector: collector.Config{
 			Interval: defaultInterval,
 		},
+		Scrub: scrub.Config{
+			// disabled by default, since tests corrupt pieces on purpose to check audits.
+			Enabled:  false,
+			Interval: defaultInterval,
+		},
 		Nodestats: nodestats.Config{
 			MaxSleep:       0,
 			ReputationSync: defaultInterval,
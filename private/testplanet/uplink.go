@@ -146,7 +146,7 @@ func (planet *Planet) newUplink(ctx context.Context, name string) (*Uplink, erro
 		if err != nil {
 			return nil, err
 		}
-		_, apiKey, err := consoleAPI.Service.CreateAPIKey(authCtx, project.ID, "root")
+		_, apiKey, err := consoleAPI.Service.CreateAPIKey(authCtx, project.ID, "root", console.APIKeyLimits{})
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,82 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/lrucache"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// ErrGetBucketLimit error for getting bucket limits from database.
+	ErrGetBucketLimit = errs.Class("get bucket limits")
+	// ErrGetBucketLimitCache error for getting bucket limits from cache.
+	ErrGetBucketLimitCache = errs.Class("get bucket limits from cache")
+)
+
+// BucketLimitDB stores information about buckets limits for storage and bandwidth.
+//
+// architecture: Database
+type BucketLimitDB interface {
+	// GetBucketLimits returns the current storage and bandwidth limits of the bucket.
+	GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (BucketLimits, error)
+}
+
+// BucketLimitCache stores the values for the storage usage and bandwidth limits
+// for each bucket.
+type BucketLimitCache struct {
+	bucketLimitDB BucketLimitDB
+
+	state *lrucache.ExpiringLRU
+}
+
+// NewBucketLimitCache creates a new bucket limit cache to store the bucket limits for each bucket.
+func NewBucketLimitCache(db BucketLimitDB, config ProjectLimitConfig) *BucketLimitCache {
+	return &BucketLimitCache{
+		bucketLimitDB: db,
+		state: lrucache.New(lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+		}),
+	}
+}
+
+// GetBucketLimits returns the current storage and bandwidth limits of the bucket.
+//
+// A bucket which doesn't exist has no limits.
+func (c *BucketLimitCache) GetBucketLimits(ctx context.Context, bucket metabase.BucketLocation) (_ BucketLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := c.bucketLimitDB.GetBucketLimits(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return BucketLimits{}, nil
+		}
+		return BucketLimits{}, ErrGetBucketLimit.Wrap(err)
+	}
+
+	return limits, nil
+}
+
+// Get returns the storage and bandwidth limits of the bucket.
+func (c *BucketLimitCache) Get(ctx context.Context, bucket metabase.BucketLocation) (BucketLimits, error) {
+	fn := func() (interface{}, error) {
+		return c.GetBucketLimits(ctx, bucket)
+	}
+	bucketLimits, err := c.state.Get(string(bucket.Prefix()), fn)
+	if err != nil {
+		return BucketLimits{}, ErrGetBucketLimitCache.Wrap(err)
+	}
+	limits, ok := bucketLimits.(BucketLimits)
+	if !ok {
+		return BucketLimits{}, ErrProjectLimitType.New("cache Get error")
+	}
+	return limits, nil
+}
@@ -17,6 +17,10 @@ type BucketTally struct {
 	TotalBytes    int64
 
 	MetadataSize int64
+
+	// BillableBytes are the total bytes weighted by the redundancy of the bucket
+	// relative to the default redundancy of the satellite.
+	BillableBytes int64
 }
 
 // Combine aggregates all the tallies.
@@ -26,6 +30,7 @@ func (s *BucketTally) Combine(o *BucketTally) {
 	s.TotalSegments += o.TotalSegments
 
 	s.TotalBytes += o.TotalBytes
+	s.BillableBytes += o.BillableBytes
 }
 
 // Segments returns total number of segments.
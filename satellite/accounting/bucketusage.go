@@ -21,9 +21,21 @@ type BucketStorageTally struct {
 	TotalBytes        int64
 
 	MetadataSize int64
+
+	// BillableBytes are the total bytes weighted by the redundancy of the bucket.
+	BillableBytes int64
 }
 
 // Bytes returns total bytes.
 func (s *BucketStorageTally) Bytes() int64 {
 	return s.TotalBytes
 }
+
+// Billable returns the bytes billed for the tally. Tallies created before
+// billable bytes were tracked are billed by their total bytes.
+func (s *BucketStorageTally) Billable() int64 {
+	if s.BillableBytes == 0 {
+		return s.TotalBytes
+	}
+	return s.BillableBytes
+}
@@ -77,10 +77,26 @@ type ProjectUsage struct {
 	Before time.Time `json:"before"`
 }
 
-// ProjectLimits contains the storage and bandwidth limits.
+// ProjectLimits contains the storage, bandwidth, segment and object limits.
 type ProjectLimits struct {
 	Usage     *int64
 	Bandwidth *int64
+	Segments  *int64
+	Objects   *int64
+}
+
+// BucketLimits contains the storage and bandwidth limits of a bucket.
+// A nil limit means that the bucket is only limited by the project limits.
+type BucketLimits struct {
+	Usage     *int64
+	Bandwidth *int64
+}
+
+// Usage contains the live storage usage, segment and object counts of a project.
+type Usage struct {
+	Storage  int64
+	Segments int64
+	Objects  int64
 }
 
 // BucketUsage consist of total bucket usage for period.
@@ -92,6 +108,9 @@ type BucketUsage struct {
 	Egress      float64
 	ObjectCount int64
 
+	StorageLimit *float64
+	EgressLimit  *float64
+
 	Since  time.Time
 	Before time.Time
 }
@@ -183,6 +202,8 @@ type ProjectAccounting interface {
 	GetAllocatedBandwidthTotal(ctx context.Context, projectID uuid.UUID, from time.Time) (int64, error)
 	// GetProjectBandwidth returns project allocated bandwidth for the specified year, month and day.
 	GetProjectBandwidth(ctx context.Context, projectID uuid.UUID, year int, month time.Month, day int, asOfSystemInterval time.Duration) (int64, error)
+	// GetBucketAllocatedBandwidth returns the sum of GET bandwidth usage allocated for a bucket in the past time frame.
+	GetBucketAllocatedBandwidth(ctx context.Context, bucket metabase.BucketLocation, from time.Time) (int64, error)
 	// GetProjectDailyBandwidth returns bandwidth (allocated and settled) for the specified day.
 	GetProjectDailyBandwidth(ctx context.Context, projectID uuid.UUID, year int, month time.Month, day int) (int64, int64, error)
 	// DeleteProjectBandwidthBefore deletes project bandwidth rollups before the given time
@@ -196,7 +217,11 @@ type ProjectAccounting interface {
 	GetProjectStorageLimit(ctx context.Context, projectID uuid.UUID) (*int64, error)
 	// GetProjectBandwidthLimit returns project bandwidth usage limit.
 	GetProjectBandwidthLimit(ctx context.Context, projectID uuid.UUID) (*int64, error)
-	// GetProjectLimits returns current project limit for both storage and bandwidth.
+	// UpdateProjectSegmentLimit updates project segment limit.
+	UpdateProjectSegmentLimit(ctx context.Context, projectID uuid.UUID, limit int64) error
+	// UpdateProjectObjectLimit updates project object limit.
+	UpdateProjectObjectLimit(ctx context.Context, projectID uuid.UUID, limit int64) error
+	// GetProjectLimits returns the current storage, bandwidth, segment and object limits of the project.
 	GetProjectLimits(ctx context.Context, projectID uuid.UUID) (ProjectLimits, error)
 	// GetProjectTotal returns project usage summary for specified period of time.
 	GetProjectTotal(ctx context.Context, projectID uuid.UUID, since, before time.Time) (*ProjectUsage, error)
@@ -245,8 +270,39 @@ type Cache interface {
 	// The projectID is inserted to the spaceUsed when it doesn't exists, hence
 	// this method will never return ErrKeyNotFound.
 	AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, spaceUsed int64) error
-	// GetAllProjectTotals return the total projects' storage used space.
-	GetAllProjectTotals(ctx context.Context) (map[uuid.UUID]int64, error)
+	// GetProjectSegmentUsage returns the project's segment count.
+	GetProjectSegmentUsage(ctx context.Context, projectID uuid.UUID) (currentUsed int64, err error)
+	// AddProjectSegmentUsage adds to the project's segment count the increment.
+	// The projectID is inserted to the increment when it doesn't exists, hence
+	// this method will never return ErrKeyNotFound.
+	AddProjectSegmentUsage(ctx context.Context, projectID uuid.UUID, increment int64) error
+	// GetProjectObjectUsage returns the project's object count.
+	GetProjectObjectUsage(ctx context.Context, projectID uuid.UUID) (currentUsed int64, err error)
+	// AddProjectObjectUsage adds to the project's object count the increment.
+	// The projectID is inserted to the increment when it doesn't exists, hence
+	// this method will never return ErrKeyNotFound.
+	AddProjectObjectUsage(ctx context.Context, projectID uuid.UUID, increment int64) error
+	// GetAllProjectTotals return the total projects' storage used space, segment
+	// and object counts.
+	GetAllProjectTotals(ctx context.Context) (map[uuid.UUID]Usage, error)
+	// GetBucketStorageUsage returns the bucket's storage usage.
+	GetBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (totalUsed int64, err error)
+	// AddBucketStorageUsage adds to the bucket's storage usage the spaceUsed.
+	// The bucket is inserted to the spaceUsed when it doesn't exists, hence this
+	// method will never return ErrKeyNotFound.
+	AddBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation, spaceUsed int64) error
+	// GetBucketBandwidthUsage returns the bucket's bandwidth usage.
+	GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error)
+	// UpdateBucketBandwidthUsage updates the bucket's bandwidth usage increasing
+	// it. The bucket is inserted to the increment when it doesn't exists, hence
+	// this method will never return ErrKeyNotFound.
+	UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) error
+	// GetAllBucketTotals returns the total buckets' storage used space.
+	GetAllBucketTotals(ctx context.Context) (map[metabase.BucketLocation]int64, error)
+	// IncrementAPIKeyRequests increments the number of requests made with the
+	// API key on the day of now and returns the updated number. The count is
+	// kept for ttl since the first request of the day.
+	IncrementAPIKeyRequests(ctx context.Context, apiKeyID uuid.UUID, ttl time.Duration, now time.Time) (count int64, err error)
 	// Close the client, releasing any open resources. Once it's called any other
 	// method must be called.
 	Close() error
@@ -23,7 +23,7 @@ var (
 
 // Config contains configurable values for the live accounting service.
 type Config struct {
-	StorageBackend     string        `help:"what to use for storing real-time accounting data"`
+	StorageBackend     string        `help:"what to use for storing real-time accounting data (redis://, postgres:// or cockroach:// of the satellite database, or memory:// when all satellite components run in one process)"`
 	BandwidthCacheTTL  time.Duration `default:"5m" help:"bandwidth cache key time to live"`
 	AsOfSystemInterval time.Duration `default:"-10s" help:"as of system interval"`
 }
@@ -50,7 +50,11 @@ func OpenCache(ctx context.Context, log *zap.Logger, config Config) (accounting.
 	switch backendType {
 	case "redis":
 		return openRedisLiveAccounting(ctx, config.StorageBackend)
+	case "postgres", "postgresql", "cockroach":
+		return openDatabaseLiveAccounting(ctx, log, config.StorageBackend)
+	case "memory":
+		return openMemoryLiveAccounting(), nil
 	default:
-		return nil, Error.New("unrecognized live accounting backend specifier %q. Currently only redis is supported", backendType)
+		return nil, Error.New("unrecognized live accounting backend specifier %q. Currently redis, postgres, cockroach and memory are supported", backendType)
 	}
 }
@@ -0,0 +1,403 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package live
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	_ "github.com/jackc/pgx/v4/stdlib" // registers pgx as a tagsql driver.
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil"
+	_ "storj.io/private/dbutil/cockroachutil" // registers cockroach as a tagsql driver.
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metabase"
+)
+
+// databaseLiveAccounting keeps the live accounting data in the satellite
+// database, which tables are created by the satellite database migrations.
+type databaseLiveAccounting struct {
+	db tagsql.DB
+}
+
+// openDatabaseLiveAccounting returns a databaseLiveAccounting cache instance.
+//
+// It returns accounting.ErrInvalidArgument if the connection string isn't
+// for a supported database.
+func openDatabaseLiveAccounting(ctx context.Context, log *zap.Logger, connstr string) (_ *databaseLiveAccounting, err error) {
+	_, _, impl, err := dbutil.SplitConnStr(connstr)
+	if err != nil {
+		return nil, accounting.ErrInvalidArgument.New("address: %w", err)
+	}
+
+	var driverName string
+	switch impl {
+	case dbutil.Postgres:
+		driverName = "pgx"
+	case dbutil.Cockroach:
+		driverName = "cockroach"
+	default:
+		return nil, accounting.ErrInvalidArgument.New("address: unsupported database %q", impl)
+	}
+
+	db, err := tagsql.Open(ctx, driverName, connstr)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("database open failed: %w", err)
+	}
+	dbutil.Configure(ctx, db, "live-accounting", mon)
+
+	log.Debug("Connected", zap.String("db source", connstr))
+
+	return &databaseLiveAccounting{db: db}, nil
+}
+
+// GetProjectStorageUsage gets inline and remote storage totals for a given
+// project, back to the time of the last accounting tally.
+func (cache *databaseLiveAccounting) GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (totalUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	return cache.getTotal(ctx, "live_accounting_storage", projectID)
+}
+
+// GetProjectBandwidthUsage returns the current bandwidth usage
+// from specific project.
+func (cache *databaseLiveAccounting) GetProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID, now)(&err)
+
+	err = cache.db.QueryRowContext(ctx, `
+		SELECT used FROM live_accounting_bandwidth
+		WHERE project_id = $1 AND interval_day = $2 AND expires_at > $3
+	`, projectID, intervalDay(now), time.Now()).Scan(&currentUsed)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, accounting.ErrKeyNotFound.New("%q bandwidth", projectID)
+		}
+		return 0, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+
+	return currentUsed, nil
+}
+
+// UpdateProjectBandwidthUsage increment the bandwidth cache key value.
+//
+// The expiration is set only when the key is created, the same as the other
+// backends.
+func (cache *databaseLiveAccounting) UpdateProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, projectID, increment, ttl, now)(&err)
+
+	current := time.Now()
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO live_accounting_bandwidth (project_id, interval_day, used, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id, interval_day) DO UPDATE SET
+			used = CASE WHEN live_accounting_bandwidth.expires_at > $5
+				THEN live_accounting_bandwidth.used + EXCLUDED.used
+				ELSE EXCLUDED.used END,
+			expires_at = CASE WHEN live_accounting_bandwidth.expires_at > $5
+				THEN live_accounting_bandwidth.expires_at
+				ELSE EXCLUDED.expires_at END
+	`, projectID, intervalDay(now), increment, current.Add(ttl), current)
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("database update failed: %w", err)
+	}
+
+	return nil
+}
+
+// AddProjectStorageUsage lets the live accounting know that the given
+// project has just added spaceUsed bytes of storage (from the user's
+// perspective; i.e. segment size).
+func (cache *databaseLiveAccounting) AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, spaceUsed int64) (err error) {
+	defer mon.Task()(&ctx, projectID, spaceUsed)(&err)
+
+	return cache.addTotal(ctx, "live_accounting_storage", projectID, spaceUsed)
+}
+
+// GetProjectSegmentUsage returns the current segment count of the project.
+func (cache *databaseLiveAccounting) GetProjectSegmentUsage(ctx context.Context, projectID uuid.UUID) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	return cache.getTotal(ctx, "live_accounting_segments", projectID)
+}
+
+// AddProjectSegmentUsage increments the segment count of the project.
+func (cache *databaseLiveAccounting) AddProjectSegmentUsage(ctx context.Context, projectID uuid.UUID, increment int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	return cache.addTotal(ctx, "live_accounting_segments", projectID, increment)
+}
+
+// GetProjectObjectUsage returns the current object count of the project.
+func (cache *databaseLiveAccounting) GetProjectObjectUsage(ctx context.Context, projectID uuid.UUID) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	return cache.getTotal(ctx, "live_accounting_objects", projectID)
+}
+
+// AddProjectObjectUsage increments the object count of the project.
+func (cache *databaseLiveAccounting) AddProjectObjectUsage(ctx context.Context, projectID uuid.UUID, increment int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	return cache.addTotal(ctx, "live_accounting_objects", projectID, increment)
+}
+
+// GetAllProjectTotals returns a map of project IDs and totals.
+//
+// It also removes the expired bandwidth usage, since it's called periodically
+// by the tally.
+func (cache *databaseLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ map[uuid.UUID]accounting.Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	_, err = cache.db.ExecContext(ctx, `
+		DELETE FROM live_accounting_bandwidth WHERE expires_at <= $1
+	`, now)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("database delete failed: %w", err)
+	}
+	_, err = cache.db.ExecContext(ctx, `
+		DELETE FROM live_accounting_bucket_bandwidth WHERE expires_at <= $1
+	`, now)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("database delete failed: %w", err)
+	}
+	_, err = cache.db.ExecContext(ctx, `
+		DELETE FROM live_accounting_api_key_requests WHERE expires_at <= $1
+	`, now)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("database delete failed: %w", err)
+	}
+
+	projects := make(map[uuid.UUID]accounting.Usage)
+	err = cache.readTotals(ctx, "live_accounting_storage", projects,
+		func(usage *accounting.Usage, value int64) { usage.Storage = value })
+	if err != nil {
+		return nil, err
+	}
+	err = cache.readTotals(ctx, "live_accounting_segments", projects,
+		func(usage *accounting.Usage, value int64) { usage.Segments = value })
+	if err != nil {
+		return nil, err
+	}
+	err = cache.readTotals(ctx, "live_accounting_objects", projects,
+		func(usage *accounting.Usage, value int64) { usage.Objects = value })
+	if err != nil {
+		return nil, err
+	}
+
+	return projects, nil
+}
+
+// GetBucketStorageUsage returns the current storage usage of the bucket.
+func (cache *databaseLiveAccounting) GetBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (totalUsed int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = cache.db.QueryRowContext(ctx, `
+		SELECT total FROM live_accounting_bucket_storage
+		WHERE project_id = $1 AND bucket_name = $2
+	`, bucket.ProjectID, []byte(bucket.BucketName)).Scan(&totalUsed)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, accounting.ErrKeyNotFound.New("%q", bucket.Prefix())
+		}
+		return 0, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+
+	return totalUsed, nil
+}
+
+// AddBucketStorageUsage lets the live accounting know that the given bucket
+// has just added spaceUsed bytes of storage.
+func (cache *databaseLiveAccounting) AddBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation, spaceUsed int64) (err error) {
+	defer mon.Task()(&ctx, spaceUsed)(&err)
+
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO live_accounting_bucket_storage (project_id, bucket_name, total) VALUES ($1, $2, $3)
+		ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+			total = live_accounting_bucket_storage.total + EXCLUDED.total
+	`, bucket.ProjectID, []byte(bucket.BucketName), spaceUsed)
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("database update failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetBucketBandwidthUsage returns the current bandwidth usage of the bucket.
+func (cache *databaseLiveAccounting) GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, now)(&err)
+
+	err = cache.db.QueryRowContext(ctx, `
+		SELECT used FROM live_accounting_bucket_bandwidth
+		WHERE project_id = $1 AND bucket_name = $2 AND interval_day = $3 AND expires_at > $4
+	`, bucket.ProjectID, []byte(bucket.BucketName), intervalDay(now), time.Now()).Scan(&currentUsed)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, accounting.ErrKeyNotFound.New("%q bandwidth", bucket.Prefix())
+		}
+		return 0, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+
+	return currentUsed, nil
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth usage of the bucket.
+//
+// The expiration is set only when the row is created, the same as for the
+// project bandwidth.
+func (cache *databaseLiveAccounting) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, increment, ttl, now)(&err)
+
+	current := time.Now()
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO live_accounting_bucket_bandwidth (project_id, bucket_name, interval_day, used, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (project_id, bucket_name, interval_day) DO UPDATE SET
+			used = CASE WHEN live_accounting_bucket_bandwidth.expires_at > $6
+				THEN live_accounting_bucket_bandwidth.used + EXCLUDED.used
+				ELSE EXCLUDED.used END,
+			expires_at = CASE WHEN live_accounting_bucket_bandwidth.expires_at > $6
+				THEN live_accounting_bucket_bandwidth.expires_at
+				ELSE EXCLUDED.expires_at END
+	`, bucket.ProjectID, []byte(bucket.BucketName), intervalDay(now), increment, current.Add(ttl), current)
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("database update failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetAllBucketTotals returns a map of buckets and storage totals.
+func (cache *databaseLiveAccounting) GetAllBucketTotals(ctx context.Context) (_ map[metabase.BucketLocation]int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.QueryContext(ctx, `
+		SELECT project_id, bucket_name, total FROM live_accounting_bucket_storage
+	`)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	buckets := make(map[metabase.BucketLocation]int64)
+	for rows.Next() {
+		var bucket metabase.BucketLocation
+		var bucketName []byte
+		var total int64
+		if err := rows.Scan(&bucket.ProjectID, &bucketName, &total); err != nil {
+			return nil, accounting.ErrUnexpectedValue.New("cannot parse the row: %w", err)
+		}
+
+		bucket.BucketName = string(bucketName)
+		buckets[bucket] = total
+	}
+	if err := rows.Err(); err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+
+	return buckets, nil
+}
+
+// Close the DB connection.
+func (cache *databaseLiveAccounting) Close() error {
+	err := cache.db.Close()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("database close failed: %w", err)
+	}
+
+	return nil
+}
+
+// getTotal returns the total of the project stored in the table.
+func (cache *databaseLiveAccounting) getTotal(ctx context.Context, table string, projectID uuid.UUID) (total int64, err error) {
+	err = cache.db.QueryRowContext(ctx, `
+		SELECT total FROM `+table+` WHERE project_id = $1
+	`, projectID).Scan(&total)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, accounting.ErrKeyNotFound.New("%q", projectID)
+		}
+		return 0, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+
+	return total, nil
+}
+
+// addTotal adds the increment to the total of the project stored in the table.
+func (cache *databaseLiveAccounting) addTotal(ctx context.Context, table string, projectID uuid.UUID, increment int64) (err error) {
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO `+table+` (project_id, total) VALUES ($1, $2)
+		ON CONFLICT (project_id) DO UPDATE SET
+			total = `+table+`.total + EXCLUDED.total
+	`, projectID, increment)
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("database update failed: %w", err)
+	}
+
+	return nil
+}
+
+// readTotals reads the totals of all the projects stored in the table into projects.
+func (cache *databaseLiveAccounting) readTotals(ctx context.Context, table string, projects map[uuid.UUID]accounting.Usage, setValue func(usage *accounting.Usage, value int64)) (err error) {
+	rows, err := cache.db.QueryContext(ctx, `SELECT project_id, total FROM `+table)
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var projectID uuid.UUID
+		var total int64
+		if err := rows.Scan(&projectID, &total); err != nil {
+			return accounting.ErrUnexpectedValue.New("cannot parse the row: %w", err)
+		}
+
+		usage := projects[projectID]
+		setValue(&usage, total)
+		projects[projectID] = usage
+	}
+	if err := rows.Err(); err != nil {
+		return accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+
+	return nil
+}
+
+// IncrementAPIKeyRequests increments the number of requests made with the
+// API key on the day of now and returns the updated number.
+func (cache *databaseLiveAccounting) IncrementAPIKeyRequests(ctx context.Context, apiKeyID uuid.UUID, ttl time.Duration, now time.Time) (count int64, err error) {
+	defer mon.Task()(&ctx, apiKeyID, ttl, now)(&err)
+
+	current := time.Now()
+	err = cache.db.QueryRowContext(ctx, `
+		INSERT INTO live_accounting_api_key_requests (api_key_id, interval_day, count, expires_at)
+		VALUES ($1, $2, 1, $3)
+		ON CONFLICT (api_key_id, interval_day) DO UPDATE SET
+			count = CASE WHEN live_accounting_api_key_requests.expires_at > $4
+				THEN live_accounting_api_key_requests.count + 1
+				ELSE 1 END,
+			expires_at = CASE WHEN live_accounting_api_key_requests.expires_at > $4
+				THEN live_accounting_api_key_requests.expires_at
+				ELSE EXCLUDED.expires_at END
+		RETURNING count
+	`, apiKeyID, intervalDay(now), current.Add(ttl), current).Scan(&count)
+	if err != nil {
+		return 0, accounting.ErrSystemOrNetError.New("database update failed: %w", err)
+	}
+
+	return count, nil
+}
+
+// intervalDay returns the day used for keeping track of the bandwidth usage.
+// It uses the date of now, the same as the other backends.
+func intervalDay(now time.Time) time.Time {
+	year, month, day := now.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
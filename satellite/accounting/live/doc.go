@@ -5,5 +5,9 @@
 Package live provides live accounting functionality. That is, it keeps track
 of deltas in the amount of storage used by each project relative to the last
 tally operation (see satellite/accounting/tally).
+
+The data can be stored in Redis, in a Postgres or CockroachDB database, or in
+the memory of the process. The memory backend isn't shared between processes,
+so it's only suitable when all the satellite components run in one process.
 */
 package live
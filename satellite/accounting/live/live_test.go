@@ -17,9 +17,13 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgtest"
+	"storj.io/private/dbutil/tempdb"
 	"storj.io/storj/private/testredis"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/accounting/live"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb"
 )
 
 func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
@@ -29,6 +33,15 @@ func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "memory",
+		},
+		{
+			backend: "postgres",
+		},
+		{
+			backend: "cockroach",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -42,11 +55,11 @@ func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
 		t.Run(tt.backend, func(t *testing.T) {
 			ctx := testcontext.New(t)
 
-			var config live.Config
-			if tt.backend == "redis" {
-				config = live.Config{
-					StorageBackend: "redis://" + redis.Addr() + "?db=0",
-				}
+			backend, closeBackend := storageBackend(ctx, t, tt.backend, redis.Addr())
+			defer ctx.Check(closeBackend)
+
+			config := live.Config{
+				StorageBackend: backend,
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -103,6 +116,15 @@ func TestGetAllProjectTotals(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "memory",
+		},
+		{
+			backend: "postgres",
+		},
+		{
+			backend: "cockroach",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -116,11 +138,11 @@ func TestGetAllProjectTotals(t *testing.T) {
 		t.Run(tt.backend, func(t *testing.T) {
 			ctx := testcontext.New(t)
 
-			var config live.Config
-			if tt.backend == "redis" {
-				config = live.Config{
-					StorageBackend: "redis://" + redis.Addr() + "?db=0",
-				}
+			backend, closeBackend := storageBackend(ctx, t, tt.backend, redis.Addr())
+			defer ctx.Check(closeBackend)
+
+			config := live.Config{
+				StorageBackend: backend,
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -132,6 +154,14 @@ func TestGetAllProjectTotals(t *testing.T) {
 				projectIDs[i] = testrand.UUID()
 				err := cache.AddProjectStorageUsage(ctx, projectIDs[i], int64(i))
 				require.NoError(t, err)
+
+				// only some of the projects have segments and objects
+				if i%2 == 0 {
+					err = cache.AddProjectSegmentUsage(ctx, projectIDs[i], int64(i+1))
+					require.NoError(t, err)
+					err = cache.AddProjectObjectUsage(ctx, projectIDs[i], int64(i+2))
+					require.NoError(t, err)
+				}
 			}
 
 			projectTotals, err := cache.GetAllProjectTotals(ctx)
@@ -139,10 +169,26 @@ func TestGetAllProjectTotals(t *testing.T) {
 			require.Len(t, projectTotals, len(projectIDs))
 
 			// make sure each project ID and total was received
-			for _, projID := range projectIDs {
+			for i, projID := range projectIDs {
 				total, err := cache.GetProjectStorageUsage(ctx, projID)
 				require.NoError(t, err)
-				assert.Equal(t, total, projectTotals[projID])
+				assert.Equal(t, total, projectTotals[projID].Storage)
+
+				if i%2 != 0 {
+					assert.Zero(t, projectTotals[projID].Segments)
+					assert.Zero(t, projectTotals[projID].Objects)
+					continue
+				}
+
+				segments, err := cache.GetProjectSegmentUsage(ctx, projID)
+				require.NoError(t, err)
+				assert.Equal(t, int64(i+1), segments)
+				assert.Equal(t, segments, projectTotals[projID].Segments)
+
+				objects, err := cache.GetProjectObjectUsage(ctx, projID)
+				require.NoError(t, err)
+				assert.Equal(t, int64(i+2), objects)
+				assert.Equal(t, objects, projectTotals[projID].Objects)
 			}
 		})
 	}
@@ -155,6 +201,15 @@ func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "memory",
+		},
+		{
+			backend: "postgres",
+		},
+		{
+			backend: "cockroach",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -169,11 +224,11 @@ func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 		t.Run(tt.backend, func(t *testing.T) {
 			ctx := testcontext.New(t)
 
-			var config live.Config
-			if tt.backend == "redis" {
-				config = live.Config{
-					StorageBackend: "redis://" + redis.Addr() + "?db=0",
-				}
+			backend, closeBackend := storageBackend(ctx, t, tt.backend, redis.Addr())
+			defer ctx.Check(closeBackend)
+
+			config := live.Config{
+				StorageBackend: backend,
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -198,6 +253,196 @@ func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 	}
 }
 
+func TestBucketStorageAndBandwidthUsage(t *testing.T) {
+	tests := []struct {
+		backend string
+	}{
+		{
+			backend: "redis",
+		},
+		{
+			backend: "memory",
+		},
+		{
+			backend: "postgres",
+		},
+		{
+			backend: "cockroach",
+		},
+	}
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	redis, err := testredis.Start(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(redis.Close)
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.backend, func(t *testing.T) {
+			ctx := testcontext.New(t)
+
+			backend, closeBackend := storageBackend(ctx, t, tt.backend, redis.Addr())
+			defer ctx.Check(closeBackend)
+
+			config := live.Config{
+				StorageBackend: backend,
+			}
+
+			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
+			require.NoError(t, err)
+			defer ctx.Check(cache.Close)
+
+			projectID := testrand.UUID()
+			buckets := []metabase.BucketLocation{
+				{ProjectID: projectID, BucketName: "first"},
+				{ProjectID: projectID, BucketName: "second"},
+			}
+
+			_, err = cache.GetBucketStorageUsage(ctx, buckets[0])
+			require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+			for i, bucket := range buckets {
+				require.NoError(t, cache.AddBucketStorageUsage(ctx, bucket, int64(i+1)*100))
+				require.NoError(t, cache.AddBucketStorageUsage(ctx, bucket, 10))
+			}
+			require.NoError(t, cache.AddProjectStorageUsage(ctx, projectID, 330))
+
+			for i, bucket := range buckets {
+				total, err := cache.GetBucketStorageUsage(ctx, bucket)
+				require.NoError(t, err)
+				assert.Equal(t, int64(i+1)*100+10, total)
+			}
+
+			bucketTotals, err := cache.GetAllBucketTotals(ctx)
+			require.NoError(t, err)
+			require.Equal(t, map[metabase.BucketLocation]int64{
+				buckets[0]: 110,
+				buckets[1]: 210,
+			}, bucketTotals)
+
+			// the bucket keys must not be reported as projects
+			projectTotals, err := cache.GetAllProjectTotals(ctx)
+			require.NoError(t, err)
+			require.Len(t, projectTotals, 1)
+			assert.Equal(t, int64(330), projectTotals[projectID].Storage)
+
+			now := time.Now()
+			_, err = cache.GetBucketBandwidthUsage(ctx, buckets[0], now)
+			require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+			require.NoError(t, cache.UpdateBucketBandwidthUsage(ctx, buckets[0], 100, time.Hour, now))
+			require.NoError(t, cache.UpdateBucketBandwidthUsage(ctx, buckets[0], 50, time.Hour, now))
+
+			bandwidth, err := cache.GetBucketBandwidthUsage(ctx, buckets[0], now)
+			require.NoError(t, err)
+			assert.Equal(t, int64(150), bandwidth)
+
+			_, err = cache.GetBucketBandwidthUsage(ctx, buckets[1], now)
+			require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+			// bandwidth keys are not storage totals
+			bucketTotals, err = cache.GetAllBucketTotals(ctx)
+			require.NoError(t, err)
+			require.Len(t, bucketTotals, 2)
+		})
+	}
+}
+
+func TestIncrementAPIKeyRequests(t *testing.T) {
+	tests := []struct {
+		backend string
+	}{
+		{
+			backend: "redis",
+		},
+		{
+			backend: "memory",
+		},
+		{
+			backend: "postgres",
+		},
+		{
+			backend: "cockroach",
+		},
+	}
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	redis, err := testredis.Start(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(redis.Close)
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.backend, func(t *testing.T) {
+			ctx := testcontext.New(t)
+
+			backend, closeBackend := storageBackend(ctx, t, tt.backend, redis.Addr())
+			defer ctx.Check(closeBackend)
+
+			config := live.Config{
+				StorageBackend: backend,
+			}
+
+			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
+			require.NoError(t, err)
+			defer ctx.Check(cache.Close)
+
+			apiKeyID := testrand.UUID()
+			now := time.Now()
+
+			for i := int64(1); i <= 3; i++ {
+				count, err := cache.IncrementAPIKeyRequests(ctx, apiKeyID, time.Hour, now)
+				require.NoError(t, err)
+				require.Equal(t, i, count)
+			}
+
+			// the requests are counted per day
+			count, err := cache.IncrementAPIKeyRequests(ctx, apiKeyID, time.Hour, now.AddDate(0, 0, 1))
+			require.NoError(t, err)
+			require.EqualValues(t, 1, count)
+
+			// the request counts aren't project totals
+			projectTotals, err := cache.GetAllProjectTotals(ctx)
+			require.NoError(t, err)
+			require.Empty(t, projectTotals)
+		})
+	}
+}
+
+// storageBackend returns the storage backend address for the backend type and
+// a function for cleaning it up. It skips the test when the backend isn't
+// available.
+func storageBackend(ctx *testcontext.Context, t *testing.T, backend, redisAddr string) (string, func() error) {
+	noop := func() error { return nil }
+
+	var connstr string
+	switch backend {
+	case "redis":
+		return "redis://" + redisAddr + "?db=0", noop
+	case "memory":
+		return "memory://", noop
+	case "postgres":
+		connstr = pgtest.PickPostgres(t)
+	case "cockroach":
+		connstr = pgtest.PickCockroach(t)
+	default:
+		t.Fatalf("unknown backend %q", backend)
+	}
+
+	db, err := tempdb.OpenUnique(ctx, connstr, "live-accounting")
+	require.NoError(t, err)
+
+	// the live accounting tables are created by the satellite database migrations.
+	satelliteDB, err := satellitedb.Open(ctx, zaptest.NewLogger(t), db.ConnStr, satellitedb.Options{ApplicationName: "live-accounting-test"})
+	require.NoError(t, err)
+	require.NoError(t, satelliteDB.TestingMigrateToLatest(ctx))
+	require.NoError(t, satelliteDB.Close())
+
+	return db.ConnStr, db.Close
+}
+
 type populateCacheData struct {
 	projectID    uuid.UUID
 	storageSum   int64
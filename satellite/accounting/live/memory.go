@@ -0,0 +1,344 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package live
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metabase"
+)
+
+// memoryLiveAccounting keeps the live accounting data in the memory of the
+// process.
+//
+// The data isn't shared between processes, so it's only suitable when all the
+// satellite components which use live accounting run in the same process.
+type memoryLiveAccounting struct {
+	mu        sync.Mutex
+	storage   map[uuid.UUID]int64
+	segments  map[uuid.UUID]int64
+	objects   map[uuid.UUID]int64
+	bandwidth map[bandwidthKey]*bandwidthUsage
+
+	bucketStorage   map[metabase.BucketLocation]int64
+	bucketBandwidth map[bucketBandwidthKey]*bandwidthUsage
+
+	apiKeyRequests map[bandwidthKey]*bandwidthUsage
+}
+
+// bandwidthKey identifies the bandwidth usage of a project on a specific day.
+type bandwidthKey struct {
+	projectID uuid.UUID
+	month     time.Month
+	day       int
+}
+
+// bandwidthUsage is the bandwidth used on a specific day and when it expires.
+type bandwidthUsage struct {
+	used      int64
+	expiresAt time.Time
+}
+
+func newBandwidthKey(projectID uuid.UUID, now time.Time) bandwidthKey {
+	_, month, day := now.Date()
+	return bandwidthKey{projectID: projectID, month: month, day: day}
+}
+
+// bucketBandwidthKey identifies the bandwidth usage of a bucket on a specific day.
+type bucketBandwidthKey struct {
+	bucket metabase.BucketLocation
+	month  time.Month
+	day    int
+}
+
+func newBucketBandwidthKey(bucket metabase.BucketLocation, now time.Time) bucketBandwidthKey {
+	_, month, day := now.Date()
+	return bucketBandwidthKey{bucket: bucket, month: month, day: day}
+}
+
+// openMemoryLiveAccounting returns a memoryLiveAccounting cache instance.
+func openMemoryLiveAccounting() *memoryLiveAccounting {
+	return &memoryLiveAccounting{
+		storage:   make(map[uuid.UUID]int64),
+		segments:  make(map[uuid.UUID]int64),
+		objects:   make(map[uuid.UUID]int64),
+		bandwidth: make(map[bandwidthKey]*bandwidthUsage),
+
+		bucketStorage:   make(map[metabase.BucketLocation]int64),
+		bucketBandwidth: make(map[bucketBandwidthKey]*bandwidthUsage),
+
+		apiKeyRequests: make(map[bandwidthKey]*bandwidthUsage),
+	}
+}
+
+// GetProjectStorageUsage gets inline and remote storage totals for a given
+// project, back to the time of the last accounting tally.
+func (cache *memoryLiveAccounting) GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (totalUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	totalUsed, ok := cache.storage[projectID]
+	if !ok {
+		return 0, accounting.ErrKeyNotFound.New("%q", projectID)
+	}
+	return totalUsed, nil
+}
+
+// GetProjectBandwidthUsage returns the current bandwidth usage
+// from specific project.
+func (cache *memoryLiveAccounting) GetProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID, now)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	key := newBandwidthKey(projectID, now)
+	usage, ok := cache.bandwidth[key]
+	if !ok || !usage.expiresAt.After(time.Now()) {
+		return 0, accounting.ErrKeyNotFound.New("%q bandwidth", projectID)
+	}
+	return usage.used, nil
+}
+
+// UpdateProjectBandwidthUsage increment the bandwidth cache key value.
+//
+// The expiration is set only when the key is created, the same as the other
+// backends.
+func (cache *memoryLiveAccounting) UpdateProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, projectID, increment, ttl, now)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	key := newBandwidthKey(projectID, now)
+	usage, ok := cache.bandwidth[key]
+	if !ok || !usage.expiresAt.After(time.Now()) {
+		cache.bandwidth[key] = &bandwidthUsage{
+			used:      increment,
+			expiresAt: time.Now().Add(ttl),
+		}
+		return nil
+	}
+
+	usage.used += increment
+	return nil
+}
+
+// AddProjectStorageUsage lets the live accounting know that the given
+// project has just added spaceUsed bytes of storage (from the user's
+// perspective; i.e. segment size).
+func (cache *memoryLiveAccounting) AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, spaceUsed int64) (err error) {
+	defer mon.Task()(&ctx, projectID, spaceUsed)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.storage[projectID] += spaceUsed
+	return nil
+}
+
+// GetProjectSegmentUsage returns the current segment count of the project.
+func (cache *memoryLiveAccounting) GetProjectSegmentUsage(ctx context.Context, projectID uuid.UUID) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	currentUsed, ok := cache.segments[projectID]
+	if !ok {
+		return 0, accounting.ErrKeyNotFound.New("%q segments", projectID)
+	}
+	return currentUsed, nil
+}
+
+// AddProjectSegmentUsage increments the segment count of the project.
+func (cache *memoryLiveAccounting) AddProjectSegmentUsage(ctx context.Context, projectID uuid.UUID, increment int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.segments[projectID] += increment
+	return nil
+}
+
+// GetProjectObjectUsage returns the current object count of the project.
+func (cache *memoryLiveAccounting) GetProjectObjectUsage(ctx context.Context, projectID uuid.UUID) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	currentUsed, ok := cache.objects[projectID]
+	if !ok {
+		return 0, accounting.ErrKeyNotFound.New("%q objects", projectID)
+	}
+	return currentUsed, nil
+}
+
+// AddProjectObjectUsage increments the object count of the project.
+func (cache *memoryLiveAccounting) AddProjectObjectUsage(ctx context.Context, projectID uuid.UUID, increment int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.objects[projectID] += increment
+	return nil
+}
+
+// GetAllProjectTotals returns a map of project IDs and totals.
+//
+// It also removes the expired bandwidth usage, since it's called periodically
+// by the tally.
+func (cache *memoryLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ map[uuid.UUID]accounting.Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	projects := make(map[uuid.UUID]accounting.Usage, len(cache.storage))
+	for projectID, total := range cache.storage {
+		usage := projects[projectID]
+		usage.Storage = total
+		projects[projectID] = usage
+	}
+	for projectID, total := range cache.segments {
+		usage := projects[projectID]
+		usage.Segments = total
+		projects[projectID] = usage
+	}
+	for projectID, total := range cache.objects {
+		usage := projects[projectID]
+		usage.Objects = total
+		projects[projectID] = usage
+	}
+
+	now := time.Now()
+	for key, usage := range cache.bandwidth {
+		if !usage.expiresAt.After(now) {
+			delete(cache.bandwidth, key)
+		}
+	}
+	for key, usage := range cache.bucketBandwidth {
+		if !usage.expiresAt.After(now) {
+			delete(cache.bucketBandwidth, key)
+		}
+	}
+	for key, usage := range cache.apiKeyRequests {
+		if !usage.expiresAt.After(now) {
+			delete(cache.apiKeyRequests, key)
+		}
+	}
+
+	return projects, nil
+}
+
+// GetBucketStorageUsage returns the current storage usage of the bucket.
+func (cache *memoryLiveAccounting) GetBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (totalUsed int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	totalUsed, ok := cache.bucketStorage[bucket]
+	if !ok {
+		return 0, accounting.ErrKeyNotFound.New("%q", bucket.Prefix())
+	}
+	return totalUsed, nil
+}
+
+// AddBucketStorageUsage lets the live accounting know that the given bucket
+// has just added spaceUsed bytes of storage.
+func (cache *memoryLiveAccounting) AddBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation, spaceUsed int64) (err error) {
+	defer mon.Task()(&ctx, spaceUsed)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.bucketStorage[bucket] += spaceUsed
+	return nil
+}
+
+// GetBucketBandwidthUsage returns the current bandwidth usage of the bucket.
+func (cache *memoryLiveAccounting) GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, now)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	usage, ok := cache.bucketBandwidth[newBucketBandwidthKey(bucket, now)]
+	if !ok || !usage.expiresAt.After(time.Now()) {
+		return 0, accounting.ErrKeyNotFound.New("%q bandwidth", bucket.Prefix())
+	}
+	return usage.used, nil
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth usage of the bucket.
+func (cache *memoryLiveAccounting) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, increment, ttl, now)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	key := newBucketBandwidthKey(bucket, now)
+	usage, ok := cache.bucketBandwidth[key]
+	if !ok || !usage.expiresAt.After(time.Now()) {
+		cache.bucketBandwidth[key] = &bandwidthUsage{
+			used:      increment,
+			expiresAt: time.Now().Add(ttl),
+		}
+		return nil
+	}
+
+	usage.used += increment
+	return nil
+}
+
+// GetAllBucketTotals returns a map of buckets and storage totals.
+func (cache *memoryLiveAccounting) GetAllBucketTotals(ctx context.Context) (_ map[metabase.BucketLocation]int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	buckets := make(map[metabase.BucketLocation]int64, len(cache.bucketStorage))
+	for bucket, total := range cache.bucketStorage {
+		buckets[bucket] = total
+	}
+	return buckets, nil
+}
+
+// IncrementAPIKeyRequests increments the number of requests made with the
+// API key on the day of now and returns the updated number.
+//
+// The requests are kept the same way as the project bandwidth, the key is
+// identified by the API key ID instead of the project ID.
+func (cache *memoryLiveAccounting) IncrementAPIKeyRequests(ctx context.Context, apiKeyID uuid.UUID, ttl time.Duration, now time.Time) (count int64, err error) {
+	defer mon.Task()(&ctx, apiKeyID, ttl, now)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	key := newBandwidthKey(apiKeyID, now)
+	usage, ok := cache.apiKeyRequests[key]
+	if !ok || !usage.expiresAt.After(time.Now()) {
+		usage = &bandwidthUsage{expiresAt: time.Now().Add(ttl)}
+		cache.apiKeyRequests[key] = usage
+	}
+
+	usage.used++
+	return usage.used, nil
+}
+
+// Close the cache.
+func (cache *memoryLiveAccounting) Close() error {
+	return nil
+}
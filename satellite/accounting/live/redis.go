@@ -16,6 +16,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metabase"
 )
 
 type redisLiveAccounting struct {
@@ -128,14 +129,52 @@ func (cache *redisLiveAccounting) AddProjectStorageUsage(ctx context.Context, pr
 	return nil
 }
 
+// GetProjectSegmentUsage returns the current segment count of the project.
+func (cache *redisLiveAccounting) GetProjectSegmentUsage(ctx context.Context, projectID uuid.UUID) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	return cache.getInt64(ctx, createSegmentProjectIDKey(projectID))
+}
+
+// AddProjectSegmentUsage increments the segment count of the project.
+func (cache *redisLiveAccounting) AddProjectSegmentUsage(ctx context.Context, projectID uuid.UUID, increment int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	_, err = cache.client.IncrBy(ctx, createSegmentProjectIDKey(projectID), increment).Result()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis incrby failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetProjectObjectUsage returns the current object count of the project.
+func (cache *redisLiveAccounting) GetProjectObjectUsage(ctx context.Context, projectID uuid.UUID) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	return cache.getInt64(ctx, createObjectProjectIDKey(projectID))
+}
+
+// AddProjectObjectUsage increments the object count of the project.
+func (cache *redisLiveAccounting) AddProjectObjectUsage(ctx context.Context, projectID uuid.UUID, increment int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	_, err = cache.client.IncrBy(ctx, createObjectProjectIDKey(projectID), increment).Result()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis incrby failed: %w", err)
+	}
+
+	return nil
+}
+
 // GetAllProjectTotals iterates through the live accounting DB and returns a map of project IDs and totals.
 //
 // TODO (https://storjlabs.atlassian.net/browse/IN-173): see if it possible to
 // get key/value pairs with one single call.
-func (cache *redisLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ map[uuid.UUID]int64, err error) {
+func (cache *redisLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ map[uuid.UUID]accounting.Usage, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	projects := make(map[uuid.UUID]int64)
+	projects := make(map[uuid.UUID]accounting.Usage)
 	it := cache.client.Scan(ctx, 0, "*", 0).Iterator()
 	for it.Next(ctx) {
 		key := it.Val()
@@ -145,15 +184,118 @@ func (cache *redisLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ ma
 			continue
 		}
 
-		projectID, err := uuid.FromBytes([]byte(key))
+		var setValue func(usage *accounting.Usage, value int64)
+		idKey := key
+		switch {
+		case len(key) == len(uuid.UUID{}):
+			setValue = func(usage *accounting.Usage, value int64) { usage.Storage = value }
+		case strings.HasSuffix(key, segmentKeySuffix):
+			idKey = strings.TrimSuffix(key, segmentKeySuffix)
+			setValue = func(usage *accounting.Usage, value int64) { usage.Segments = value }
+		case strings.HasSuffix(key, objectKeySuffix):
+			idKey = strings.TrimSuffix(key, objectKeySuffix)
+			setValue = func(usage *accounting.Usage, value int64) { usage.Objects = value }
+		case strings.HasPrefix(key, bucketKeyPrefix):
+			// bucket keys are returned by GetAllBucketTotals
+			continue
+		case strings.HasPrefix(key, apiKeyKeyPrefix):
+			// API key request counts aren't project totals
+			continue
+		default:
+			return nil, accounting.ErrUnexpectedValue.New("unknown key; key=%q", key)
+		}
+
+		projectID, err := uuid.FromBytes([]byte(idKey))
 		if err != nil {
 			return nil, accounting.ErrUnexpectedValue.New("cannot parse the key as UUID; key=%q", key)
 		}
 
-		if _, seen := projects[projectID]; seen {
+		val, err := cache.getInt64(ctx, key)
+		if err != nil {
+			if accounting.ErrKeyNotFound.Has(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		usage := projects[projectID]
+		setValue(&usage, val)
+		projects[projectID] = usage
+	}
+
+	return projects, nil
+}
+
+// GetBucketStorageUsage returns the current storage usage of the bucket.
+func (cache *redisLiveAccounting) GetBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (totalUsed int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cache.getInt64(ctx, createBucketKey(bucket))
+}
+
+// AddBucketStorageUsage lets the live accounting know that the given bucket
+// has just added spaceUsed bytes of storage.
+func (cache *redisLiveAccounting) AddBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation, spaceUsed int64) (err error) {
+	defer mon.Task()(&ctx, spaceUsed)(&err)
+
+	_, err = cache.client.IncrBy(ctx, createBucketKey(bucket), spaceUsed).Result()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis incrby failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetBucketBandwidthUsage returns the current bandwidth usage of the bucket.
+func (cache *redisLiveAccounting) GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, now)(&err)
+
+	return cache.getInt64(ctx, createBandwidthBucketKey(bucket, now))
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth usage of the bucket.
+func (cache *redisLiveAccounting) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, increment, ttl, now)(&err)
+
+	// The expiration is set only when the key is created, see
+	// UpdateProjectBandwidthUsage.
+	script := fmt.Sprintf(`local current
+	current = redis.call("incrby", KEYS[1], "%d")
+	if tonumber(current) == %d then
+		redis.call("expire",KEYS[1], %d)
+	end
+	return current
+	`, increment, increment, int(ttl.Seconds()))
+
+	err = cache.client.Eval(ctx, script, []string{createBandwidthBucketKey(bucket, now)}).Err()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetAllBucketTotals iterates through the bucket keys of the live accounting DB
+// and returns a map of buckets and storage totals.
+func (cache *redisLiveAccounting) GetAllBucketTotals(ctx context.Context) (_ map[metabase.BucketLocation]int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	buckets := make(map[metabase.BucketLocation]int64)
+	it := cache.client.Scan(ctx, 0, bucketKeyPrefix+"*", 0).Iterator()
+	for it.Next(ctx) {
+		key := it.Val()
+
+		// skip bandwidth keys and project keys which happen to match the pattern
+		if strings.HasSuffix(key, "bandwidth") || len(key) == len(uuid.UUID{}) {
 			continue
 		}
 
+		bucket, err := metabase.ParseCompactBucketPrefix([]byte(strings.TrimPrefix(key, bucketKeyPrefix)))
+		if err != nil {
+			return nil, accounting.ErrUnexpectedValue.New("cannot parse the key as bucket; key=%q", key)
+		}
+
 		val, err := cache.getInt64(ctx, key)
 		if err != nil {
 			if accounting.ErrKeyNotFound.Has(err) {
@@ -163,10 +305,33 @@ func (cache *redisLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ ma
 			return nil, err
 		}
 
-		projects[projectID] = val
+		buckets[bucket] = val
 	}
 
-	return projects, nil
+	return buckets, nil
+}
+
+// IncrementAPIKeyRequests increments the number of requests made with the
+// API key on the day of now and returns the updated number.
+func (cache *redisLiveAccounting) IncrementAPIKeyRequests(ctx context.Context, apiKeyID uuid.UUID, ttl time.Duration, now time.Time) (count int64, err error) {
+	defer mon.Task()(&ctx, apiKeyID, ttl, now)(&err)
+
+	// The expiration is set only on the first request of the day, the same as
+	// for the bandwidth keys.
+	script := fmt.Sprintf(`local current
+	current = redis.call("incr", KEYS[1])
+	if tonumber(current) == 1 then
+		redis.call("expire",KEYS[1], %d)
+	end
+	return current
+	`, int(ttl.Seconds()))
+
+	count, err = cache.client.Eval(ctx, script, []string{createAPIKeyRequestsKey(apiKeyID, now)}).Int64()
+	if err != nil {
+		return 0, accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return count, nil
 }
 
 // Close the DB connection.
@@ -206,3 +371,45 @@ func createBandwidthProjectIDKey(projectID uuid.UUID, now time.Time) string {
 	_, month, day := now.Date()
 	return string(projectID[:]) + string(byte(month)) + string(byte(day)) + ":bandwidth"
 }
+
+const (
+	segmentKeySuffix = ":segment"
+	objectKeySuffix  = ":object"
+)
+
+// createSegmentProjectIDKey creates the segment project key.
+func createSegmentProjectIDKey(projectID uuid.UUID) string {
+	return string(projectID[:]) + segmentKeySuffix
+}
+
+// createObjectProjectIDKey creates the object project key.
+func createObjectProjectIDKey(projectID uuid.UUID) string {
+	return string(projectID[:]) + objectKeySuffix
+}
+
+// bucketKeyPrefix is the prefix of the bucket keys. Bucket names cannot
+// contain a colon, hence the bucket keys never end with the project key
+// suffixes.
+const bucketKeyPrefix = "bucket:"
+
+// createBucketKey creates the bucket storage key.
+func createBucketKey(bucket metabase.BucketLocation) string {
+	return bucketKeyPrefix + string(bucket.CompactPrefix())
+}
+
+// createBandwidthBucketKey creates the bucket bandwidth key.
+// The current month and day are combined with the bucket, the same as for the
+// project bandwidth key.
+func createBandwidthBucketKey(bucket metabase.BucketLocation, now time.Time) string {
+	_, month, day := now.Date()
+	return createBucketKey(bucket) + ":" + string(byte(month)) + string(byte(day)) + ":bandwidth"
+}
+
+// apiKeyKeyPrefix is the prefix of the API key request count keys.
+const apiKeyKeyPrefix = "apikey:"
+
+// createAPIKeyRequestsKey creates the API key request count key for the day of now.
+func createAPIKeyRequestsKey(apiKeyID uuid.UUID, now time.Time) string {
+	_, month, day := now.Date()
+	return apiKeyKeyPrefix + string(apiKeyID[:]) + ":" + string(byte(month)) + string(byte(day)) + ":requests"
+}
@@ -32,7 +32,7 @@ func (mdb *mockDB) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (a
 func TestProjectLimitCacheCallCount(t *testing.T) {
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
 		mdb := mockDB{}
-		projectLimitCache := accounting.NewProjectLimitCache(&mdb, 0, 0, accounting.ProjectLimitConfig{CacheCapacity: 100})
+		projectLimitCache := accounting.NewProjectLimitCache(&mdb, 0, 0, 0, 0, accounting.ProjectLimitConfig{CacheCapacity: 100})
 
 		testProject, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "test", OwnerID: testrand.UUID()})
 		require.NoError(t, err)
@@ -62,7 +62,14 @@ func TestProjectLimitCache(t *testing.T) {
 		projectLimitCache := saPeer.ProjectLimits.Cache
 		defaultUsageLimit := saPeer.Config.Console.UsageLimits.Storage.Free.Int64()
 		defaultBandwidthLimit := saPeer.Config.Console.UsageLimits.Bandwidth.Free.Int64()
-		dbDefaultLimits := accounting.ProjectLimits{Usage: &defaultUsageLimit, Bandwidth: &defaultBandwidthLimit}
+		defaultSegmentLimit := saPeer.Config.Console.UsageLimits.Segment.Free
+		defaultObjectLimit := saPeer.Config.Console.UsageLimits.Object.Free
+		dbDefaultLimits := accounting.ProjectLimits{
+			Usage:     &defaultUsageLimit,
+			Bandwidth: &defaultBandwidthLimit,
+			Segments:  &defaultSegmentLimit,
+			Objects:   &defaultObjectLimit,
+		}
 
 		testProject, err := saPeer.DB.Console().Projects().Insert(ctx, &console.Project{Name: "test", OwnerID: testrand.UUID()})
 		require.NoError(t, err)
@@ -71,6 +78,8 @@ func TestProjectLimitCache(t *testing.T) {
 			errorLimit             = 0
 			expectedUsageLimit     = 1
 			expectedBandwidthLimit = 2
+			expectedSegmentLimit   = 3
+			expectedObjectLimit    = 4
 		)
 
 		t.Run("project ID doesn't exist", func(t *testing.T) {
@@ -124,6 +133,14 @@ func TestProjectLimitCache(t *testing.T) {
 			actualBandwidthLimitFromSvc, err := projectUsageSvc.GetProjectBandwidthLimit(ctx, testProject.ID)
 			assert.NoError(t, err)
 			assert.Equal(t, memory.Size(*dbDefaultLimits.Bandwidth), actualBandwidthLimitFromSvc)
+
+			actualSegmentLimitFromCache, err := projectLimitCache.GetProjectSegmentLimit(ctx, testProject.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, *dbDefaultLimits.Segments, actualSegmentLimitFromCache)
+
+			actualObjectLimitFromCache, err := projectLimitCache.GetProjectObjectLimit(ctx, testProject.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, *dbDefaultLimits.Objects, actualObjectLimitFromCache)
 		})
 
 		t.Run("update limits in the database", func(t *testing.T) {
@@ -131,6 +148,10 @@ func TestProjectLimitCache(t *testing.T) {
 			require.NoError(t, err)
 			err = accountingDB.UpdateProjectBandwidthLimit(ctx, testProject.ID, expectedBandwidthLimit)
 			require.NoError(t, err)
+			err = accountingDB.UpdateProjectSegmentLimit(ctx, testProject.ID, expectedSegmentLimit)
+			require.NoError(t, err)
+			err = accountingDB.UpdateProjectObjectLimit(ctx, testProject.ID, expectedObjectLimit)
+			require.NoError(t, err)
 
 			actualStorageLimitFromDB, err := accountingDB.GetProjectStorageLimit(ctx, testProject.ID)
 			assert.NoError(t, err)
@@ -140,7 +161,14 @@ func TestProjectLimitCache(t *testing.T) {
 			assert.NoError(t, err)
 			usageLimits := int64(expectedUsageLimit)
 			bwLimits := int64(expectedBandwidthLimit)
-			assert.Equal(t, accounting.ProjectLimits{Usage: &usageLimits, Bandwidth: &bwLimits}, actualLimitsFromDB)
+			segmentLimits := int64(expectedSegmentLimit)
+			objectLimits := int64(expectedObjectLimit)
+			assert.Equal(t, accounting.ProjectLimits{
+				Usage:     &usageLimits,
+				Bandwidth: &bwLimits,
+				Segments:  &segmentLimits,
+				Objects:   &objectLimits,
+			}, actualLimitsFromDB)
 
 			actualStorageLimitFromCache, err := projectLimitCache.GetProjectStorageLimit(ctx, testProject.ID)
 			assert.NoError(t, err)
@@ -161,6 +189,14 @@ func TestProjectLimitCache(t *testing.T) {
 			actualBandwidthLimitFromSvc, err := projectUsageSvc.GetProjectBandwidthLimit(ctx, testProject.ID)
 			assert.NoError(t, err)
 			require.Equal(t, memory.Size(expectedBandwidthLimit), actualBandwidthLimitFromSvc)
+
+			actualSegmentLimitFromSvc, err := projectUsageSvc.GetProjectSegmentLimit(ctx, testProject.ID)
+			assert.NoError(t, err)
+			require.Equal(t, int64(expectedSegmentLimit), actualSegmentLimitFromSvc)
+
+			actualObjectLimitFromSvc, err := projectUsageSvc.GetProjectObjectLimit(ctx, testProject.ID)
+			assert.NoError(t, err)
+			require.Equal(t, int64(expectedObjectLimit), actualObjectLimitFromSvc)
 		})
 	})
 }
@@ -13,6 +13,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
 )
 
 var mon = monkit.Package()
@@ -29,17 +30,19 @@ type Service struct {
 	projectAccountingDB ProjectAccounting
 	liveAccounting      Cache
 	projectLimitCache   *ProjectLimitCache
+	bucketLimitCache    *BucketLimitCache
 	bandwidthCacheTTL   time.Duration
 	nowFn               func() time.Time
 	asOfSystemInterval  time.Duration
 }
 
 // NewService created new instance of project usage service.
-func NewService(projectAccountingDB ProjectAccounting, liveAccounting Cache, limitCache *ProjectLimitCache, bandwidthCacheTTL, asOfSystemInterval time.Duration) *Service {
+func NewService(projectAccountingDB ProjectAccounting, liveAccounting Cache, limitCache *ProjectLimitCache, bucketLimitCache *BucketLimitCache, bandwidthCacheTTL, asOfSystemInterval time.Duration) *Service {
 	return &Service{
 		projectAccountingDB: projectAccountingDB,
 		liveAccounting:      liveAccounting,
 		projectLimitCache:   limitCache,
+		bucketLimitCache:    bucketLimitCache,
 		bandwidthCacheTTL:   bandwidthCacheTTL,
 		nowFn:               time.Now,
 		asOfSystemInterval:  asOfSystemInterval,
@@ -136,6 +139,58 @@ func (usage *Service) ExceedsStorageUsage(ctx context.Context, projectID uuid.UU
 	return false, limit, nil
 }
 
+// ExceedsSegmentUsage returns true if the segment count of a project has reached that project's limit.
+func (usage *Service) ExceedsSegmentUsage(ctx context.Context, projectID uuid.UUID) (_ bool, limit int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errgroup.Group
+	var segmentsUsed int64
+
+	group.Go(func() error {
+		var err error
+		limit, err = usage.projectLimitCache.GetProjectSegmentLimit(ctx, projectID)
+		return err
+	})
+	group.Go(func() error {
+		var err error
+		segmentsUsed, err = usage.GetProjectSegmentTotals(ctx, projectID)
+		return err
+	})
+
+	err = group.Wait()
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+
+	return segmentsUsed >= limit, limit, nil
+}
+
+// ExceedsObjectUsage returns true if the object count of a project has reached that project's limit.
+func (usage *Service) ExceedsObjectUsage(ctx context.Context, projectID uuid.UUID) (_ bool, limit int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errgroup.Group
+	var objectsUsed int64
+
+	group.Go(func() error {
+		var err error
+		limit, err = usage.projectLimitCache.GetProjectObjectLimit(ctx, projectID)
+		return err
+	})
+	group.Go(func() error {
+		var err error
+		objectsUsed, err = usage.GetProjectObjectTotals(ctx, projectID)
+		return err
+	})
+
+	err = group.Wait()
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+
+	return objectsUsed >= limit, limit, nil
+}
+
 // GetProjectStorageTotals returns total amount of storage used by project.
 //
 // It can return one of the following errors returned by
@@ -152,6 +207,38 @@ func (usage *Service) GetProjectStorageTotals(ctx context.Context, projectID uui
 	return total, ErrProjectUsage.Wrap(err)
 }
 
+// GetProjectSegmentTotals returns the number of segments of the project.
+//
+// It can return one of the following errors returned by
+// storj.io/storj/satellite/accounting.Cache.GetProjectSegmentUsage except the
+// ErrKeyNotFound, wrapped by ErrProjectUsage.
+func (usage *Service) GetProjectSegmentTotals(ctx context.Context, projectID uuid.UUID) (total int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	total, err = usage.liveAccounting.GetProjectSegmentUsage(ctx, projectID)
+	if ErrKeyNotFound.Has(err) {
+		return 0, nil
+	}
+
+	return total, ErrProjectUsage.Wrap(err)
+}
+
+// GetProjectObjectTotals returns the number of objects of the project.
+//
+// It can return one of the following errors returned by
+// storj.io/storj/satellite/accounting.Cache.GetProjectObjectUsage except the
+// ErrKeyNotFound, wrapped by ErrProjectUsage.
+func (usage *Service) GetProjectObjectTotals(ctx context.Context, projectID uuid.UUID) (total int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	total, err = usage.liveAccounting.GetProjectObjectUsage(ctx, projectID)
+	if ErrKeyNotFound.Has(err) {
+		return 0, nil
+	}
+
+	return total, ErrProjectUsage.Wrap(err)
+}
+
 // GetProjectBandwidthTotals returns total amount of allocated bandwidth used for past 30 days.
 func (usage *Service) GetProjectBandwidthTotals(ctx context.Context, projectID uuid.UUID) (_ int64, err error) {
 	defer mon.Task()(&ctx, projectID)(&err)
@@ -184,6 +271,18 @@ func (usage *Service) GetProjectBandwidthLimit(ctx context.Context, projectID uu
 	return usage.projectLimitCache.GetProjectBandwidthLimit(ctx, projectID)
 }
 
+// GetProjectSegmentLimit returns current project segment limit.
+func (usage *Service) GetProjectSegmentLimit(ctx context.Context, projectID uuid.UUID) (_ int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+	return usage.projectLimitCache.GetProjectSegmentLimit(ctx, projectID)
+}
+
+// GetProjectObjectLimit returns current project object limit.
+func (usage *Service) GetProjectObjectLimit(ctx context.Context, projectID uuid.UUID) (_ int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+	return usage.projectLimitCache.GetProjectObjectLimit(ctx, projectID)
+}
+
 // UpdateProjectLimits sets new value for project's bandwidth and storage limit.
 func (usage *Service) UpdateProjectLimits(ctx context.Context, projectID uuid.UUID, limit memory.Size) (err error) {
 	defer mon.Task()(&ctx, projectID)(&err)
@@ -221,6 +320,159 @@ func (usage *Service) AddProjectStorageUsage(ctx context.Context, projectID uuid
 	return usage.liveAccounting.AddProjectStorageUsage(ctx, projectID, spaceUsed)
 }
 
+// AddProjectSegmentUsage lets the live accounting know that the given
+// project has just added segments.
+//
+// It can return one of the following errors returned by
+// storj.io/storj/satellite/accounting.Cache.AddProjectSegmentUsage, wrapped by
+// ErrProjectUsage.
+func (usage *Service) AddProjectSegmentUsage(ctx context.Context, projectID uuid.UUID, increment int64) (err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+	return usage.liveAccounting.AddProjectSegmentUsage(ctx, projectID, increment)
+}
+
+// AddProjectObjectUsage lets the live accounting know that the given
+// project has just added objects.
+//
+// It can return one of the following errors returned by
+// storj.io/storj/satellite/accounting.Cache.AddProjectObjectUsage, wrapped by
+// ErrProjectUsage.
+func (usage *Service) AddProjectObjectUsage(ctx context.Context, projectID uuid.UUID, increment int64) (err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+	return usage.liveAccounting.AddProjectObjectUsage(ctx, projectID, increment)
+}
+
+// apiKeyRequestsTTL is how long the request count of an API key is kept. The
+// count is kept per day, so it only has to outlive the day.
+const apiKeyRequestsTTL = 24 * time.Hour
+
+// ExceedsAPIKeyRequestQuota counts a request made with the API key and returns
+// true if the number of requests made with it today is over the quota.
+func (usage *Service) ExceedsAPIKeyRequestQuota(ctx context.Context, apiKeyID uuid.UUID, quota int64) (_ bool, err error) {
+	defer mon.Task()(&ctx, apiKeyID)(&err)
+
+	count, err := usage.liveAccounting.IncrementAPIKeyRequests(ctx, apiKeyID, apiKeyRequestsTTL, usage.nowFn())
+	if err != nil {
+		return false, ErrProjectUsage.Wrap(err)
+	}
+
+	return count > quota, nil
+}
+
+// ExceedsBucketStorageUsage returns true if the storage usage of a bucket is currently over that bucket's limit.
+// Buckets without a storage limit are only limited by the project limit.
+func (usage *Service) ExceedsBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (_ bool, limit memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.bucketLimitCache.Get(ctx, bucket)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+	if limits.Usage == nil {
+		return false, 0, nil
+	}
+
+	totalUsed, err := usage.GetBucketStorageTotals(ctx, bucket)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return totalUsed >= *limits.Usage, memory.Size(*limits.Usage), nil
+}
+
+// ExceedsBucketBandwidthUsage returns true if the bandwidth usage of a bucket in the current month
+// has reached that bucket's limit. Buckets without a bandwidth limit are only limited by the project limit.
+func (usage *Service) ExceedsBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation) (_ bool, limit memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.bucketLimitCache.Get(ctx, bucket)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+	if limits.Bandwidth == nil {
+		return false, 0, nil
+	}
+
+	now := usage.nowFn()
+	bandwidthUsage, err := usage.liveAccounting.GetBucketBandwidthUsage(ctx, bucket, now)
+	if ErrKeyNotFound.Has(err) {
+		// Get current bandwidth value from database and create the cache key with it.
+		year, month, _ := now.Date()
+		bandwidthUsage, err = usage.projectAccountingDB.GetBucketAllocatedBandwidth(ctx, bucket, time.Date(year, month, 1, 0, 0, 0, 0, time.UTC))
+		if err == nil {
+			err = usage.liveAccounting.UpdateBucketBandwidthUsage(ctx, bucket, bandwidthUsage, usage.bandwidthCacheTTL, now)
+		}
+	}
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+
+	return bandwidthUsage >= *limits.Bandwidth, memory.Size(*limits.Bandwidth), nil
+}
+
+// GetBucketStorageTotals returns total amount of storage used by bucket.
+//
+// It can return one of the following errors returned by
+// storj.io/storj/satellite/accounting.Cache.GetBucketStorageUsage except the
+// ErrKeyNotFound, wrapped by ErrProjectUsage.
+func (usage *Service) GetBucketStorageTotals(ctx context.Context, bucket metabase.BucketLocation) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	total, err = usage.liveAccounting.GetBucketStorageUsage(ctx, bucket)
+	if ErrKeyNotFound.Has(err) {
+		return 0, nil
+	}
+
+	return total, ErrProjectUsage.Wrap(err)
+}
+
+// GetBucketLimits returns the current storage and bandwidth limits of the bucket.
+func (usage *Service) GetBucketLimits(ctx context.Context, bucket metabase.BucketLocation) (_ BucketLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return usage.bucketLimitCache.Get(ctx, bucket)
+}
+
+// AddBucketStorageUsage lets the live accounting know that the given bucket
+// has just added spaceUsed bytes of storage. The usage of buckets without a
+// storage limit isn't tracked.
+//
+// It can return one of the following errors returned by
+// storj.io/storj/satellite/accounting.Cache.AddBucketStorageUsage, wrapped by
+// ErrProjectUsage.
+func (usage *Service) AddBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation, spaceUsed int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.bucketLimitCache.Get(ctx, bucket)
+	if err != nil {
+		return ErrProjectUsage.Wrap(err)
+	}
+	if limits.Usage == nil {
+		return nil
+	}
+
+	return ErrProjectUsage.Wrap(usage.liveAccounting.AddBucketStorageUsage(ctx, bucket, spaceUsed))
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth cache key of the bucket.
+// The usage of buckets without a bandwidth limit isn't tracked.
+//
+// It can return one of the following errors returned by
+// storj.io/storj/satellite/accounting.Cache.UpdateBucketBandwidthUsage,
+// wrapped by ErrProjectUsage.
+func (usage *Service) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.bucketLimitCache.Get(ctx, bucket)
+	if err != nil {
+		return ErrProjectUsage.Wrap(err)
+	}
+	if limits.Bandwidth == nil {
+		return nil
+	}
+
+	return ErrProjectUsage.Wrap(usage.liveAccounting.UpdateBucketBandwidthUsage(ctx, bucket, increment, usage.bandwidthCacheTTL, usage.nowFn()))
+}
+
 // SetNow allows tests to have the Service act as if the current time is whatever they want.
 func (usage *Service) SetNow(now func() time.Time) {
 	usage.nowFn = now
@@ -8,6 +8,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -374,6 +375,141 @@ func TestProjectUsageCustomLimit(t *testing.T) {
 	})
 }
 
+func TestProjectUsageSegmentLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		err := planet.Satellites[0].DB.ProjectAccounting().UpdateProjectSegmentLimit(ctx, projectID, 2)
+		require.NoError(t, err)
+
+		projectUsage := planet.Satellites[0].Accounting.ProjectUsage
+
+		// successful uploads until the limit is reached
+		for i := 0; i < 2; i++ {
+			err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path/"+strconv.Itoa(i), testrand.Bytes(10*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		segments, err := projectUsage.GetProjectSegmentTotals(ctx, projectID)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, segments)
+
+		actualExceeded, limit, err := projectUsage.ExceedsSegmentUsage(ctx, projectID)
+		require.NoError(t, err)
+		require.True(t, actualExceeded)
+		require.EqualValues(t, 2, limit)
+
+		// upload fails due to segment limit
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path/2", testrand.Bytes(10*memory.KiB))
+		require.Error(t, err)
+	})
+}
+
+func TestProjectUsageObjectLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		err := planet.Satellites[0].DB.ProjectAccounting().UpdateProjectObjectLimit(ctx, projectID, 1)
+		require.NoError(t, err)
+
+		projectUsage := planet.Satellites[0].Accounting.ProjectUsage
+
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path/0", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		objects, err := projectUsage.GetProjectObjectTotals(ctx, projectID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, objects)
+
+		actualExceeded, limit, err := projectUsage.ExceedsObjectUsage(ctx, projectID)
+		require.NoError(t, err)
+		require.True(t, actualExceeded)
+		require.EqualValues(t, 1, limit)
+
+		// upload fails due to object limit
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path/1", testrand.Bytes(10*memory.KiB))
+		require.Error(t, err)
+	})
+}
+
+func TestProjectUsageBucketStorageLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "limited"))
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "unlimited"))
+
+		limit := int64(20 * memory.KiB)
+		err := sat.DB.Buckets().UpdateBucketLimits(ctx, []byte("limited"), projectID, accounting.BucketLimits{Usage: &limit})
+		require.NoError(t, err)
+
+		projectUsage := sat.Accounting.ProjectUsage
+		limited := metabase.BucketLocation{ProjectID: projectID, BucketName: "limited"}
+
+		err = planet.Uplinks[0].Upload(ctx, sat, "limited", "test/path/0", testrand.Bytes(30*memory.KiB))
+		require.NoError(t, err)
+
+		actualExceeded, actualLimit, err := projectUsage.ExceedsBucketStorageUsage(ctx, limited)
+		require.NoError(t, err)
+		require.True(t, actualExceeded)
+		require.EqualValues(t, limit, actualLimit)
+
+		// upload fails due to the bucket storage limit
+		err = planet.Uplinks[0].Upload(ctx, sat, "limited", "test/path/1", testrand.Bytes(30*memory.KiB))
+		require.Error(t, err)
+
+		// other buckets of the project are not affected
+		err = planet.Uplinks[0].Upload(ctx, sat, "unlimited", "test/path/1", testrand.Bytes(30*memory.KiB))
+		require.NoError(t, err)
+
+		// the usage of buckets without a limit isn't tracked
+		_, err = sat.LiveAccounting.Cache.GetBucketStorageUsage(ctx, metabase.BucketLocation{ProjectID: projectID, BucketName: "unlimited"})
+		require.True(t, accounting.ErrKeyNotFound.Has(err))
+	})
+}
+
+func TestProjectUsageObjectLimitOverwrite(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		err := planet.Satellites[0].DB.ProjectAccounting().UpdateProjectObjectLimit(ctx, projectID, 1)
+		require.NoError(t, err)
+
+		projectUsage := planet.Satellites[0].Accounting.ProjectUsage
+
+		// overwriting an object doesn't create a new one, even at the limit
+		for i := 0; i < 3; i++ {
+			err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path/0", testrand.Bytes(10*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		objects, err := projectUsage.GetProjectObjectTotals(ctx, projectID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, objects)
+
+		segments, err := projectUsage.GetProjectSegmentTotals(ctx, projectID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, segments)
+
+		// a new object is still rejected
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path/1", testrand.Bytes(10*memory.KiB))
+		require.Error(t, err)
+
+		actualExceeded, _, err := projectUsage.ExceedsObjectUsage(ctx, projectID)
+		require.NoError(t, err)
+		require.True(t, actualExceeded)
+	})
+}
+
 func TestUsageRollups(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 2,
@@ -15,6 +15,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
 )
 
 // Error is a standard error class for this package.
@@ -42,6 +43,7 @@ type Service struct {
 	Loop   *sync2.Cycle
 
 	metabase                *metabase.DB
+	redundancy              metainfo.RedundancyProfiles
 	liveAccounting          accounting.Cache
 	storagenodeAccountingDB accounting.StoragenodeAccounting
 	projectAccountingDB     accounting.ProjectAccounting
@@ -49,13 +51,14 @@ type Service struct {
 }
 
 // New creates a new tally Service.
-func New(log *zap.Logger, sdb accounting.StoragenodeAccounting, pdb accounting.ProjectAccounting, liveAccounting accounting.Cache, metabase *metabase.DB, config Config) *Service {
+func New(log *zap.Logger, sdb accounting.StoragenodeAccounting, pdb accounting.ProjectAccounting, liveAccounting accounting.Cache, metabase *metabase.DB, redundancy metainfo.RedundancyProfiles, config Config) *Service {
 	return &Service{
 		log:    log,
 		config: config,
 		Loop:   sync2.NewCycle(config.Interval),
 
 		metabase:                metabase,
+		redundancy:              redundancy,
 		liveAccounting:          liveAccounting,
 		storagenodeAccountingDB: sdb,
 		projectAccountingDB:     pdb,
@@ -112,7 +115,7 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 
 	// No-op unless that there isn't an error getting the
 	// liveAccounting.GetAllProjectTotals
-	updateLiveAccountingTotals := func(_ map[uuid.UUID]int64) {}
+	updateLiveAccountingTotals := func(_ map[uuid.UUID]accounting.Usage) {}
 
 	initialLiveTotals, err := service.liveAccounting.GetAllProjectTotals(ctx)
 	if err != nil {
@@ -121,7 +124,7 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 			zap.Error(err),
 		)
 	} else {
-		updateLiveAccountingTotals = func(tallyProjectTotals map[uuid.UUID]int64) {
+		updateLiveAccountingTotals = func(tallyProjectTotals map[uuid.UUID]accounting.Usage) {
 			latestLiveTotals, err := service.liveAccounting.GetAllProjectTotals(ctx)
 			if err != nil {
 				service.log.Error(
@@ -136,19 +139,23 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 			// Thus, we add them and set the total to 0.
 			for projectID := range latestLiveTotals {
 				if _, ok := tallyProjectTotals[projectID]; !ok {
-					tallyProjectTotals[projectID] = 0
+					tallyProjectTotals[projectID] = accounting.Usage{}
 				}
 			}
 
 			for projectID, tallyTotal := range tallyProjectTotals {
-				delta := latestLiveTotals[projectID] - initialLiveTotals[projectID]
-				if delta < 0 {
-					delta = 0
-				}
+				initial, latest := initialLiveTotals[projectID], latestLiveTotals[projectID]
 
-				// read the method documentation why the increase passed to this method
+				// read the method documentation why the increase passed to these methods
 				// is calculated in this way
-				err = service.liveAccounting.AddProjectStorageUsage(ctx, projectID, -latestLiveTotals[projectID]+tallyTotal+(delta/2))
+				err = errs.Combine(
+					service.liveAccounting.AddProjectStorageUsage(ctx, projectID,
+						liveTotalIncrement(tallyTotal.Storage, initial.Storage, latest.Storage)),
+					service.liveAccounting.AddProjectSegmentUsage(ctx, projectID,
+						liveTotalIncrement(tallyTotal.Segments, initial.Segments, latest.Segments)),
+					service.liveAccounting.AddProjectObjectUsage(ctx, projectID,
+						liveTotalIncrement(tallyTotal.Objects, initial.Objects, latest.Objects)),
+				)
 				if err != nil {
 					if accounting.ErrSystemOrNetError.Has(err) {
 						service.log.Error(
@@ -168,8 +175,22 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 		}
 	}
 
+	// the live accounting storage usages of the buckets are updated the same
+	// way as the ones of the projects.
+	initialLiveBucketTotals, err := service.liveAccounting.GetAllBucketTotals(ctx)
+	if err != nil {
+		service.log.Error(
+			"tally won't update the live accounting storage usages of the buckets in this cycle",
+			zap.Error(err),
+		)
+	}
+
 	// add up all buckets
 	collector := NewBucketTallyCollector(service.log.Named("observer"), service.nowFn(), service.metabase, service.config)
+	collector.BillableBytes, err = service.billableBytes(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
 	err = collector.Run(ctx)
 	if err != nil {
 		return Error.Wrap(err)
@@ -186,6 +207,9 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 		}
 
 		updateLiveAccountingTotals(projectTotalsFromBuckets(collector.Bucket))
+		if initialLiveBucketTotals != nil {
+			service.updateLiveAccountingBucketTotals(ctx, initialLiveBucketTotals, collector.Bucket)
+		}
 	}
 
 	// TODO move commented metrics in a different place or wait until metabase
@@ -219,12 +243,109 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 	return errAtRest
 }
 
+// updateLiveAccountingBucketTotals updates the live accounting storage usages of
+// the buckets with the totals calculated by the tally. Read the Tally method
+// documentation for how the new totals are calculated.
+//
+// Only the buckets, which usage is already in the live accounting, are updated.
+// The usage is only tracked for the buckets with a storage limit.
+func (service *Service) updateLiveAccountingBucketTotals(ctx context.Context, initialLiveTotals map[metabase.BucketLocation]int64, buckets map[metabase.BucketLocation]*accounting.BucketTally) {
+	defer mon.Task()(&ctx)(nil)
+
+	latestLiveTotals, err := service.liveAccounting.GetAllBucketTotals(ctx)
+	if err != nil {
+		service.log.Error(
+			"tally isn't updating the live accounting storage usages of the buckets in this cycle",
+			zap.Error(err),
+		)
+		return
+	}
+
+	tallyTotals := make(map[metabase.BucketLocation]int64, len(latestLiveTotals))
+	for location := range latestLiveTotals {
+		// empty buckets are not returned by the metainfo observer.
+		tallyTotals[location] = 0
+		if bucket, ok := buckets[location]; ok {
+			tallyTotals[location] = bucket.TotalBytes
+		}
+	}
+
+	for location, tallyTotal := range tallyTotals {
+		increment := liveTotalIncrement(tallyTotal, initialLiveTotals[location], latestLiveTotals[location])
+		err := service.liveAccounting.AddBucketStorageUsage(ctx, location, increment)
+		if err != nil {
+			if accounting.ErrSystemOrNetError.Has(err) {
+				service.log.Error(
+					"tally isn't updating the live accounting storage usages of the buckets in this cycle",
+					zap.Error(err),
+				)
+				return
+			}
+
+			service.log.Error(
+				"tally isn't updating the live accounting storage usage of the bucket in this cycle",
+				zap.Error(err),
+				zap.Stringer("projectID", location.ProjectID),
+				zap.String("bucket", location.BucketName),
+			)
+		}
+	}
+}
+
+// billableBytes returns the billable bytes of the streams, which have segments stored with
+// a redundancy scheme other than the default. Segments keep the scheme they were uploaded
+// with, so their stored bytes are weighted by its storage factor.
+//
+// Without additional redundancy schemes configured every segment is billed like the default.
+func (service *Service) billableBytes(ctx context.Context) (_ map[uuid.UUID]int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(service.redundancy.Allowed) == 0 {
+		return nil, nil
+	}
+
+	startingTime, err := service.metabase.Now(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	weighted := map[uuid.UUID]float64{}
+	err = service.metabase.IterateLoopSegments(ctx, metabase.IterateLoopSegments{
+		BatchSize:          service.config.ListLimit,
+		AsOfSystemTime:     startingTime,
+		AsOfSystemInterval: service.config.AsOfSystemInterval,
+	}, func(ctx context.Context, it metabase.LoopSegmentsIterator) error {
+		var entry metabase.LoopSegmentEntry
+		for it.Next(ctx, &entry) {
+			factor := service.redundancy.StorageFactor(entry.Redundancy)
+			if _, ok := weighted[entry.StreamID]; !ok && factor == 1 {
+				continue
+			}
+			weighted[entry.StreamID] += float64(entry.EncryptedSize) * factor
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	billable := make(map[uuid.UUID]int64, len(weighted))
+	for streamID, bytes := range weighted {
+		billable[streamID] = int64(bytes)
+	}
+	return billable, nil
+}
+
 // BucketTallyCollector collects and adds up tallies for buckets.
 type BucketTallyCollector struct {
 	Now    time.Time
 	Log    *zap.Logger
 	Bucket map[metabase.BucketLocation]*accounting.BucketTally
 
+	// BillableBytes contains the billable bytes of the streams, which differ from their
+	// stored bytes, because their segments don't use the default redundancy scheme.
+	BillableBytes map[uuid.UUID]int64
+
 	metabase *metabase.DB
 	config   Config
 }
@@ -291,19 +412,38 @@ func (observer *BucketTallyCollector) object(ctx context.Context, object metabas
 	bucket := observer.ensureBucket(ctx, object.ObjectStream.Location())
 	bucket.TotalSegments += int64(object.SegmentCount)
 	bucket.TotalBytes += object.TotalEncryptedSize
+	if billable, ok := observer.BillableBytes[object.StreamID]; ok {
+		bucket.BillableBytes += billable
+	} else {
+		bucket.BillableBytes += object.TotalEncryptedSize
+	}
 	bucket.MetadataSize += int64(object.EncryptedMetadataSize)
 	bucket.ObjectCount++
 
 	return nil
 }
 
-func projectTotalsFromBuckets(buckets map[metabase.BucketLocation]*accounting.BucketTally) map[uuid.UUID]int64 {
-	projectTallyTotals := make(map[uuid.UUID]int64)
+func projectTotalsFromBuckets(buckets map[metabase.BucketLocation]*accounting.BucketTally) map[uuid.UUID]accounting.Usage {
+	projectTallyTotals := make(map[uuid.UUID]accounting.Usage)
 	for _, bucket := range buckets {
-		projectTallyTotals[bucket.ProjectID] += bucket.TotalBytes
+		usage := projectTallyTotals[bucket.ProjectID]
+		usage.Storage += bucket.TotalBytes
+		usage.Segments += bucket.Segments()
+		usage.Objects += bucket.ObjectCount
+		projectTallyTotals[bucket.ProjectID] = usage
 	}
 	return projectTallyTotals
 }
 
+// liveTotalIncrement returns the increment which sets a live accounting total to the
+// tally total plus half of what was added to the live accounting total during the tally.
+func liveTotalIncrement(tallyTotal, initialLiveTotal, latestLiveTotal int64) int64 {
+	delta := latestLiveTotal - initialLiveTotal
+	if delta < 0 {
+		delta = 0
+	}
+	return -latestLiveTotal + tallyTotal + (delta / 2)
+}
+
 // using custom name to avoid breaking monitoring.
 var monAccounting = monkit.ScopeNamed("storj.io/storj/satellite/accounting")
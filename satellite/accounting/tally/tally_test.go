@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/common/memory"
 	"storj.io/common/storj"
@@ -18,9 +19,12 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/private/teststorj"
+	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/uplink/private/metaclient"
 )
 
 func TestDeleteTalliesBefore(t *testing.T) {
@@ -274,3 +278,48 @@ func TestTallyEmptyProjectUpdatesLiveAccounting(t *testing.T) {
 		require.Zero(t, p1Total)
 	})
 }
+
+func TestTallyBillableBytes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.AllowedRS = metainfo.RSConfigs{
+					{ErasureShareSize: 256 * memory.B, Min: 2, Repair: 3, Success: 4, Total: 5},
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		sat.Accounting.Tally.Loop.Pause()
+
+		client, err := planet.Uplinks[0].DialMetainfo(ctx, sat, planet.Uplinks[0].APIKey[sat.ID()])
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		archive := sat.Config.Metainfo.AllowedRS[0].RedundancyScheme()
+		_, err = client.CreateBucket(ctx, metaclient.CreateBucketParams{
+			Name:                    []byte("archive"),
+			DefaultRedundancyScheme: archive,
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "archive", "object", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "default", "object", testrand.Bytes(10*memory.KiB)))
+
+		sat.Accounting.Tally.Loop.TriggerWait()
+
+		tallies, err := sat.DB.ProjectAccounting().GetTallies(ctx)
+		require.NoError(t, err)
+		require.Len(t, tallies, 2)
+
+		factor := metainfo.NewRedundancyProfiles(sat.Config.Metainfo).StorageFactor(archive)
+		for _, tally := range tallies {
+			if tally.BucketName == "archive" {
+				require.Equal(t, int64(float64(tally.TotalBytes)*factor), tally.BillableBytes)
+			} else {
+				require.Equal(t, tally.TotalBytes, tally.BillableBytes)
+			}
+		}
+	})
+}
@@ -20,8 +20,10 @@ import (
 	"storj.io/storj/private/lifecycle"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/reputation"
 )
 
 // Admin is the satellite core process that runs chores.
@@ -52,6 +54,10 @@ type Admin struct {
 		Stripe   stripecoinpayments.StripeClient
 	}
 
+	Reputation struct {
+		Service *reputation.Service
+	}
+
 	Admin struct {
 		Listener net.Listener
 		Server   *admin.Server
@@ -59,7 +65,7 @@ type Admin struct {
 }
 
 // NewAdmin creates a new satellite admin peer.
-func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB,
+func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *metabase.DB,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel) (*Admin, error) {
 	peer := &Admin{
 		Log:      log,
@@ -146,6 +152,18 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.Payments.Stripe = stripeClient
 		peer.Payments.Accounts = peer.Payments.Service.Accounts()
 	}
+	{ // setup reputation
+		peer.Reputation.Service = reputation.NewService(log.Named("reputation:service"),
+			peer.DB.OverlayCache(),
+			peer.DB.Reputation(),
+			config.Reputation,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "reputation",
+			Close: peer.Reputation.Service.Close,
+		})
+	}
+
 	{ // setup admin endpoint
 		var err error
 		peer.Admin.Listener, err = net.Listen("tcp", config.Admin.Address)
@@ -156,7 +174,7 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB,
 		adminConfig := config.Admin
 		adminConfig.AuthorizationToken = config.Console.AuthToken
 
-		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, peer.DB, peer.Payments.Accounts, adminConfig)
+		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, peer.DB, metabaseDB, peer.Payments.Accounts, peer.Reputation.Service, adminConfig)
 		peer.Servers.Add(lifecycle.Item{
 			Name:  "admin",
 			Run:   peer.Admin.Server.Run,
@@ -4,6 +4,7 @@
 package admin
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -41,8 +42,12 @@ func (server *Server) addAPIKey(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var input struct {
-		PartnerID uuid.UUID `json:"partnerId"`
-		Name      string    `json:"name"`
+		PartnerID    uuid.UUID `json:"partnerId"`
+		Name         string    `json:"name"`
+		RateLimit    *int      `json:"rateLimit"`
+		RequestQuota *int64    `json:"requestQuota"`
+
+		Restrictions console.APIKeyRestrictions `json:"restrictions"`
 	}
 
 	var output struct {
@@ -62,6 +67,18 @@ func (server *Server) addAPIKey(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if (input.RateLimit != nil && *input.RateLimit <= 0) || (input.RequestQuota != nil && *input.RequestQuota <= 0) {
+		httpJSONError(w, "invalid api-key limits",
+			"rateLimit and requestQuota must be positive", http.StatusBadRequest)
+		return
+	}
+
+	if err := input.Restrictions.Validate(); err != nil {
+		httpJSONError(w, "invalid api-key restrictions",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	_, err = server.db.Console().APIKeys().GetByNameAndProjectID(ctx, input.Name, projectUUID)
 	if err == nil {
 		httpJSONError(w, "api-key with given name already exists",
@@ -88,16 +105,37 @@ func (server *Server) addAPIKey(w http.ResponseWriter, r *http.Request) {
 		ProjectID: projectUUID,
 		Secret:    secret,
 		PartnerID: input.PartnerID,
+
+		RateLimit:    input.RateLimit,
+		RequestQuota: input.RequestQuota,
+		Restrictions: input.Restrictions.String(),
 	}
 
-	_, err = server.db.Console().APIKeys().Create(ctx, key.Head(), apikey)
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		info, err := tx.APIKeys().Create(ctx, key.Head(), apikey)
+		if err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, console.AuditEvent{
+			Action:    "add api key",
+			ProjectID: projectUUID,
+			Target:    info.ID.String(),
+		}, nil, console.APIKeyAuditValue(info))
+	})
 	if err != nil {
 		httpJSONError(w, "unable to add api-key to database",
 			err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	output.APIKey = key.Serialize()
+	restricted, err := input.Restrictions.Restrict(key)
+	if err != nil {
+		httpJSONError(w, "could not restrict api-key",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output.APIKey = restricted.Serialize()
 	data, err := json.Marshal(output)
 	if err != nil {
 		httpJSONError(w, "json encoding failed",
@@ -134,7 +172,16 @@ func (server *Server) deleteAPIKey(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = server.db.Console().APIKeys().Delete(ctx, info.ID)
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		if err := tx.APIKeys().Delete(ctx, info.ID); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, console.AuditEvent{
+			Action:    "delete api key",
+			ProjectID: info.ProjectID,
+			Target:    info.ID.String(),
+		}, console.APIKeyAuditValue(info), nil)
+	})
 	if err != nil {
 		httpJSONError(w, "unable to delete apikey",
 			err.Error(), http.StatusInternalServerError)
@@ -174,7 +221,16 @@ func (server *Server) deleteAPIKeyByName(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	err = server.db.Console().APIKeys().Delete(ctx, info.ID)
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		if err := tx.APIKeys().Delete(ctx, info.ID); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, console.AuditEvent{
+			Action:    "delete api key",
+			ProjectID: info.ProjectID,
+			Target:    info.ID.String(),
+		}, console.APIKeyAuditValue(info), nil)
+	})
 	if err != nil {
 		httpJSONError(w, "unable to delete apikey",
 			err.Error(), http.StatusInternalServerError)
@@ -41,7 +41,7 @@ func TestAddApiKey(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, keys.APIKeys, 1)
 
-		body := strings.NewReader(`{"name":"Default"}`)
+		body := strings.NewReader(`{"name":"Default","rateLimit":10,"requestQuota":1000}`)
 		req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("http://"+address.String()+"/api/projects/%s/apikeys", projectID.String()), body)
 		require.NoError(t, err)
 		req.Header.Set("Authorization", planet.Satellites[0].Config.Console.AuthToken)
@@ -71,6 +71,10 @@ func TestAddApiKey(t *testing.T) {
 		key, err := planet.Satellites[0].DB.Console().APIKeys().GetByHead(ctx, apikey.Head())
 		require.NoError(t, err)
 		require.Equal(t, "Default", key.Name)
+		require.NotNil(t, key.RateLimit)
+		require.Equal(t, 10, *key.RateLimit)
+		require.NotNil(t, key.RequestQuota)
+		require.EqualValues(t, 1000, *key.RequestQuota)
 	})
 }
 
@@ -0,0 +1,265 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/schema"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	defaultAuditEventsLimit = 50
+	maxAuditEventsLimit     = 500
+)
+
+// auditEvent is the JSON representation of an audit log entry.
+type auditEvent struct {
+	ID        uuid.UUID       `json:"id"`
+	ActorID   *uuid.UUID      `json:"actorId"`
+	Actor     string          `json:"actor"`
+	Action    string          `json:"action"`
+	UserID    *uuid.UUID      `json:"userId"`
+	ProjectID *uuid.UUID      `json:"projectId"`
+	Target    string          `json:"target"`
+	OldValue  json.RawMessage `json:"oldValue"`
+	NewValue  json.RawMessage `json:"newValue"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+func newAuditEvent(event console.AuditEvent) auditEvent {
+	optionalID := func(id uuid.UUID) *uuid.UUID {
+		if id.IsZero() {
+			return nil
+		}
+		return &id
+	}
+
+	return auditEvent{
+		ID:        event.ID,
+		ActorID:   optionalID(event.ActorID),
+		Actor:     event.Actor,
+		Action:    event.Action,
+		UserID:    optionalID(event.UserID),
+		ProjectID: optionalID(event.ProjectID),
+		Target:    event.Target,
+		OldValue:  event.OldValue,
+		NewValue:  event.NewValue,
+		CreatedAt: event.CreatedAt,
+	}
+}
+
+func (server *Server) listAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var arguments struct {
+		User    string `schema:"user"`
+		Project string `schema:"project"`
+		Since   string `schema:"since"`
+		Before  string `schema:"before"`
+		Limit   uint   `schema:"limit"`
+		Page    uint   `schema:"page"`
+	}
+
+	if err := r.ParseForm(); err != nil {
+		httpJSONError(w, "invalid form",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	decoder := schema.NewDecoder()
+	err := decoder.Decode(&arguments, r.Form)
+	if err != nil {
+		httpJSONError(w, "invalid arguments",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cursor := console.AuditEventCursor{
+		Limit: arguments.Limit,
+		Page:  arguments.Page,
+	}
+	if cursor.Limit == 0 {
+		cursor.Limit = defaultAuditEventsLimit
+	}
+	if cursor.Limit > maxAuditEventsLimit {
+		cursor.Limit = maxAuditEventsLimit
+	}
+	if cursor.Page == 0 {
+		cursor.Page = 1
+	}
+
+	if arguments.User != "" {
+		user, err := server.db.Console().Users().GetByEmail(ctx, arguments.User)
+		if errors.Is(err, sql.ErrNoRows) {
+			httpJSONError(w, fmt.Sprintf("user with email %q not found", arguments.User),
+				"", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			httpJSONError(w, "failed to get user",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+		cursor.UserID = user.ID
+	}
+
+	if arguments.Project != "" {
+		cursor.ProjectID, err = uuid.FromString(arguments.Project)
+		if err != nil {
+			httpJSONError(w, "invalid project id",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if arguments.Since != "" {
+		cursor.Since, err = time.Parse(time.RFC3339, arguments.Since)
+		if err != nil {
+			httpJSONError(w, "invalid since",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if arguments.Before != "" {
+		cursor.Before, err = time.Parse(time.RFC3339, arguments.Before)
+		if err != nil {
+			httpJSONError(w, "invalid before",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	page, err := server.db.Console().AuditEvents().GetPaged(ctx, cursor)
+	if err != nil {
+		httpJSONError(w, "unable to list audit events",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	events := make([]auditEvent, 0, len(page.Events))
+	for _, event := range page.Events {
+		events = append(events, newAuditEvent(event))
+	}
+
+	data, err := json.Marshal(struct {
+		Events      []auditEvent `json:"events"`
+		PageCount   uint         `json:"pageCount"`
+		CurrentPage uint         `json:"currentPage"`
+		TotalCount  uint64       `json:"totalCount"`
+	}{
+		Events:      events,
+		PageCount:   page.PageCount,
+		CurrentPage: page.CurrentPage,
+		TotalCount:  page.TotalCount,
+	})
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+// operatorKey is the context key of the name of the operator making the request.
+type operatorKey struct{}
+
+// withOperator returns the context of a request made by the named operator.
+func withOperator(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operatorKey{}, name)
+}
+
+// auditActor returns the actor recorded for the changes made with the context.
+func auditActor(ctx context.Context) string {
+	if name, ok := ctx.Value(operatorKey{}).(string); ok && name != "" {
+		return console.AuditActorAdmin + ":" + name
+	}
+	return console.AuditActorAdmin
+}
+
+// recordAuditEvent appends the event of a change made through the admin API to the
+// audit log. db should be the transaction making the change.
+func recordAuditEvent(ctx context.Context, db console.DB, event console.AuditEvent, oldValue, newValue interface{}) error {
+	event.Actor = auditActor(ctx)
+
+	if err := event.SetValues(oldValue, newValue); err != nil {
+		return err
+	}
+	return db.AuditEvents().Insert(ctx, &event)
+}
+
+// beginAuditEvent records the event of a change which can't be made in the transaction
+// of the event, so it has to be recorded before the change is made. A failed change is
+// recorded with failAuditEvent. It writes the error response when the event can't be
+// recorded.
+func (server *Server) beginAuditEvent(ctx context.Context, w http.ResponseWriter, event console.AuditEvent, oldValue, newValue interface{}) (_ *console.AuditEvent, ok bool) {
+	event.Actor = auditActor(ctx)
+
+	err := event.SetValues(oldValue, newValue)
+	if err == nil {
+		err = server.db.Console().AuditEvents().Insert(ctx, &event)
+	}
+	if err != nil {
+		httpJSONError(w, "unable to record audit event",
+			err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	return &event, true
+}
+
+// failAuditEvent appends the failure of the change recorded by beginAuditEvent to the
+// audit log, the recorded event is kept. The response is already the error of the
+// change, so failures are only logged.
+func (server *Server) failAuditEvent(ctx context.Context, event *console.AuditEvent, changeErr error) {
+	failure := console.AuditEvent{
+		Actor:     event.Actor,
+		Action:    event.Action + " failed",
+		UserID:    event.UserID,
+		ProjectID: event.ProjectID,
+		Target:    event.Target,
+	}
+
+	err := failure.SetValues(nil, map[string]string{"error": changeErr.Error()})
+	if err == nil {
+		err = server.db.Console().AuditEvents().Insert(ctx, &failure)
+	}
+	if err != nil {
+		server.log.Error("failed to record audit event of failed change",
+			zap.Stringer("ID", event.ID),
+			zap.String("Action", event.Action),
+			zap.String("Target", event.Target),
+			zap.Error(err))
+	}
+}
+
+// userAuditValue returns the recorded value of a user, it doesn't contain the password hash.
+func userAuditValue(user *console.User) interface{} {
+	return struct {
+		FullName     string             `json:"fullName"`
+		ShortName    string             `json:"shortName"`
+		Email        string             `json:"email"`
+		PartnerID    uuid.UUID          `json:"partnerId"`
+		ProjectLimit int                `json:"projectLimit"`
+		Status       console.UserStatus `json:"status"`
+	}{
+		FullName:     user.FullName,
+		ShortName:    user.ShortName,
+		Email:        user.Email,
+		PartnerID:    user.PartnerID,
+		ProjectLimit: user.ProjectLimit,
+		Status:       user.Status,
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+)
+
+func TestAuditEvents(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		project, err := sat.DB.Console().Projects().Get(ctx, projectID)
+		require.NoError(t, err)
+		require.NotNil(t, project.StorageLimit)
+
+		data := url.Values{"usage": []string{"1TB"}}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+			"http://"+address.String()+"/api/projects/"+projectID.String()+"/limit",
+			strings.NewReader(data.Encode()))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		response, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		require.NoError(t, response.Body.Close())
+
+		type event struct {
+			Actor     string          `json:"actor"`
+			Action    string          `json:"action"`
+			ProjectID *string         `json:"projectId"`
+			OldValue  json.RawMessage `json:"oldValue"`
+			NewValue  json.RawMessage `json:"newValue"`
+		}
+		var output struct {
+			Events      []event `json:"events"`
+			PageCount   uint    `json:"pageCount"`
+			CurrentPage uint    `json:"currentPage"`
+			TotalCount  uint64  `json:"totalCount"`
+		}
+
+		link := "http://" + address.String() + "/api/audit-events?project=" + projectID.String()
+		body := assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", authToken)
+		require.NoError(t, json.Unmarshal(body, &output))
+		require.NotEmpty(t, output.Events)
+		require.EqualValues(t, 1, output.CurrentPage)
+
+		latest := output.Events[0]
+		require.Equal(t, "admin", latest.Actor)
+		require.Equal(t, "update project limit", latest.Action)
+		require.NotNil(t, latest.ProjectID)
+		require.Equal(t, projectID.String(), *latest.ProjectID)
+		require.JSONEq(t, `{"usage":"`+project.StorageLimit.String()+`"}`, string(latest.OldValue))
+		require.JSONEq(t, `{"usage":"1.00 TB"}`, string(latest.NewValue))
+
+		// events before the change don't include it.
+		link = "http://" + address.String() + "/api/audit-events?project=" + projectID.String() +
+			"&before=" + url.QueryEscape(project.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+		body = assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", authToken)
+		require.NoError(t, json.Unmarshal(body, &output))
+		require.Empty(t, output.Events)
+
+		link = "http://" + address.String() + "/api/audit-events?user=" + url.QueryEscape("missing@storj.test")
+		assertReq(ctx, t, link, http.MethodGet, "", http.StatusNotFound, "", authToken)
+
+		link = "http://" + address.String() + "/api/audit-events?since=invalid"
+		assertReq(ctx, t, link, http.MethodGet, "", http.StatusBadRequest, "", authToken)
+	})
+}
+
+func TestAuditEventsOperator(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+				config.Admin.Operators = []string{"alice:alice-token", "bob:bob-token"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		link := "http://" + address.String() + "/api/audit-events?project=" + projectID.String()
+
+		// the shared token isn't accepted when operators are configured.
+		assertReq(ctx, t, link, http.MethodGet, "", http.StatusForbidden, "", sat.Config.Console.AuthToken)
+		assertReq(ctx, t, link, http.MethodGet, "", http.StatusForbidden, "", "unknown-token")
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+			"http://"+address.String()+"/api/projects/"+projectID.String()+"/limit?rate=100", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "bob-token")
+
+		response, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		require.NoError(t, response.Body.Close())
+
+		var output struct {
+			Events []struct {
+				Actor  string `json:"actor"`
+				Action string `json:"action"`
+			} `json:"events"`
+		}
+		body := assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", "alice-token")
+		require.NoError(t, json.Unmarshal(body, &output))
+		require.NotEmpty(t, output.Events)
+		require.Equal(t, "admin:bob", output.Events[0].Actor)
+		require.Equal(t, "update project limit", output.Events[0].Action)
+	})
+}
@@ -0,0 +1,491 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/placement"
+)
+
+func (server *Server) getBucketVersioning(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	versioning, err := server.db.Buckets().GetBucketVersioning(ctx, bucket, projectUUID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			httpJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to get bucket versioning",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		Versioning string `json:"versioning"`
+	}{
+		Versioning: versioning.String(),
+	})
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) putBucketVersioning(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Versioning string `json:"versioning"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		httpJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// versioning cannot be disabled once objects may have multiple versions
+	if input.Versioning != metainfo.VersioningEnabled.String() {
+		httpJSONError(w, "unsupported versioning state",
+			"only enabling versioning is supported", http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.Buckets().UpdateBucketVersioning(ctx, bucket, projectUUID, metainfo.VersioningEnabled)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			httpJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to update bucket versioning",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) getBucketLifecycle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	lifecycle, err := server.db.Buckets().GetBucketLifecycle(ctx, bucket, projectUUID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			httpJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to get bucket lifecycle",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if lifecycle.Rules == nil {
+		lifecycle.Rules = []metainfo.LifecycleRule{}
+	}
+
+	data, err := json.Marshal(lifecycle)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) putBucketLifecycle(w http.ResponseWriter, r *http.Request) {
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var lifecycle metainfo.BucketLifecycle
+	err = json.Unmarshal(body, &lifecycle)
+	if err != nil {
+		httpJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := lifecycle.Verify(); err != nil {
+		httpJSONError(w, "invalid bucket lifecycle",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	server.updateBucketLifecycle(w, r, projectUUID, bucket, lifecycle)
+}
+
+func (server *Server) deleteBucketLifecycle(w http.ResponseWriter, r *http.Request) {
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	server.updateBucketLifecycle(w, r, projectUUID, bucket, metainfo.BucketLifecycle{})
+}
+
+func (server *Server) updateBucketLifecycle(w http.ResponseWriter, r *http.Request, projectUUID uuid.UUID, bucket []byte, lifecycle metainfo.BucketLifecycle) {
+	err := server.db.Buckets().UpdateBucketLifecycle(r.Context(), bucket, projectUUID, lifecycle)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			httpJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to update bucket lifecycle",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) getBucketNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	notifications, err := server.db.Buckets().GetBucketNotifications(ctx, bucket, projectUUID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			httpJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to get bucket notifications",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if notifications.Webhooks == nil {
+		notifications.Webhooks = []metainfo.WebhookConfig{}
+	}
+
+	data, err := json.Marshal(notifications)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) putBucketNotifications(w http.ResponseWriter, r *http.Request) {
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var notifications metainfo.BucketNotifications
+	err = json.Unmarshal(body, &notifications)
+	if err != nil {
+		httpJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := notifications.Verify(); err != nil {
+		httpJSONError(w, "invalid bucket notifications",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	server.updateBucketNotifications(w, r, projectUUID, bucket, notifications)
+}
+
+func (server *Server) deleteBucketNotifications(w http.ResponseWriter, r *http.Request) {
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	server.updateBucketNotifications(w, r, projectUUID, bucket, metainfo.BucketNotifications{})
+}
+
+func (server *Server) updateBucketNotifications(w http.ResponseWriter, r *http.Request, projectUUID uuid.UUID, bucket []byte, notifications metainfo.BucketNotifications) {
+	err := server.db.Buckets().UpdateBucketNotifications(r.Context(), bucket, projectUUID, notifications)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			httpJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to update bucket notifications",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) getBucketPlacement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	placement, err := server.db.Buckets().GetBucketPlacement(ctx, bucket, projectUUID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			httpJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to get bucket placement",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		Placement string `json:"placement"`
+	}{
+		Placement: placement.String(),
+	})
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) putBucketPlacement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Placement string `json:"placement"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		httpJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	constraint, err := placement.Parse(input.Placement)
+	if err != nil {
+		httpJSONError(w, "unsupported placement",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.Buckets().UpdateBucketPlacement(ctx, bucket, projectUUID, constraint)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			httpJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to update bucket placement",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// bucketLimits is the JSON representation of the bucket limits. A null limit
+// means that the bucket is only limited by the project limits.
+type bucketLimits struct {
+	Usage     *int64 `json:"usage"`
+	Bandwidth *int64 `json:"bandwidth"`
+}
+
+func (server *Server) getBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	limits, err := server.db.Buckets().GetBucketLimits(ctx, bucket, projectUUID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			httpJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to get bucket limits",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(bucketLimits{
+		Usage:     limits.Usage,
+		Bandwidth: limits.Bandwidth,
+	})
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) putBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input bucketLimits
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		httpJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if (input.Usage != nil && *input.Usage < 0) || (input.Bandwidth != nil && *input.Bandwidth < 0) {
+		httpJSONError(w, "negative bucket limit",
+			"limits must be positive or null", http.StatusBadRequest)
+		return
+	}
+
+	limits, err := server.db.Buckets().GetBucketLimits(ctx, bucket, projectUUID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			httpJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to get bucket limits",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	event, ok := server.beginAuditEvent(ctx, w, console.AuditEvent{
+		Action:    "update bucket limits",
+		ProjectID: projectUUID,
+		Target:    string(bucket),
+	}, bucketLimits{Usage: limits.Usage, Bandwidth: limits.Bandwidth}, input)
+	if !ok {
+		return
+	}
+
+	err = server.db.Buckets().UpdateBucketLimits(ctx, bucket, projectUUID, accounting.BucketLimits{
+		Usage:     input.Usage,
+		Bandwidth: input.Bandwidth,
+	})
+	if err != nil {
+		server.failAuditEvent(ctx, event, err)
+		if storj.ErrBucketNotFound.Has(err) {
+			httpJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to update bucket limits",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// bucketFromVars parses project id and bucket name from the request path.
+// It writes the error response when they are missing or invalid.
+func bucketFromVars(w http.ResponseWriter, r *http.Request) (projectUUID uuid.UUID, bucket []byte, ok bool) {
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		httpJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return uuid.UUID{}, nil, false
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		httpJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, nil, false
+	}
+
+	bucketName, ok := vars["bucket"]
+	if !ok {
+		httpJSONError(w, "bucket name missing",
+			"", http.StatusBadRequest)
+		return uuid.UUID{}, nil, false
+	}
+
+	return projectUUID, []byte(bucketName), true
+}
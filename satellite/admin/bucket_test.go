@@ -0,0 +1,198 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+)
+
+func TestBucketVersioning(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "bucket"))
+
+		link := "http://" + address.String() + "/api/projects/" + projectID.String() + "/buckets/bucket/versioning"
+
+		assertGet(ctx, t, link, `{"versioning":"unversioned"}`, authToken)
+
+		assertReq(ctx, t, link, http.MethodPut, `{"versioning":"unversioned"}`, http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, link, http.MethodPut, `{"versioning":"enabled"}`, http.StatusOK, "", authToken)
+
+		assertGet(ctx, t, link, `{"versioning":"enabled"}`, authToken)
+
+		missing := "http://" + address.String() + "/api/projects/" + projectID.String() + "/buckets/missing/versioning"
+		assertReq(ctx, t, missing, http.MethodGet, "", http.StatusNotFound, "", authToken)
+		assertReq(ctx, t, missing, http.MethodPut, `{"versioning":"enabled"}`, http.StatusNotFound, "", authToken)
+	})
+}
+
+func TestBucketLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "bucket"))
+
+		link := "http://" + address.String() + "/api/projects/" + projectID.String() + "/buckets/bucket/lifecycle"
+
+		assertGet(ctx, t, link, `{"rules":[]}`, authToken)
+
+		assertReq(ctx, t, link, http.MethodPut, `{"rules":[{"id":"logs"}]}`, http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, link, http.MethodPut, `{"rules":[{"id":"logs","prefix":"bG9ncy8=","expire_after_days":30}]}`, http.StatusOK, "", authToken)
+
+		assertGet(ctx, t, link, `{"rules":[{"id":"logs","prefix":"bG9ncy8=","expire_after_days":30}]}`, authToken)
+
+		assertReq(ctx, t, link, http.MethodDelete, "", http.StatusOK, "", authToken)
+
+		assertGet(ctx, t, link, `{"rules":[]}`, authToken)
+
+		missing := "http://" + address.String() + "/api/projects/" + projectID.String() + "/buckets/missing/lifecycle"
+		assertReq(ctx, t, missing, http.MethodGet, "", http.StatusNotFound, "", authToken)
+		assertReq(ctx, t, missing, http.MethodPut, `{"rules":[]}`, http.StatusNotFound, "", authToken)
+	})
+}
+
+func TestBucketNotifications(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "bucket"))
+
+		link := "http://" + address.String() + "/api/projects/" + projectID.String() + "/buckets/bucket/notifications"
+
+		assertGet(ctx, t, link, `{"webhooks":[]}`, authToken)
+
+		assertReq(ctx, t, link, http.MethodPut, `{"webhooks":[{"id":"uploads","url":"ftp://example.test","events":["object-created"]}]}`, http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, link, http.MethodPut, `{"webhooks":[{"id":"uploads","url":"https://example.test/hook","events":["object-moved"]}]}`, http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, link, http.MethodPut, `{"webhooks":[{"id":"uploads","url":"https://example.test/hook","events":["object-created"],"prefix":"aW5ib3gv"}]}`, http.StatusOK, "", authToken)
+
+		assertGet(ctx, t, link, `{"webhooks":[{"id":"uploads","url":"https://example.test/hook","events":["object-created"],"prefix":"aW5ib3gv"}]}`, authToken)
+
+		assertReq(ctx, t, link, http.MethodDelete, "", http.StatusOK, "", authToken)
+
+		assertGet(ctx, t, link, `{"webhooks":[]}`, authToken)
+
+		missing := "http://" + address.String() + "/api/projects/" + projectID.String() + "/buckets/missing/notifications"
+		assertReq(ctx, t, missing, http.MethodGet, "", http.StatusNotFound, "", authToken)
+		assertReq(ctx, t, missing, http.MethodPut, `{"webhooks":[]}`, http.StatusNotFound, "", authToken)
+	})
+}
+
+func TestBucketPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "bucket"))
+
+		link := "http://" + address.String() + "/api/projects/" + projectID.String() + "/buckets/bucket/placement"
+
+		assertGet(ctx, t, link, `{"placement":"every-country"}`, authToken)
+
+		assertReq(ctx, t, link, http.MethodPut, `{"placement":"mars"}`, http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, link, http.MethodPut, `{"placement":"eu"}`, http.StatusOK, "", authToken)
+
+		assertGet(ctx, t, link, `{"placement":"eu"}`, authToken)
+
+		assertReq(ctx, t, link, http.MethodPut, `{"placement":"every-country"}`, http.StatusOK, "", authToken)
+
+		assertGet(ctx, t, link, `{"placement":"every-country"}`, authToken)
+
+		missing := "http://" + address.String() + "/api/projects/" + projectID.String() + "/buckets/missing/placement"
+		assertReq(ctx, t, missing, http.MethodGet, "", http.StatusNotFound, "", authToken)
+		assertReq(ctx, t, missing, http.MethodPut, `{"placement":"eu"}`, http.StatusNotFound, "", authToken)
+	})
+}
+
+func TestBucketLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "bucket"))
+
+		link := "http://" + address.String() + "/api/projects/" + projectID.String() + "/buckets/bucket/limits"
+
+		assertGet(ctx, t, link, `{"usage":null,"bandwidth":null}`, authToken)
+
+		assertReq(ctx, t, link, http.MethodPut, `{"usage":-1,"bandwidth":null}`, http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, link, http.MethodPut, `{"usage":1000,"bandwidth":2000}`, http.StatusOK, "", authToken)
+
+		assertGet(ctx, t, link, `{"usage":1000,"bandwidth":2000}`, authToken)
+
+		assertReq(ctx, t, link, http.MethodPut, `{"usage":null,"bandwidth":2000}`, http.StatusOK, "", authToken)
+
+		assertGet(ctx, t, link, `{"usage":null,"bandwidth":2000}`, authToken)
+
+		missing := "http://" + address.String() + "/api/projects/" + projectID.String() + "/buckets/missing/limits"
+		assertReq(ctx, t, missing, http.MethodGet, "", http.StatusNotFound, "", authToken)
+		assertReq(ctx, t, missing, http.MethodPut, `{"usage":1000,"bandwidth":null}`, http.StatusNotFound, "", authToken)
+	})
+}
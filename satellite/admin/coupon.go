@@ -14,6 +14,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/payments"
 )
 
@@ -59,13 +60,33 @@ func (server *Server) addCoupon(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	coupon, err := server.db.StripeCoinPayments().Coupons().Insert(ctx, payments.Coupon{
+	couponID, err := uuid.New()
+	if err != nil {
+		httpJSONError(w, "unable to create UUID",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	coupon := payments.Coupon{
+		ID:          couponID,
 		UserID:      input.UserID,
 		Amount:      input.Amount,
 		Duration:    &input.Duration,
 		Description: input.Description,
-	})
+	}
+
+	event, ok := server.beginAuditEvent(ctx, w, console.AuditEvent{
+		Action: "add coupon",
+		UserID: coupon.UserID,
+		Target: coupon.ID.String(),
+	}, nil, coupon)
+	if !ok {
+		return
+	}
+
+	coupon, err = server.db.StripeCoinPayments().Coupons().Insert(ctx, coupon)
 	if err != nil {
+		server.failAuditEvent(ctx, event, err)
 		httpJSONError(w, "failed to insert coupon",
 			err.Error(), http.StatusInternalServerError)
 		return
@@ -140,8 +161,31 @@ func (server *Server) deleteCoupon(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	coupon, err := server.db.StripeCoinPayments().Coupons().Get(ctx, couponID)
+	found := err == nil
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		httpJSONError(w, "failed to get coupon",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	event := console.AuditEvent{
+		Action: "delete coupon",
+		Target: couponID.String(),
+	}
+	var oldValue interface{}
+	if found {
+		event.UserID = coupon.UserID
+		oldValue = coupon
+	}
+	recorded, ok := server.beginAuditEvent(ctx, w, event, oldValue, nil)
+	if !ok {
+		return
+	}
+
 	err = server.db.StripeCoinPayments().Coupons().Delete(ctx, couponID)
 	if err != nil {
+		server.failAuditEvent(ctx, recorded, err)
 		httpJSONError(w, "unable to delete coupon",
 			err.Error(), http.StatusInternalServerError)
 		return
@@ -0,0 +1,433 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/overlay"
+)
+
+// nodeInfo is the information about a storage node returned by the node endpoints.
+type nodeInfo struct {
+	ID                    storj.NodeID `json:"id"`
+	Address               string       `json:"address"`
+	LastNet               string       `json:"lastNet"`
+	LastIPPort            string       `json:"lastIPPort"`
+	CountryCode           string       `json:"countryCode"`
+	FreeDisk              int64        `json:"freeDisk"`
+	Version               string       `json:"version"`
+	CreatedAt             time.Time    `json:"createdAt"`
+	LastContactSuccess    time.Time    `json:"lastContactSuccess"`
+	LastContactFailure    time.Time    `json:"lastContactFailure"`
+	VettedAt              *time.Time   `json:"vettedAt"`
+	Disqualified          *time.Time   `json:"disqualified"`
+	UnknownAuditSuspended *time.Time   `json:"unknownAuditSuspended"`
+	OfflineSuspended      *time.Time   `json:"offlineSuspended"`
+	ExitFinishedAt        *time.Time   `json:"exitFinishedAt"`
+	ExitSuccess           bool         `json:"exitSuccess"`
+}
+
+func newNodeInfo(node *overlay.NodeDossier) nodeInfo {
+	return nodeInfo{
+		ID:                    node.Id,
+		Address:               node.Address.GetAddress(),
+		LastNet:               node.LastNet,
+		LastIPPort:            node.LastIPPort,
+		CountryCode:           node.CountryCode,
+		FreeDisk:              node.Capacity.FreeDisk,
+		Version:               node.Version.Version,
+		CreatedAt:             node.CreatedAt,
+		LastContactSuccess:    node.Reputation.LastContactSuccess,
+		LastContactFailure:    node.Reputation.LastContactFailure,
+		VettedAt:              node.Reputation.VettedAt,
+		Disqualified:          node.Disqualified,
+		UnknownAuditSuspended: node.UnknownAuditSuspended,
+		OfflineSuspended:      node.OfflineSuspended,
+		ExitFinishedAt:        node.ExitStatus.ExitFinishedAt,
+		ExitSuccess:           node.ExitStatus.ExitSuccess,
+	}
+}
+
+func (server *Server) listNodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	subnet := r.URL.Query().Get("subnet")
+	if subnet == "" {
+		httpJSONError(w, "subnet missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	dossiers, err := server.db.OverlayCache().GetNodesByLastNet(ctx, subnet)
+	if err != nil {
+		httpJSONError(w, "unable to list nodes",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	nodes := make([]nodeInfo, 0, len(dossiers))
+	for _, node := range dossiers {
+		nodes = append(nodes, newNodeInfo(node))
+	}
+
+	data, err := json.Marshal(struct {
+		Nodes []nodeInfo `json:"nodes"`
+	}{
+		Nodes: nodes,
+	})
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) getNode(w http.ResponseWriter, r *http.Request) {
+	node, ok := server.nodeFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	data, err := json.Marshal(newNodeInfo(node))
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) getNodeReputation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	node, ok := server.nodeFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	info, err := server.reputation.Get(ctx, node.Id)
+	if err != nil {
+		httpJSONError(w, "unable to get node reputation",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type auditWindow struct {
+		WindowStart time.Time `json:"windowStart"`
+		TotalCount  int32     `json:"totalCount"`
+		OnlineCount int32     `json:"onlineCount"`
+	}
+
+	windows := make([]auditWindow, 0, len(info.AuditHistory.Windows))
+	for _, window := range info.AuditHistory.Windows {
+		windows = append(windows, auditWindow{
+			WindowStart: window.WindowStart,
+			TotalCount:  window.TotalCount,
+			OnlineCount: window.OnlineCount,
+		})
+	}
+
+	data, err := json.Marshal(struct {
+		ID                    storj.NodeID  `json:"id"`
+		AuditSuccessCount     int64         `json:"auditSuccessCount"`
+		TotalAuditCount       int64         `json:"totalAuditCount"`
+		AuditScore            float64       `json:"auditScore"`
+		UnknownAuditScore     float64       `json:"unknownAuditScore"`
+		OnlineScore           float64       `json:"onlineScore"`
+		VettedAt              *time.Time    `json:"vettedAt"`
+		Disqualified          *time.Time    `json:"disqualified"`
+		UnknownAuditSuspended *time.Time    `json:"unknownAuditSuspended"`
+		OfflineSuspended      *time.Time    `json:"offlineSuspended"`
+		UnderReview           *time.Time    `json:"underReview"`
+		AuditHistory          []auditWindow `json:"auditHistory"`
+	}{
+		ID:                    node.Id,
+		AuditSuccessCount:     info.AuditSuccessCount,
+		TotalAuditCount:       info.TotalAuditCount,
+		AuditScore:            reputationScore(info.AuditReputationAlpha, info.AuditReputationBeta),
+		UnknownAuditScore:     reputationScore(info.UnknownAuditReputationAlpha, info.UnknownAuditReputationBeta),
+		OnlineScore:           info.OnlineScore,
+		VettedAt:              info.VettedAt,
+		Disqualified:          info.Disqualified,
+		UnknownAuditSuspended: info.UnknownAuditSuspended,
+		OfflineSuspended:      info.OfflineSuspended,
+		UnderReview:           info.UnderReview,
+		AuditHistory:          windows,
+	})
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) suspendNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	node, ok := server.nodeFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	if node.UnknownAuditSuspended != nil {
+		httpJSONError(w, "node is already suspended",
+			"", http.StatusConflict)
+		return
+	}
+
+	suspendedAt := server.nowFn()
+	event, ok := server.beginAuditEvent(ctx, w, console.AuditEvent{
+		Action: "suspend node",
+		Target: node.Id.String(),
+	}, nil, map[string]time.Time{"unknownAuditSuspended": suspendedAt})
+	if !ok {
+		return
+	}
+
+	err := server.reputation.SuspendNodeUnknownAudit(ctx, node.Id, suspendedAt)
+	if err != nil {
+		server.failAuditEvent(ctx, event, err)
+		httpJSONError(w, "unable to suspend node",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) unsuspendNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	node, ok := server.nodeFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	if node.UnknownAuditSuspended == nil {
+		httpJSONError(w, "node is not suspended",
+			"", http.StatusConflict)
+		return
+	}
+
+	event, ok := server.beginAuditEvent(ctx, w, console.AuditEvent{
+		Action: "unsuspend node",
+		Target: node.Id.String(),
+	}, map[string]time.Time{"unknownAuditSuspended": *node.UnknownAuditSuspended}, nil)
+	if !ok {
+		return
+	}
+
+	err := server.reputation.UnsuspendNodeUnknownAudit(ctx, node.Id)
+	if err != nil {
+		server.failAuditEvent(ctx, event, err)
+		httpJSONError(w, "unable to unsuspend node",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) disqualifyNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	node, ok := server.nodeFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	if node.Disqualified != nil {
+		httpJSONError(w, "node is already disqualified",
+			"", http.StatusConflict)
+		return
+	}
+
+	event, ok := server.beginAuditEvent(ctx, w, console.AuditEvent{
+		Action: "disqualify node",
+		Target: node.Id.String(),
+	}, nil, nil)
+	if !ok {
+		return
+	}
+
+	err := server.reputation.DisqualifyNode(ctx, node.Id)
+	if err != nil {
+		server.failAuditEvent(ctx, event, err)
+		httpJSONError(w, "unable to disqualify node",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) reinstateNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	node, ok := server.nodeFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	if node.Disqualified == nil {
+		httpJSONError(w, "node is not disqualified",
+			"", http.StatusConflict)
+		return
+	}
+
+	event, ok := server.beginAuditEvent(ctx, w, console.AuditEvent{
+		Action: "reinstate node",
+		Target: node.Id.String(),
+	}, map[string]time.Time{"disqualified": *node.Disqualified}, nil)
+	if !ok {
+		return
+	}
+
+	err := server.reputation.ReinstateNode(ctx, node.Id)
+	if err != nil {
+		server.failAuditEvent(ctx, event, err)
+		httpJSONError(w, "unable to reinstate node",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) markNodeExited(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	node, ok := server.nodeFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Success *bool `json:"success"`
+	}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &input)
+		if err != nil {
+			httpJSONError(w, "failed to unmarshal request",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if node.ExitStatus.ExitFinishedAt != nil {
+		httpJSONError(w, "node has already exited",
+			"", http.StatusConflict)
+		return
+	}
+
+	now := server.nowFn()
+	request := &overlay.ExitStatusRequest{
+		NodeID:              node.Id,
+		ExitInitiatedAt:     now,
+		ExitLoopCompletedAt: now,
+		ExitFinishedAt:      now,
+		ExitSuccess:         input.Success == nil || *input.Success,
+	}
+	if node.ExitStatus.ExitInitiatedAt != nil {
+		request.ExitInitiatedAt = *node.ExitStatus.ExitInitiatedAt
+	}
+	if node.ExitStatus.ExitLoopCompletedAt != nil {
+		request.ExitLoopCompletedAt = *node.ExitStatus.ExitLoopCompletedAt
+	}
+
+	event, ok := server.beginAuditEvent(ctx, w, console.AuditEvent{
+		Action: "mark node exited",
+		Target: node.Id.String(),
+	}, nil, map[string]bool{"exitSuccess": request.ExitSuccess})
+	if !ok {
+		return
+	}
+
+	// remove the remaining transfer queue items, like graceful exit does when it finishes.
+	// It's done before the exit status is changed, so a failed request can be retried.
+	usesSegmentTransferQueue := true
+	progress, err := server.db.GracefulExit().GetProgress(ctx, node.Id)
+	if err != nil && !errs.Is(err, sql.ErrNoRows) {
+		server.failAuditEvent(ctx, event, err)
+		httpJSONError(w, "unable to get graceful exit progress",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if progress != nil {
+		usesSegmentTransferQueue = progress.UsesSegmentTransferQueue
+	}
+	err = server.db.GracefulExit().DeleteTransferQueueItems(ctx, node.Id, usesSegmentTransferQueue)
+	if err != nil {
+		server.failAuditEvent(ctx, event, err)
+		httpJSONError(w, "unable to delete graceful exit transfer queue items",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	_, err = server.db.OverlayCache().UpdateExitStatus(ctx, request)
+	if err != nil {
+		server.failAuditEvent(ctx, event, err)
+		httpJSONError(w, "unable to mark node as exited",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// nodeFromVars parses the node id from the request path and gets the node.
+// It writes the error response when the node id is invalid or the node doesn't exist.
+func (server *Server) nodeFromVars(w http.ResponseWriter, r *http.Request) (_ *overlay.NodeDossier, ok bool) {
+	vars := mux.Vars(r)
+	nodeIDString, ok := vars["nodeid"]
+	if !ok {
+		httpJSONError(w, "node-id missing",
+			"", http.StatusBadRequest)
+		return nil, false
+	}
+
+	nodeID, err := storj.NodeIDFromString(nodeIDString)
+	if err != nil {
+		httpJSONError(w, "invalid node-id",
+			err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	node, err := server.db.OverlayCache().Get(r.Context(), nodeID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			httpJSONError(w, "node does not exist",
+				"", http.StatusNotFound)
+			return nil, false
+		}
+		httpJSONError(w, "unable to get node",
+			err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return node, true
+}
+
+// reputationScore returns the beta reputation score for the alpha and beta values.
+func reputationScore(alpha, beta float64) float64 {
+	if alpha+beta == 0 {
+		return 0
+	}
+	return alpha / (alpha + beta)
+}
@@ -0,0 +1,187 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+)
+
+func TestGetNode(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 1,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+				config.Overlay.GeoIP.MockCountries = []string{"DE"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		node := planet.StorageNodes[0]
+
+		require.NoError(t, node.Contact.Service.PingSatellites(ctx, 0))
+
+		link := "http://" + address.String() + "/api/nodes/" + node.ID().String()
+		body := assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", authToken)
+
+		var output struct {
+			ID          string `json:"id"`
+			Address     string `json:"address"`
+			CountryCode string `json:"countryCode"`
+		}
+		require.NoError(t, json.Unmarshal(body, &output))
+		require.Equal(t, node.ID().String(), output.ID)
+		require.Equal(t, node.Addr(), output.Address)
+		require.Equal(t, "DE", output.CountryCode)
+
+		missing := "http://" + address.String() + "/api/nodes/" + testrand.NodeID().String()
+		assertReq(ctx, t, missing, http.MethodGet, "", http.StatusNotFound, "", authToken)
+
+		invalid := "http://" + address.String() + "/api/nodes/invalid"
+		assertReq(ctx, t, invalid, http.MethodGet, "", http.StatusBadRequest, "", authToken)
+	})
+}
+
+func TestNodeManagement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 2,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		node := planet.StorageNodes[0]
+
+		baseURL := "http://" + address.String() + "/api/nodes"
+		link := baseURL + "/" + node.ID().String()
+
+		getNode := func() *overlay.NodeDossier {
+			dossier, err := sat.Overlay.DB.Get(ctx, node.ID())
+			require.NoError(t, err)
+			return dossier
+		}
+
+		t.Run("list by subnet", func(t *testing.T) {
+			body := assertReq(ctx, t, baseURL+"?subnet="+getNode().LastNet, http.MethodGet, "", http.StatusOK, "", authToken)
+
+			var output struct {
+				Nodes []struct {
+					ID string `json:"id"`
+				} `json:"nodes"`
+			}
+			require.NoError(t, json.Unmarshal(body, &output))
+
+			var ids []string
+			for _, node := range output.Nodes {
+				ids = append(ids, node.ID)
+			}
+			require.Contains(t, ids, node.ID().String())
+			require.Contains(t, ids, planet.StorageNodes[1].ID().String())
+
+			assertReq(ctx, t, baseURL, http.MethodGet, "", http.StatusBadRequest, "", authToken)
+		})
+
+		t.Run("reputation", func(t *testing.T) {
+			body := assertReq(ctx, t, link+"/reputation", http.MethodGet, "", http.StatusOK, "", authToken)
+
+			var output struct {
+				ID          string  `json:"id"`
+				OnlineScore float64 `json:"onlineScore"`
+			}
+			require.NoError(t, json.Unmarshal(body, &output))
+			require.Equal(t, node.ID().String(), output.ID)
+			require.Equal(t, float64(1), output.OnlineScore)
+
+			missing := baseURL + "/" + testrand.NodeID().String() + "/reputation"
+			assertReq(ctx, t, missing, http.MethodGet, "", http.StatusNotFound, "", authToken)
+		})
+
+		t.Run("suspension", func(t *testing.T) {
+			assertReq(ctx, t, link+"/suspension", http.MethodDelete, "", http.StatusConflict, "", authToken)
+
+			assertReq(ctx, t, link+"/suspension", http.MethodPut, "", http.StatusOK, "", authToken)
+			require.NotNil(t, getNode().UnknownAuditSuspended)
+
+			info, err := sat.Reputation.Service.Get(ctx, node.ID())
+			require.NoError(t, err)
+			require.NotNil(t, info.UnknownAuditSuspended)
+
+			assertReq(ctx, t, link+"/suspension", http.MethodPut, "", http.StatusConflict, "", authToken)
+
+			assertReq(ctx, t, link+"/suspension", http.MethodDelete, "", http.StatusOK, "", authToken)
+			require.Nil(t, getNode().UnknownAuditSuspended)
+		})
+
+		t.Run("disqualification", func(t *testing.T) {
+			assertReq(ctx, t, link+"/disqualification", http.MethodDelete, "", http.StatusConflict, "", authToken)
+
+			require.NoError(t, sat.Reputation.Service.ApplyAudit(ctx, node.ID(), reputation.AuditFailure))
+
+			assertReq(ctx, t, link+"/disqualification", http.MethodPut, "", http.StatusOK, "", authToken)
+			require.NotNil(t, getNode().Disqualified)
+
+			info, err := sat.Reputation.Service.Get(ctx, node.ID())
+			require.NoError(t, err)
+			require.NotNil(t, info.Disqualified)
+
+			assertReq(ctx, t, link+"/disqualification", http.MethodPut, "", http.StatusConflict, "", authToken)
+
+			assertReq(ctx, t, link+"/disqualification", http.MethodDelete, "", http.StatusOK, "", authToken)
+			require.Nil(t, getNode().Disqualified)
+
+			// reinstating resets the audit reputation
+			info, err = sat.Reputation.Service.Get(ctx, node.ID())
+			require.NoError(t, err)
+			require.Nil(t, info.Disqualified)
+			require.EqualValues(t, 1, info.AuditReputationAlpha)
+			require.EqualValues(t, 0, info.AuditReputationBeta)
+		})
+
+		t.Run("exit", func(t *testing.T) {
+			require.NoError(t, sat.DB.GracefulExit().Enqueue(ctx, []gracefulexit.TransferQueueItem{{
+				NodeID:          node.ID(),
+				StreamID:        testrand.UUID(),
+				PieceNum:        1,
+				RootPieceID:     testrand.PieceID(),
+				DurabilityRatio: 0.9,
+			}}, 10, true))
+
+			assertReq(ctx, t, link+"/exit", http.MethodPut, `{"success": false}`, http.StatusOK, "", authToken)
+
+			dossier := getNode()
+			require.NotNil(t, dossier.ExitStatus.ExitFinishedAt)
+			require.False(t, dossier.ExitStatus.ExitSuccess)
+
+			// the remaining transfer queue items are removed
+			items, err := sat.DB.GracefulExit().GetIncomplete(ctx, node.ID(), 10, 0, true)
+			require.NoError(t, err)
+			require.Empty(t, items)
+
+			assertReq(ctx, t, link+"/exit", http.MethodPut, "", http.StatusConflict, "", authToken)
+		})
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 )
 
@@ -128,7 +129,9 @@ func (server *Server) getProjectLimit(w http.ResponseWriter, r *http.Request) {
 		Rate struct {
 			RPS int `json:"rps"`
 		} `json:"rate"`
-		Buckets int `json:"maxBuckets"`
+		Buckets  int   `json:"maxBuckets"`
+		Segments int64 `json:"maxSegments"`
+		Objects  int64 `json:"maxObjects"`
 	}
 	if project.StorageLimit != nil {
 		output.Usage.Amount = *project.StorageLimit
@@ -144,6 +147,12 @@ func (server *Server) getProjectLimit(w http.ResponseWriter, r *http.Request) {
 	if project.RateLimit != nil {
 		output.Rate.RPS = *project.RateLimit
 	}
+	if project.SegmentLimit != nil {
+		output.Segments = *project.SegmentLimit
+	}
+	if project.ObjectLimit != nil {
+		output.Objects = *project.ObjectLimit
+	}
 
 	data, err := json.Marshal(output)
 	if err != nil {
@@ -179,6 +188,8 @@ func (server *Server) putProjectLimit(w http.ResponseWriter, r *http.Request) {
 		Bandwidth *memory.Size `schema:"bandwidth"`
 		Rate      *int         `schema:"rate"`
 		Buckets   *int         `schema:"buckets"`
+		Segments  *int64       `schema:"segments"`
+		Objects   *int64       `schema:"objects"`
 	}
 
 	if err := r.ParseForm(); err != nil {
@@ -195,64 +206,106 @@ func (server *Server) putProjectLimit(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if arguments.Usage != nil {
-		if *arguments.Usage < 0 {
-			httpJSONError(w, "negative usage",
-				fmt.Sprintf("%v", arguments.Usage), http.StatusBadRequest)
-			return
-		}
+	switch {
+	case arguments.Usage != nil && *arguments.Usage < 0:
+		httpJSONError(w, "negative usage",
+			fmt.Sprintf("%v", arguments.Usage), http.StatusBadRequest)
+		return
+	case arguments.Bandwidth != nil && *arguments.Bandwidth < 0:
+		httpJSONError(w, "negative bandwidth",
+			fmt.Sprintf("%v", arguments.Bandwidth), http.StatusBadRequest)
+		return
+	case arguments.Rate != nil && *arguments.Rate < 0:
+		httpJSONError(w, "negative rate",
+			fmt.Sprintf("%v", arguments.Rate), http.StatusBadRequest)
+		return
+	case arguments.Buckets != nil && *arguments.Buckets < 0:
+		httpJSONError(w, "negative bucket count",
+			fmt.Sprintf("%v", arguments.Buckets), http.StatusBadRequest)
+		return
+	case arguments.Segments != nil && *arguments.Segments < 0:
+		httpJSONError(w, "negative segment count",
+			fmt.Sprintf("%v", *arguments.Segments), http.StatusBadRequest)
+		return
+	case arguments.Objects != nil && *arguments.Objects < 0:
+		httpJSONError(w, "negative object count",
+			fmt.Sprintf("%v", *arguments.Objects), http.StatusBadRequest)
+		return
+	}
 
-		err = server.db.ProjectAccounting().UpdateProjectUsageLimit(ctx, projectUUID, *arguments.Usage)
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		project, err := tx.Projects().Get(ctx, projectUUID)
 		if err != nil {
-			httpJSONError(w, "failed to update usage",
-				err.Error(), http.StatusInternalServerError)
-			return
+			return err
 		}
-	}
 
-	if arguments.Bandwidth != nil {
-		if *arguments.Bandwidth < 0 {
-			httpJSONError(w, "negative bandwidth",
-				fmt.Sprintf("%v", arguments.Usage), http.StatusBadRequest)
-			return
-		}
+		oldLimits := map[string]interface{}{}
+		newLimits := map[string]interface{}{}
 
-		err = server.db.ProjectAccounting().UpdateProjectBandwidthLimit(ctx, projectUUID, *arguments.Bandwidth)
-		if err != nil {
-			httpJSONError(w, "failed to update bandwidth",
-				err.Error(), http.StatusInternalServerError)
-			return
+		updateProject := false
+		if arguments.Usage != nil {
+			oldLimits["usage"] = project.StorageLimit
+			newLimits["usage"] = *arguments.Usage
+			project.StorageLimit = arguments.Usage
+			updateProject = true
 		}
-	}
-
-	if arguments.Rate != nil {
-		if *arguments.Rate < 0 {
-			httpJSONError(w, "negative rate",
-				fmt.Sprintf("%v", arguments.Rate), http.StatusBadRequest)
-			return
+		if arguments.Bandwidth != nil {
+			oldLimits["bandwidth"] = project.BandwidthLimit
+			newLimits["bandwidth"] = *arguments.Bandwidth
+			project.BandwidthLimit = arguments.Bandwidth
+			updateProject = true
 		}
-
-		err = server.db.Console().Projects().UpdateRateLimit(ctx, projectUUID, *arguments.Rate)
-		if err != nil {
-			httpJSONError(w, "failed to update rate",
-				err.Error(), http.StatusInternalServerError)
-			return
+		if arguments.Segments != nil {
+			oldLimits["segments"] = project.SegmentLimit
+			newLimits["segments"] = *arguments.Segments
+			project.SegmentLimit = arguments.Segments
+			updateProject = true
+		}
+		if arguments.Objects != nil {
+			oldLimits["objects"] = project.ObjectLimit
+			newLimits["objects"] = *arguments.Objects
+			project.ObjectLimit = arguments.Objects
+			updateProject = true
+		}
+		if updateProject {
+			if err := tx.Projects().Update(ctx, project); err != nil {
+				return err
+			}
 		}
-	}
 
-	if arguments.Buckets != nil {
-		if *arguments.Buckets < 0 {
-			httpJSONError(w, "negative bucket coun",
-				fmt.Sprintf("t: %v", arguments.Buckets), http.StatusBadRequest)
-			return
+		if arguments.Rate != nil {
+			oldLimits["rate"] = project.RateLimit
+			newLimits["rate"] = *arguments.Rate
+			if err := tx.Projects().UpdateRateLimit(ctx, projectUUID, *arguments.Rate); err != nil {
+				return err
+			}
+		}
+		if arguments.Buckets != nil {
+			oldLimits["buckets"] = project.MaxBuckets
+			newLimits["buckets"] = *arguments.Buckets
+			if err := tx.Projects().UpdateBucketLimit(ctx, projectUUID, *arguments.Buckets); err != nil {
+				return err
+			}
 		}
 
-		err = server.db.Console().Projects().UpdateBucketLimit(ctx, projectUUID, *arguments.Buckets)
-		if err != nil {
-			httpJSONError(w, "failed to update bucket limit",
-				err.Error(), http.StatusInternalServerError)
-			return
+		if len(newLimits) == 0 {
+			return nil
 		}
+		return recordAuditEvent(ctx, tx, console.AuditEvent{
+			Action:    "update project limit",
+			ProjectID: projectUUID,
+			Target:    projectUUID.String(),
+		}, oldLimits, newLimits)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		httpJSONError(w, "project with specified uuid does not exist",
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httpJSONError(w, "failed to update project limits",
+			err.Error(), http.StatusInternalServerError)
+		return
 	}
 }
 
@@ -294,9 +347,27 @@ func (server *Server) addProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	project, err := server.db.Console().Projects().Insert(ctx, &console.Project{
-		Name:    input.ProjectName,
-		OwnerID: input.OwnerID,
+	var project *console.Project
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) (err error) {
+		project, err = tx.Projects().Insert(ctx, &console.Project{
+			Name:    input.ProjectName,
+			OwnerID: input.OwnerID,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ProjectMembers().Insert(ctx, project.OwnerID, project.ID, console.RoleOwner)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, tx, console.AuditEvent{
+			Action:    "add project",
+			UserID:    project.OwnerID,
+			ProjectID: project.ID,
+			Target:    project.ID.String(),
+		}, nil, map[string]string{"name": project.Name})
 	})
 	if err != nil {
 		httpJSONError(w, "failed to insert project",
@@ -304,13 +375,6 @@ func (server *Server) addProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = server.db.Console().ProjectMembers().Insert(ctx, project.OwnerID, project.ID)
-	if err != nil {
-		httpJSONError(w, "failed to insert project member",
-			err.Error(), http.StatusInternalServerError)
-		return
-	}
-
 	output.ProjectID = project.ID
 	data, err := json.Marshal(output)
 	if err != nil {
@@ -378,10 +442,20 @@ func (server *Server) renameProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	oldValue := map[string]string{"name": project.Name, "description": project.Description}
 	project.Name = input.ProjectName
 	project.Description = input.Description
 
-	err = server.db.Console().Projects().Update(ctx, project)
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		if err := tx.Projects().Update(ctx, project); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, console.AuditEvent{
+			Action:    "rename project",
+			ProjectID: project.ID,
+			Target:    project.ID.String(),
+		}, oldValue, map[string]string{"name": project.Name, "description": project.Description})
+	})
 	if err != nil {
 		httpJSONError(w, "error renaming project",
 			err.Error(), http.StatusInternalServerError)
@@ -426,6 +500,18 @@ func (server *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	locked, err := server.metabaseDB.HasLockedObjects(ctx, metabase.HasLockedObjects{ProjectID: projectUUID})
+	if err != nil {
+		httpJSONError(w, "unable to check locked objects",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if locked {
+		httpJSONError(w, "locked objects still exist",
+			"objects under retention or legal hold cannot be deleted", http.StatusConflict)
+		return
+	}
+
 	keys, err := server.db.Console().APIKeys().GetPagedByProjectID(ctx, projectUUID, console.APIKeyCursor{Limit: 1, Page: 1})
 	if err != nil {
 		httpJSONError(w, "unable to list api-keys",
@@ -443,7 +529,16 @@ func (server *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = server.db.Console().Projects().Delete(ctx, projectUUID)
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		if err := tx.Projects().Delete(ctx, projectUUID); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, console.AuditEvent{
+			Action:    "delete project",
+			ProjectID: projectUUID,
+			Target:    projectUUID.String(),
+		}, nil, nil)
+	})
 	if err != nil {
 		httpJSONError(w, "unable to delete project",
 			err.Error(), http.StatusInternalServerError)
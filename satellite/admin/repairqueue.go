@@ -0,0 +1,308 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/schema"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/placement"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// injuredSegment is the JSON representation of a repair queue entry.
+type injuredSegment struct {
+	StreamID      uuid.UUID  `json:"streamID"`
+	Position      uint64     `json:"position"`
+	SegmentHealth float64    `json:"segmentHealth"`
+	Placement     string     `json:"placement"`
+	AttemptedAt   *time.Time `json:"attemptedAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+	InsertedAt    time.Time  `json:"insertedAt"`
+}
+
+func newInjuredSegment(segment queue.InjuredSegment) injuredSegment {
+	return injuredSegment{
+		StreamID:      segment.StreamID,
+		Position:      segment.Position.Encode(),
+		SegmentHealth: segment.SegmentHealth,
+		Placement:     segment.Placement.String(),
+		AttemptedAt:   segment.AttemptedAt,
+		UpdatedAt:     segment.UpdatedAt,
+		InsertedAt:    segment.InsertedAt,
+	}
+}
+
+func (server *Server) listRepairQueue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var arguments struct {
+		Placement string `schema:"placement"`
+		StreamID  string `schema:"streamID"`
+		Limit     int    `schema:"limit"`
+		Cursor    string `schema:"cursor"`
+	}
+
+	if err := r.ParseForm(); err != nil {
+		httpJSONError(w, "invalid form",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	decoder := schema.NewDecoder()
+	err := decoder.Decode(&arguments, r.Form)
+	if err != nil {
+		httpJSONError(w, "invalid arguments",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	opts := queue.ListOptions{Limit: arguments.Limit}
+
+	if arguments.Placement != "" {
+		constraint, err := placement.Parse(arguments.Placement)
+		if err != nil {
+			httpJSONError(w, "unsupported placement",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		opts.Filter.Placements = []placement.Placement{constraint}
+	}
+
+	if arguments.StreamID != "" {
+		opts.Filter.StreamID, err = uuid.FromString(arguments.StreamID)
+		if err != nil {
+			httpJSONError(w, "invalid stream-id",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if arguments.Cursor != "" {
+		cursor, err := parseRepairQueueCursor(arguments.Cursor)
+		if err != nil {
+			httpJSONError(w, "invalid cursor",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		opts.Cursor = &cursor
+	}
+
+	result, err := server.db.RepairQueue().List(ctx, opts)
+	if err != nil {
+		httpJSONError(w, "unable to list repair queue",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := struct {
+		Segments []injuredSegment `json:"segments"`
+		More     bool             `json:"more"`
+		Cursor   string           `json:"cursor,omitempty"`
+	}{
+		Segments: []injuredSegment{},
+		More:     result.More,
+	}
+	for _, segment := range result.Segments {
+		output.Segments = append(output.Segments, newInjuredSegment(segment))
+	}
+	if result.More {
+		last := result.Segments[len(result.Segments)-1]
+		output.Cursor = formatRepairQueueCursor(queue.ListCursor{
+			SegmentHealth: last.SegmentHealth,
+			StreamID:      last.StreamID,
+			Position:      last.Position,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+func (server *Server) getRepairQueueSegment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	streamID, position, ok := segmentFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	segment, err := server.db.RepairQueue().Get(ctx, streamID, position)
+	if err != nil {
+		if queue.ErrSegmentNotFound.Has(err) {
+			httpJSONError(w, "segment is not in the repair queue",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to get segment from the repair queue",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(newInjuredSegment(*segment))
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+func (server *Server) putRepairQueueSegment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	streamID, position, ok := segmentFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		SegmentHealth *float64 `json:"segmentHealth"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		httpJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if input.SegmentHealth == nil {
+		httpJSONError(w, "segmentHealth missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.RepairQueue().UpdateSegmentHealth(ctx, streamID, position, *input.SegmentHealth)
+	if err != nil {
+		if queue.ErrSegmentNotFound.Has(err) {
+			httpJSONError(w, "segment is not in the repair queue",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to update segment health",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) deleteRepairQueueSegment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	streamID, position, ok := segmentFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	segment, err := server.db.RepairQueue().Get(ctx, streamID, position)
+	if err != nil {
+		if queue.ErrSegmentNotFound.Has(err) {
+			httpJSONError(w, "segment is not in the repair queue",
+				"", http.StatusNotFound)
+			return
+		}
+		httpJSONError(w, "unable to get segment from the repair queue",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = server.db.RepairQueue().Delete(ctx, segment)
+	if err != nil {
+		httpJSONError(w, "unable to delete segment from the repair queue",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// segmentFromVars parses stream id and position from the request path.
+// It writes the error response when they are missing or invalid.
+func segmentFromVars(w http.ResponseWriter, r *http.Request) (streamID uuid.UUID, position metabase.SegmentPosition, ok bool) {
+	vars := mux.Vars(r)
+	streamIDString, ok := vars["streamid"]
+	if !ok {
+		httpJSONError(w, "stream-id missing",
+			"", http.StatusBadRequest)
+		return uuid.UUID{}, metabase.SegmentPosition{}, false
+	}
+
+	streamID, err := uuid.FromString(streamIDString)
+	if err != nil {
+		httpJSONError(w, "invalid stream-id",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, metabase.SegmentPosition{}, false
+	}
+
+	positionString, ok := vars["position"]
+	if !ok {
+		httpJSONError(w, "position missing",
+			"", http.StatusBadRequest)
+		return uuid.UUID{}, metabase.SegmentPosition{}, false
+	}
+
+	encodedPosition, err := strconv.ParseUint(positionString, 10, 64)
+	if err != nil {
+		httpJSONError(w, "invalid position",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, metabase.SegmentPosition{}, false
+	}
+
+	return streamID, metabase.SegmentPositionFromEncoded(encodedPosition), true
+}
+
+// formatRepairQueueCursor formats the cursor as "segmentHealth:streamID:position".
+func formatRepairQueueCursor(cursor queue.ListCursor) string {
+	return fmt.Sprintf("%s:%s:%d",
+		strconv.FormatFloat(cursor.SegmentHealth, 'g', -1, 64),
+		cursor.StreamID, cursor.Position.Encode())
+}
+
+// parseRepairQueueCursor parses the cursor formatted by formatRepairQueueCursor.
+func parseRepairQueueCursor(s string) (cursor queue.ListCursor, err error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return queue.ListCursor{}, Error.New("expected segmentHealth:streamID:position")
+	}
+
+	cursor.SegmentHealth, err = strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return queue.ListCursor{}, Error.Wrap(err)
+	}
+	cursor.StreamID, err = uuid.FromString(parts[1])
+	if err != nil {
+		return queue.ListCursor{}, Error.Wrap(err)
+	}
+	position, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return queue.ListCursor{}, Error.Wrap(err)
+	}
+	cursor.Position = metabase.SegmentPositionFromEncoded(position)
+
+	return cursor, nil
+}
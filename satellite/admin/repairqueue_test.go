@@ -0,0 +1,136 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/placement"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func TestRepairQueue(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		repairQueue := sat.DB.RepairQueue()
+
+		segments := []*queue.InjuredSegment{
+			{StreamID: testrand.UUID(), SegmentHealth: 1},
+			{StreamID: testrand.UUID(), SegmentHealth: 2, Placement: placement.EU},
+			{StreamID: testrand.UUID(), SegmentHealth: 3, Position: metabase.SegmentPosition{Index: 1}},
+		}
+		for _, segment := range segments {
+			_, err := repairQueue.Insert(ctx, segment)
+			require.NoError(t, err)
+		}
+
+		type listing struct {
+			Segments []struct {
+				StreamID      string  `json:"streamID"`
+				Position      uint64  `json:"position"`
+				SegmentHealth float64 `json:"segmentHealth"`
+				Placement     string  `json:"placement"`
+			} `json:"segments"`
+			More   bool   `json:"more"`
+			Cursor string `json:"cursor"`
+		}
+
+		baseURL := "http://" + address.String() + "/api/repair-queue"
+
+		t.Run("list", func(t *testing.T) {
+			body := assertReq(ctx, t, baseURL+"?limit=2", http.MethodGet, "", http.StatusOK, "", authToken)
+
+			var page listing
+			require.NoError(t, json.Unmarshal(body, &page))
+			require.True(t, page.More)
+			require.Len(t, page.Segments, 2)
+			require.Equal(t, segments[0].StreamID.String(), page.Segments[0].StreamID)
+			require.Equal(t, segments[1].StreamID.String(), page.Segments[1].StreamID)
+			require.Equal(t, "eu", page.Segments[1].Placement)
+
+			body = assertReq(ctx, t, baseURL+"?limit=2&cursor="+page.Cursor, http.MethodGet, "", http.StatusOK, "", authToken)
+
+			page = listing{}
+			require.NoError(t, json.Unmarshal(body, &page))
+			require.False(t, page.More)
+			require.Len(t, page.Segments, 1)
+			require.Equal(t, segments[2].StreamID.String(), page.Segments[0].StreamID)
+			require.Equal(t, segments[2].Position.Encode(), page.Segments[0].Position)
+
+			body = assertReq(ctx, t, baseURL+"?placement=eu", http.MethodGet, "", http.StatusOK, "", authToken)
+
+			page = listing{}
+			require.NoError(t, json.Unmarshal(body, &page))
+			require.Len(t, page.Segments, 1)
+			require.Equal(t, segments[1].StreamID.String(), page.Segments[0].StreamID)
+
+			assertReq(ctx, t, baseURL+"?placement=mars", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+			assertReq(ctx, t, baseURL+"?cursor=invalid", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+		})
+
+		segmentURL := func(segment *queue.InjuredSegment) string {
+			return baseURL + "/" + segment.StreamID.String() + "/" + strconv.FormatUint(segment.Position.Encode(), 10)
+		}
+
+		t.Run("get", func(t *testing.T) {
+			body := assertReq(ctx, t, segmentURL(segments[2]), http.MethodGet, "", http.StatusOK, "", authToken)
+
+			var output struct {
+				StreamID      string  `json:"streamID"`
+				SegmentHealth float64 `json:"segmentHealth"`
+			}
+			require.NoError(t, json.Unmarshal(body, &output))
+			require.Equal(t, segments[2].StreamID.String(), output.StreamID)
+			require.Equal(t, 3.0, output.SegmentHealth)
+
+			missing := &queue.InjuredSegment{StreamID: testrand.UUID()}
+			assertReq(ctx, t, segmentURL(missing), http.MethodGet, "", http.StatusNotFound, "", authToken)
+			assertReq(ctx, t, baseURL+"/invalid/0", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+		})
+
+		t.Run("reprioritize", func(t *testing.T) {
+			assertReq(ctx, t, segmentURL(segments[2]), http.MethodPut, `{"segmentHealth":0}`, http.StatusOK, "", authToken)
+
+			selected, err := repairQueue.Select(ctx)
+			require.NoError(t, err)
+			require.Equal(t, segments[2].StreamID, selected.StreamID)
+
+			assertReq(ctx, t, segmentURL(segments[2]), http.MethodPut, `{}`, http.StatusBadRequest, "", authToken)
+
+			missing := &queue.InjuredSegment{StreamID: testrand.UUID()}
+			assertReq(ctx, t, segmentURL(missing), http.MethodPut, `{"segmentHealth":0}`, http.StatusNotFound, "", authToken)
+		})
+
+		t.Run("drop", func(t *testing.T) {
+			assertReq(ctx, t, segmentURL(segments[0]), http.MethodDelete, "", http.StatusOK, "", authToken)
+			assertReq(ctx, t, segmentURL(segments[0]), http.MethodDelete, "", http.StatusNotFound, "", authToken)
+
+			count, err := repairQueue.Count(ctx)
+			require.NoError(t, err)
+			require.Equal(t, 2, count)
+		})
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -19,14 +20,20 @@ import (
 	"storj.io/common/errs2"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/reputation"
 )
 
 // Config defines configuration for debug server.
 type Config struct {
-	Address string `help:"admin peer http listening address" releaseDefault:"" devDefault:""`
+	Address   string   `help:"admin peer http listening address" releaseDefault:"" devDefault:""`
+	Operators []string `help:"admin operators as name:token, when set only their tokens are accepted and changes are recorded with their name" default:""`
 
 	AuthorizationToken string `internal:"true"`
 }
@@ -41,6 +48,12 @@ type DB interface {
 	StripeCoinPayments() stripecoinpayments.DB
 	// Buckets returns database for satellite buckets
 	Buckets() metainfo.BucketsDB
+	// OverlayCache returns database for caching overlay information
+	OverlayCache() overlay.DB
+	// GracefulExit returns database for graceful exit
+	GracefulExit() gracefulexit.DB
+	// RepairQueue returns queue for segments that need repairing
+	RepairQueue() queue.RepairQueue
 }
 
 // Server provides endpoints for administrative tasks.
@@ -51,30 +64,43 @@ type Server struct {
 	server   http.Server
 	mux      *mux.Router
 
-	db       DB
-	payments payments.Accounts
+	db         DB
+	metabaseDB *metabase.DB
+	payments   payments.Accounts
+	reputation *reputation.Service
 
 	nowFn func() time.Time
 }
 
 // NewServer returns a new administration Server.
-func NewServer(log *zap.Logger, listener net.Listener, db DB, accounts payments.Accounts, config Config) *Server {
+func NewServer(log *zap.Logger, listener net.Listener, db DB, metabaseDB *metabase.DB, accounts payments.Accounts, reputation *reputation.Service, config Config) *Server {
 	server := &Server{
 		log: log,
 
 		listener: listener,
 		mux:      mux.NewRouter(),
 
-		db:       db,
-		payments: accounts,
+		db:         db,
+		metabaseDB: metabaseDB,
+		payments:   accounts,
+		reputation: reputation,
 
 		nowFn: time.Now,
 	}
 
-	server.server.Handler = &protectedServer{
+	protected := &protectedServer{
 		allowedAuthorization: config.AuthorizationToken,
 		next:                 server.mux,
 	}
+	for _, operator := range config.Operators {
+		name, token, err := parseOperator(operator)
+		if err != nil {
+			log.Error("invalid admin operator", zap.Error(err))
+			continue
+		}
+		protected.operators = append(protected.operators, adminOperator{name: name, token: token})
+	}
+	server.server.Handler = protected
 
 	// When adding new options, also update README.md
 	server.mux.HandleFunc("/api/users", server.addUser).Methods("POST")
@@ -94,32 +120,91 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, accounts payments.
 	server.mux.HandleFunc("/api/projects/{project}/apikeys", server.listAPIKeys).Methods("GET")
 	server.mux.HandleFunc("/api/projects/{project}/apikeys", server.addAPIKey).Methods("POST")
 	server.mux.HandleFunc("/api/projects/{project}/apikeys/{name}", server.deleteAPIKeyByName).Methods("DELETE")
+	server.mux.HandleFunc("/api/projects/{project}/buckets/{bucket}/versioning", server.getBucketVersioning).Methods("GET")
+	server.mux.HandleFunc("/api/projects/{project}/buckets/{bucket}/versioning", server.putBucketVersioning).Methods("PUT")
+	server.mux.HandleFunc("/api/projects/{project}/buckets/{bucket}/lifecycle", server.getBucketLifecycle).Methods("GET")
+	server.mux.HandleFunc("/api/projects/{project}/buckets/{bucket}/lifecycle", server.putBucketLifecycle).Methods("PUT")
+	server.mux.HandleFunc("/api/projects/{project}/buckets/{bucket}/lifecycle", server.deleteBucketLifecycle).Methods("DELETE")
+	server.mux.HandleFunc("/api/projects/{project}/buckets/{bucket}/notifications", server.getBucketNotifications).Methods("GET")
+	server.mux.HandleFunc("/api/projects/{project}/buckets/{bucket}/notifications", server.putBucketNotifications).Methods("PUT")
+	server.mux.HandleFunc("/api/projects/{project}/buckets/{bucket}/notifications", server.deleteBucketNotifications).Methods("DELETE")
+	server.mux.HandleFunc("/api/projects/{project}/buckets/{bucket}/placement", server.getBucketPlacement).Methods("GET")
+	server.mux.HandleFunc("/api/projects/{project}/buckets/{bucket}/placement", server.putBucketPlacement).Methods("PUT")
+	server.mux.HandleFunc("/api/projects/{project}/buckets/{bucket}/limits", server.getBucketLimits).Methods("GET")
+	server.mux.HandleFunc("/api/projects/{project}/buckets/{bucket}/limits", server.putBucketLimits).Methods("PUT")
 	server.mux.HandleFunc("/api/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
+	server.mux.HandleFunc("/api/nodes", server.listNodes).Methods("GET")
+	server.mux.HandleFunc("/api/nodes/{nodeid}", server.getNode).Methods("GET")
+	server.mux.HandleFunc("/api/nodes/{nodeid}/reputation", server.getNodeReputation).Methods("GET")
+	server.mux.HandleFunc("/api/nodes/{nodeid}/suspension", server.suspendNode).Methods("PUT")
+	server.mux.HandleFunc("/api/nodes/{nodeid}/suspension", server.unsuspendNode).Methods("DELETE")
+	server.mux.HandleFunc("/api/nodes/{nodeid}/disqualification", server.disqualifyNode).Methods("PUT")
+	server.mux.HandleFunc("/api/nodes/{nodeid}/disqualification", server.reinstateNode).Methods("DELETE")
+	server.mux.HandleFunc("/api/nodes/{nodeid}/exit", server.markNodeExited).Methods("PUT")
+	server.mux.HandleFunc("/api/repair-queue", server.listRepairQueue).Methods("GET")
+	server.mux.HandleFunc("/api/repair-queue/{streamid}/{position}", server.getRepairQueueSegment).Methods("GET")
+	server.mux.HandleFunc("/api/repair-queue/{streamid}/{position}", server.putRepairQueueSegment).Methods("PUT")
+	server.mux.HandleFunc("/api/repair-queue/{streamid}/{position}", server.deleteRepairQueueSegment).Methods("DELETE")
+	server.mux.HandleFunc("/api/audit-events", server.listAuditEvents).Methods("GET")
 
 	return server
 }
 
 type protectedServer struct {
 	allowedAuthorization string
+	operators            []adminOperator
 
 	next http.Handler
 }
 
-func (server *protectedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if server.allowedAuthorization == "" {
-		httpJSONError(w, "Authorization not enabled.",
-			"", http.StatusForbidden)
-		return
+// adminOperator is a person allowed to use the admin API with its own token.
+type adminOperator struct {
+	name  string
+	token string
+}
+
+// parseOperator parses the name and the token of an operator from "name:token".
+func parseOperator(operator string) (name, token string, err error) {
+	i := strings.IndexByte(operator, ':')
+	if i <= 0 || i == len(operator)-1 {
+		return "", "", Error.New("operator must be name:token")
 	}
+	return operator[:i], operator[i+1:], nil
+}
 
-	equality := subtle.ConstantTimeCompare(
-		[]byte(r.Header.Get("Authorization")),
-		[]byte(server.allowedAuthorization),
-	)
-	if equality != 1 {
-		httpJSONError(w, "Forbidden",
-			"", http.StatusForbidden)
-		return
+func (server *protectedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	authorization := []byte(r.Header.Get("Authorization"))
+
+	if len(server.operators) > 0 {
+		// compare all tokens, so the time doesn't tell which operator matched
+		name := ""
+		for _, operator := range server.operators {
+			if subtle.ConstantTimeCompare(authorization, []byte(operator.token)) == 1 {
+				name = operator.name
+			}
+		}
+		if name == "" {
+			httpJSONError(w, "Forbidden",
+				"", http.StatusForbidden)
+			return
+		}
+		r = r.WithContext(withOperator(r.Context(), name))
+	} else {
+		if server.allowedAuthorization == "" {
+			httpJSONError(w, "Authorization not enabled.",
+				"", http.StatusForbidden)
+			return
+		}
+
+		equality := subtle.ConstantTimeCompare(
+			authorization,
+			[]byte(server.allowedAuthorization),
+		)
+		if equality != 1 {
+			httpJSONError(w, "Forbidden",
+				"", http.StatusForbidden)
+			return
+		}
 	}
 
 	r.Header.Set("Cache-Control", "must-revalidate")
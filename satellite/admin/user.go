@@ -4,6 +4,7 @@
 package admin
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -111,7 +112,16 @@ func (server *Server) addUser(w http.ResponseWriter, r *http.Request) {
 	// Set User Status to be activated, as we manually created it
 	newuser.Status = console.Active
 	newuser.PasswordHash = nil
-	err = server.db.Console().Users().Update(ctx, newuser)
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		if err := tx.Users().Update(ctx, newuser); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, console.AuditEvent{
+			Action: "add user",
+			UserID: newuser.ID,
+			Target: newuser.ID.String(),
+		}, nil, userAuditValue(newuser))
+	})
 	if err != nil {
 		httpJSONError(w, "failed to activate user",
 			err.Error(), http.StatusInternalServerError)
@@ -252,6 +262,8 @@ func (server *Server) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	oldValue := userAuditValue(user)
+
 	if input.FullName != "" {
 		user.FullName = input.FullName
 	}
@@ -271,7 +283,16 @@ func (server *Server) updateUser(w http.ResponseWriter, r *http.Request) {
 		user.ProjectLimit = input.ProjectLimit
 	}
 
-	err = server.db.Console().Users().Update(ctx, user)
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		if err := tx.Users().Update(ctx, user); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, console.AuditEvent{
+			Action: "update user",
+			UserID: user.ID,
+			Target: user.ID.String(),
+		}, oldValue, userAuditValue(user))
+	})
 	if err != nil {
 		httpJSONError(w, "failed to update user",
 			err.Error(), http.StatusInternalServerError)
@@ -369,7 +390,16 @@ func (server *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
 		Status:    console.Deleted,
 	}
 
-	err = server.db.Console().Users().Update(ctx, userInfo)
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		if err := tx.Users().Update(ctx, userInfo); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, console.AuditEvent{
+			Action: "delete user",
+			UserID: user.ID,
+			Target: user.ID.String(),
+		}, userAuditValue(user), userAuditValue(userInfo))
+	})
 	if err != nil {
 		httpJSONError(w, "unable to delete user",
 			err.Error(), http.StatusInternalServerError)
@@ -124,6 +124,10 @@ type API struct {
 		Cache *accounting.ProjectLimitCache
 	}
 
+	BucketLimits struct {
+		Cache *accounting.BucketLimitCache
+	}
+
 	Mail struct {
 		Service *mailservice.Service
 	}
@@ -308,15 +312,22 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.ProjectLimits.Cache = accounting.NewProjectLimitCache(peer.DB.ProjectAccounting(),
 			config.Console.Config.UsageLimits.Storage.Free,
 			config.Console.Config.UsageLimits.Bandwidth.Free,
+			config.Console.Config.UsageLimits.Segment.Free,
+			config.Console.Config.UsageLimits.Object.Free,
 			config.ProjectLimit,
 		)
 	}
 
+	{ // setup bucket limits
+		peer.BucketLimits.Cache = accounting.NewBucketLimitCache(peer.DB.Buckets(), config.ProjectLimit)
+	}
+
 	{ // setup accounting project usage
 		peer.Accounting.ProjectUsage = accounting.NewService(
 			peer.DB.ProjectAccounting(),
 			peer.LiveAccounting.Cache,
 			peer.ProjectLimits.Cache,
+			peer.BucketLimits.Cache,
 			config.LiveAccounting.BandwidthCacheTTL,
 			config.LiveAccounting.AsOfSystemInterval,
 		)
@@ -421,6 +432,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := pb.DRPCRegisterMetainfo(peer.Server.DRPC(), peer.Metainfo.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := internalpb.DRPCRegisterMetainfoExt(peer.Server.DRPC(), peer.Metainfo.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:endpoint",
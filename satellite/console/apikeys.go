@@ -5,8 +5,10 @@ package console
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	"storj.io/common/macaroon"
 	"storj.io/common/uuid"
 )
 
@@ -38,6 +40,135 @@ type APIKeyInfo struct {
 	Name      string    `json:"name"`
 	Secret    []byte    `json:"-"`
 	CreatedAt time.Time `json:"createdAt"`
+
+	// RateLimit is the number of requests per second allowed for the key,
+	// on top of the project rate limit.
+	RateLimit *int `json:"rateLimit"`
+	// RequestQuota is the number of requests per day allowed for the key.
+	RequestQuota *int64 `json:"requestQuota"`
+	// Restrictions is the description of the caveats of the key, it's empty
+	// when the key isn't restricted.
+	Restrictions string `json:"restrictions"`
+}
+
+// APIKeyLimits are the optional limits and restrictions of an API key, set
+// when the key is created. A nil limit means that the key is only limited by
+// its project.
+type APIKeyLimits struct {
+	RateLimit    *int
+	RequestQuota *int64
+
+	Restrictions APIKeyRestrictions
+}
+
+// APIKeyRestrictions are the caveats added to an API key when it's created,
+// the zero value doesn't restrict the key.
+//
+// Object key prefixes can't be restricted by the satellite, because the caveat
+// requires the encrypted prefix. They have to be restricted with uplink share.
+type APIKeyRestrictions struct {
+	DisallowReads   bool `json:"disallowReads"`
+	DisallowWrites  bool `json:"disallowWrites"`
+	DisallowLists   bool `json:"disallowLists"`
+	DisallowDeletes bool `json:"disallowDeletes"`
+
+	// Buckets are the only buckets the key can access, all of them when empty.
+	Buckets []string `json:"buckets"`
+
+	NotBefore *time.Time `json:"notBefore"`
+	NotAfter  *time.Time `json:"notAfter"`
+}
+
+// IsZero returns whether the restrictions don't restrict the key.
+func (restrictions APIKeyRestrictions) IsZero() bool {
+	return !restrictions.DisallowReads && !restrictions.DisallowWrites &&
+		!restrictions.DisallowLists && !restrictions.DisallowDeletes &&
+		len(restrictions.Buckets) == 0 &&
+		restrictions.NotBefore == nil && restrictions.NotAfter == nil
+}
+
+// Validate checks that the restrictions leave the key usable.
+func (restrictions APIKeyRestrictions) Validate() error {
+	if restrictions.DisallowReads && restrictions.DisallowWrites &&
+		restrictions.DisallowLists && restrictions.DisallowDeletes {
+		return ErrValidation.New("API Key restrictions can't disallow all the operations")
+	}
+	for _, bucket := range restrictions.Buckets {
+		if bucket == "" {
+			return ErrValidation.New("API Key restrictions can't contain an empty bucket name")
+		}
+	}
+	if restrictions.NotBefore != nil && restrictions.NotAfter != nil &&
+		!restrictions.NotBefore.Before(*restrictions.NotAfter) {
+		return ErrValidation.New("API Key restrictions must start before they expire")
+	}
+	return nil
+}
+
+// Restrict returns the key restricted with the caveat of the restrictions.
+func (restrictions APIKeyRestrictions) Restrict(key *macaroon.APIKey) (*macaroon.APIKey, error) {
+	if restrictions.IsZero() {
+		return key, nil
+	}
+
+	caveat := macaroon.Caveat{
+		DisallowReads:   restrictions.DisallowReads,
+		DisallowWrites:  restrictions.DisallowWrites,
+		DisallowLists:   restrictions.DisallowLists,
+		DisallowDeletes: restrictions.DisallowDeletes,
+		NotBefore:       restrictions.NotBefore,
+		NotAfter:        restrictions.NotAfter,
+	}
+	for _, bucket := range restrictions.Buckets {
+		caveat.AllowedPaths = append(caveat.AllowedPaths, &macaroon.Caveat_Path{
+			Bucket: []byte(bucket),
+		})
+	}
+
+	return key.Restrict(macaroon.WithNonce(caveat))
+}
+
+// String returns the human readable description of the restrictions.
+func (restrictions APIKeyRestrictions) String() string {
+	var parts []string
+
+	switch {
+	case restrictions.DisallowWrites && restrictions.DisallowDeletes &&
+		!restrictions.DisallowReads && !restrictions.DisallowLists:
+		parts = append(parts, "read only")
+	case restrictions.DisallowReads && restrictions.DisallowLists && restrictions.DisallowDeletes &&
+		!restrictions.DisallowWrites:
+		parts = append(parts, "write only")
+	default:
+		var disallowed []string
+		if restrictions.DisallowReads {
+			disallowed = append(disallowed, "reads")
+		}
+		if restrictions.DisallowWrites {
+			disallowed = append(disallowed, "writes")
+		}
+		if restrictions.DisallowLists {
+			disallowed = append(disallowed, "lists")
+		}
+		if restrictions.DisallowDeletes {
+			disallowed = append(disallowed, "deletes")
+		}
+		if len(disallowed) > 0 {
+			parts = append(parts, "no "+strings.Join(disallowed, ", "))
+		}
+	}
+
+	if len(restrictions.Buckets) > 0 {
+		parts = append(parts, "buckets: "+strings.Join(restrictions.Buckets, ", "))
+	}
+	if restrictions.NotBefore != nil {
+		parts = append(parts, "valid from "+restrictions.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if restrictions.NotAfter != nil {
+		parts = append(parts, "valid until "+restrictions.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	return strings.Join(parts, "; ")
 }
 
 // APIKeyCursor holds info for api keys cursor pagination.
@@ -6,8 +6,10 @@ package console_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/common/macaroon"
 	"storj.io/common/testcontext"
@@ -158,3 +160,90 @@ func TestApiKeysRepository(t *testing.T) {
 
 	})
 }
+
+func TestAPIKeyRestrictions(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+	key, err := macaroon.NewAPIKey(secret)
+	require.NoError(t, err)
+
+	t.Run("Unrestricted", func(t *testing.T) {
+		restrictions := console.APIKeyRestrictions{}
+		require.True(t, restrictions.IsZero())
+		require.NoError(t, restrictions.Validate())
+		require.Equal(t, "", restrictions.String())
+
+		restricted, err := restrictions.Restrict(key)
+		require.NoError(t, err)
+		require.Equal(t, key.Serialize(), restricted.Serialize())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		now := time.Now()
+
+		for _, restrictions := range []console.APIKeyRestrictions{
+			{DisallowReads: true, DisallowWrites: true, DisallowLists: true, DisallowDeletes: true},
+			{Buckets: []string{"photos", ""}},
+			{NotBefore: &now, NotAfter: &now},
+		} {
+			require.True(t, console.ErrValidation.Has(restrictions.Validate()))
+		}
+	})
+
+	t.Run("Read only bucket", func(t *testing.T) {
+		notAfter := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+		restrictions := console.APIKeyRestrictions{
+			DisallowWrites:  true,
+			DisallowDeletes: true,
+			Buckets:         []string{"photos"},
+			NotAfter:        &notAfter,
+		}
+		require.NoError(t, restrictions.Validate())
+		require.Equal(t, "read only; buckets: photos; valid until 2021-06-01T00:00:00Z", restrictions.String())
+
+		restricted, err := restrictions.Restrict(key)
+		require.NoError(t, err)
+		require.Equal(t, key.Head(), restricted.Head())
+
+		parsed, err := macaroon.ParseAPIKey(restricted.Serialize())
+		require.NoError(t, err)
+
+		now := notAfter.Add(-time.Hour)
+		require.NoError(t, parsed.Check(ctx, secret, macaroon.Action{
+			Op:            macaroon.ActionRead,
+			Bucket:        []byte("photos"),
+			EncryptedPath: []byte("path"),
+			Time:          now,
+		}, nil))
+		require.Error(t, parsed.Check(ctx, secret, macaroon.Action{
+			Op:            macaroon.ActionWrite,
+			Bucket:        []byte("photos"),
+			EncryptedPath: []byte("path"),
+			Time:          now,
+		}, nil))
+		require.Error(t, parsed.Check(ctx, secret, macaroon.Action{
+			Op:            macaroon.ActionRead,
+			Bucket:        []byte("videos"),
+			EncryptedPath: []byte("path"),
+			Time:          now,
+		}, nil))
+		require.Error(t, parsed.Check(ctx, secret, macaroon.Action{
+			Op:            macaroon.ActionRead,
+			Bucket:        []byte("photos"),
+			EncryptedPath: []byte("path"),
+			Time:          notAfter.Add(time.Hour),
+		}, nil))
+	})
+
+	t.Run("Description", func(t *testing.T) {
+		require.Equal(t, "write only", console.APIKeyRestrictions{
+			DisallowReads: true, DisallowLists: true, DisallowDeletes: true,
+		}.String())
+		require.Equal(t, "no lists, deletes; buckets: a, b", console.APIKeyRestrictions{
+			DisallowLists: true, DisallowDeletes: true, Buckets: []string{"a", "b"},
+		}.String())
+	})
+}
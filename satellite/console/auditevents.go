@@ -0,0 +1,121 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// AuditActorAdmin is the actor of the changes made through the satellite admin API,
+// it's followed by the name of the operator when the operator is known.
+const AuditActorAdmin = "admin"
+
+// AuditEvents exposes methods to manage the append-only audit log of the changes
+// made to accounts, projects and storage nodes.
+//
+// architecture: Database
+type AuditEvents interface {
+	// Insert appends the event to the audit log and sets its ID.
+	Insert(ctx context.Context, event *AuditEvent) error
+	// GetPaged returns the events matching the cursor, the newest first.
+	GetPaged(ctx context.Context, cursor AuditEventCursor) (*AuditEventPage, error)
+}
+
+// AuditEvent describes a change made by a user or by an administrator.
+type AuditEvent struct {
+	ID uuid.UUID
+
+	// ActorID is the user who made the change, it's zero for administrators.
+	ActorID uuid.UUID
+	// Actor is the email of the user or AuditActorAdmin, e.g. "admin:alice".
+	Actor  string
+	Action string
+
+	// UserID and ProjectID are the user and the project affected by the change.
+	UserID    uuid.UUID
+	ProjectID uuid.UUID
+	// Target identifies the changed object, e.g. the ID of an API key.
+	Target string
+
+	// OldValue and NewValue are the JSON encoded values before and after the change.
+	OldValue json.RawMessage
+	NewValue json.RawMessage
+
+	CreatedAt time.Time
+}
+
+// SetValues encodes the values before and after the change. Nil values aren't recorded.
+func (event *AuditEvent) SetValues(oldValue, newValue interface{}) (err error) {
+	event.OldValue, err = encodeAuditValue(oldValue)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	event.NewValue, err = encodeAuditValue(newValue)
+	return Error.Wrap(err)
+}
+
+func encodeAuditValue(value interface{}) (json.RawMessage, error) {
+	if value == nil {
+		return nil, nil
+	}
+	return json.Marshal(value)
+}
+
+// AuditEventCursor holds the filters and the page of the audit events to get.
+type AuditEventCursor struct {
+	// UserID matches the events made by the user or affecting the user.
+	UserID    uuid.UUID
+	ProjectID uuid.UUID
+	// Since and Before limit the time of the events, zero values aren't used.
+	Since  time.Time
+	Before time.Time
+
+	Limit uint
+	Page  uint
+}
+
+// AuditEventPage is a page of audit events.
+type AuditEventPage struct {
+	Events []AuditEvent
+
+	Limit  uint
+	Offset uint64
+
+	PageCount   uint
+	CurrentPage uint
+	TotalCount  uint64
+}
+
+// recordAuditEvent appends the event of a change made by the authorized user to
+// the audit log. The db can be a transaction, making the event part of the change.
+func recordAuditEvent(ctx context.Context, db DB, auth Authorization, event AuditEvent, oldValue, newValue interface{}) error {
+	event.ActorID = auth.User.ID
+	event.Actor = auth.User.Email
+
+	if err := event.SetValues(oldValue, newValue); err != nil {
+		return err
+	}
+
+	return db.AuditEvents().Insert(ctx, &event)
+}
+
+// APIKeyAuditValue returns the value of an API key recorded in the audit log, it
+// doesn't contain the secret.
+func APIKeyAuditValue(key *APIKeyInfo) interface{} {
+	return struct {
+		Name         string `json:"name"`
+		RateLimit    *int   `json:"rateLimit,omitempty"`
+		RequestQuota *int64 `json:"requestQuota,omitempty"`
+		Restrictions string `json:"restrictions,omitempty"`
+	}{
+		Name:         key.Name,
+		RateLimit:    key.RateLimit,
+		RequestQuota: key.RequestQuota,
+		Restrictions: key.Restrictions,
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAuditEventsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		events := db.Console().AuditEvents()
+
+		userID := testrand.UUID()
+		projectID := testrand.UUID()
+
+		userEvent := console.AuditEvent{
+			ActorID: userID,
+			Actor:   "user@mail.test",
+			Action:  "update account",
+			UserID:  userID,
+		}
+		require.NoError(t, userEvent.SetValues(map[string]string{"fullName": "old"}, map[string]string{"fullName": "new"}))
+		require.NoError(t, events.Insert(ctx, &userEvent))
+
+		for i := 0; i < 3; i++ {
+			projectEvent := console.AuditEvent{
+				Actor:     console.AuditActorAdmin,
+				Action:    "update project limit",
+				ProjectID: projectID,
+			}
+			require.NoError(t, projectEvent.SetValues(map[string]int{"buckets": i}, map[string]int{"buckets": i + 1}))
+			require.NoError(t, events.Insert(ctx, &projectEvent))
+		}
+
+		page, err := events.GetPaged(ctx, console.AuditEventCursor{UserID: userID, Limit: 10, Page: 1})
+		require.NoError(t, err)
+		require.Len(t, page.Events, 1)
+		require.Equal(t, userEvent.Action, page.Events[0].Action)
+		require.Equal(t, userID, page.Events[0].ActorID)
+		require.True(t, page.Events[0].ProjectID.IsZero())
+		require.JSONEq(t, `{"fullName":"old"}`, string(page.Events[0].OldValue))
+		require.JSONEq(t, `{"fullName":"new"}`, string(page.Events[0].NewValue))
+
+		page, err = events.GetPaged(ctx, console.AuditEventCursor{ProjectID: projectID, Limit: 2, Page: 1})
+		require.NoError(t, err)
+		require.Len(t, page.Events, 2)
+		require.EqualValues(t, 3, page.TotalCount)
+		require.EqualValues(t, 2, page.PageCount)
+		require.True(t, page.Events[0].ActorID.IsZero())
+		require.Equal(t, console.AuditActorAdmin, page.Events[0].Actor)
+
+		page, err = events.GetPaged(ctx, console.AuditEventCursor{ProjectID: projectID, Limit: 2, Page: 2})
+		require.NoError(t, err)
+		require.Len(t, page.Events, 1)
+
+		_, err = events.GetPaged(ctx, console.AuditEventCursor{ProjectID: projectID, Limit: 2, Page: 3})
+		require.Error(t, err)
+
+		page, err = events.GetPaged(ctx, console.AuditEventCursor{Since: time.Now().Add(time.Hour), Limit: 10, Page: 1})
+		require.NoError(t, err)
+		require.Empty(t, page.Events)
+
+		page, err = events.GetPaged(ctx, console.AuditEventCursor{Before: time.Now().Add(time.Hour), Limit: 10, Page: 1})
+		require.NoError(t, err)
+		require.EqualValues(t, 4, page.TotalCount)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
 )
 
@@ -66,6 +67,50 @@ func (b *Buckets) AllBucketNames(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// UpdateBucketLimits updates the storage and bandwidth limits of a bucket.
+func (b *Buckets) UpdateBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromString(r.URL.Query().Get("projectID"))
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	bucketName := r.URL.Query().Get("bucket")
+	if bucketName == "" {
+		b.serveJSONError(w, http.StatusBadRequest, ErrBucketsAPI.New("bucket name is required"))
+		return
+	}
+
+	var limits struct {
+		Usage     *int64 `json:"usage"`
+		Bandwidth *int64 `json:"bandwidth"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&limits); err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.UpdateBucketLimits(ctx, projectID, bucketName, accounting.BucketLimits{
+		Usage:     limits.Usage,
+		Bandwidth: limits.Bandwidth,
+	})
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			b.serveJSONError(w, http.StatusUnauthorized, err)
+		case console.ErrValidation.Has(err):
+			b.serveJSONError(w, http.StatusBadRequest, err)
+		default:
+			b.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (b *Buckets) serveJSONError(w http.ResponseWriter, status int, err error) {
 	serveJSONError(b.log, w, status, err)
@@ -82,7 +82,8 @@ func (p *Payments) AccountBalance(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ProjectsCharges returns how much money current user will be charged for each project which he owns.
+// ProjectsCharges returns how much money will be charged for each project which the current user owns
+// or is allowed to view the billing of.
 func (p *Payments) ProjectsCharges(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
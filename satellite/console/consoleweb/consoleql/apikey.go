@@ -4,6 +4,8 @@
 package consoleql
 
 import (
+	"time"
+
 	"github.com/graphql-go/graphql"
 
 	"storj.io/storj/satellite/console"
@@ -17,6 +19,28 @@ const (
 	CreateAPIKeyType = "graphqlCreateAPIKey"
 	// FieldKey is field name for the actual key in createAPIKey.
 	FieldKey = "key"
+	// FieldRateLimit is field name for the api key requests per second limit.
+	FieldRateLimit = "rateLimit"
+	// FieldRequestQuota is field name for the api key requests per day limit.
+	FieldRequestQuota = "requestQuota"
+	// FieldRestrictions is field name for the api key restrictions.
+	FieldRestrictions = "restrictions"
+	// APIKeyRestrictionsInputType is graphql type name for the api key restrictions input.
+	APIKeyRestrictionsInputType = "apiKeyRestrictions"
+	// FieldDisallowReads is field name for disallowing reads with the api key.
+	FieldDisallowReads = "disallowReads"
+	// FieldDisallowWrites is field name for disallowing writes with the api key.
+	FieldDisallowWrites = "disallowWrites"
+	// FieldDisallowLists is field name for disallowing lists with the api key.
+	FieldDisallowLists = "disallowLists"
+	// FieldDisallowDeletes is field name for disallowing deletes with the api key.
+	FieldDisallowDeletes = "disallowDeletes"
+	// FieldBuckets is field name for the buckets the api key is restricted to.
+	FieldBuckets = "buckets"
+	// FieldNotBefore is field name for the time the api key becomes valid.
+	FieldNotBefore = "notBefore"
+	// FieldNotAfter is field name for the time the api key expires.
+	FieldNotAfter = "notAfter"
 )
 
 // graphqlAPIKeyInfo creates satellite.APIKeyInfo graphql object.
@@ -39,10 +63,73 @@ func graphqlAPIKeyInfo() *graphql.Object {
 			FieldPartnerID: &graphql.Field{
 				Type: graphql.String,
 			},
+			FieldRateLimit: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldRequestQuota: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldRestrictions: &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+}
+
+// graphqlAPIKeyRestrictions creates the input object of the api key restrictions.
+func graphqlAPIKeyRestrictions() *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: APIKeyRestrictionsInputType,
+		Fields: graphql.InputObjectConfigFieldMap{
+			FieldDisallowReads: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+			FieldDisallowWrites: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+			FieldDisallowLists: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+			FieldDisallowDeletes: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+			FieldBuckets: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewList(graphql.String),
+			},
+			FieldNotBefore: &graphql.InputObjectFieldConfig{
+				Type: graphql.DateTime,
+			},
+			FieldNotAfter: &graphql.InputObjectFieldConfig{
+				Type: graphql.DateTime,
+			},
 		},
 	})
 }
 
+// fromMapAPIKeyRestrictions creates console.APIKeyRestrictions from input args.
+func fromMapAPIKeyRestrictions(args map[string]interface{}) (restrictions console.APIKeyRestrictions) {
+	restrictions.DisallowReads, _ = args[FieldDisallowReads].(bool)
+	restrictions.DisallowWrites, _ = args[FieldDisallowWrites].(bool)
+	restrictions.DisallowLists, _ = args[FieldDisallowLists].(bool)
+	restrictions.DisallowDeletes, _ = args[FieldDisallowDeletes].(bool)
+
+	buckets, _ := args[FieldBuckets].([]interface{})
+	for _, bucket := range buckets {
+		if name, ok := bucket.(string); ok {
+			restrictions.Buckets = append(restrictions.Buckets, name)
+		}
+	}
+
+	if notBefore, ok := args[FieldNotBefore].(time.Time); ok {
+		restrictions.NotBefore = &notBefore
+	}
+	if notAfter, ok := args[FieldNotAfter].(time.Time); ok {
+		restrictions.NotAfter = &notAfter
+	}
+
+	return restrictions
+}
+
 // graphqlCreateAPIKey creates createAPIKey graphql object.
 func graphqlCreateAPIKey(types *TypeCreator) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
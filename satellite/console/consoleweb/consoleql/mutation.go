@@ -28,6 +28,8 @@ const (
 	AddProjectMembersMutation = "addProjectMembers"
 	// DeleteProjectMembersMutation is a mutation name for deleting project members.
 	DeleteProjectMembersMutation = "deleteProjectMembers"
+	// UpdateProjectMemberRoleMutation is a mutation name for changing the role of a project member.
+	UpdateProjectMemberRoleMutation = "updateProjectMemberRole"
 
 	// CreateAPIKeyMutation is a mutation name for api key creation.
 	CreateAPIKeyMutation = "createAPIKey"
@@ -121,7 +123,7 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return project, nil
 				},
 			},
-			// add user as member of given project
+			// add user as member of given project, members are admins unless the role is given
 			AddProjectMembersMutation: &graphql.Field{
 				Type: types.project,
 				Args: graphql.FieldConfigArgument{
@@ -131,16 +133,26 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					FieldEmail: &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.NewList(graphql.String)),
 					},
+					FieldRole: &graphql.ArgumentConfig{
+						Type:         graphql.String,
+						DefaultValue: console.RoleAdmin.String(),
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					pID, _ := p.Args[FieldProjectID].(string)
 					emails, _ := p.Args[FieldEmail].([]interface{})
+					roleName, _ := p.Args[FieldRole].(string)
 
 					projectID, err := uuid.FromString(pID)
 					if err != nil {
 						return nil, err
 					}
 
+					role, err := console.ParseProjectMemberRole(roleName)
+					if err != nil {
+						return nil, err
+					}
+
 					var userEmails []string
 					for _, email := range emails {
 						userEmails = append(userEmails, email.(string))
@@ -151,7 +163,7 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 						return nil, err
 					}
 
-					users, err := service.AddProjectMembers(p.Context, projectID, userEmails)
+					users, err := service.AddProjectMembers(p.Context, projectID, userEmails, role)
 					if err != nil {
 						return nil, err
 					}
@@ -226,6 +238,43 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return project, nil
 				},
 			},
+			// change the role of a member of given project
+			UpdateProjectMemberRoleMutation: &graphql.Field{
+				Type: types.project,
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldEmail: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldRole: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pID, _ := p.Args[FieldProjectID].(string)
+					email, _ := p.Args[FieldEmail].(string)
+					roleName, _ := p.Args[FieldRole].(string)
+
+					projectID, err := uuid.FromString(pID)
+					if err != nil {
+						return nil, err
+					}
+
+					role, err := console.ParseProjectMemberRole(roleName)
+					if err != nil {
+						return nil, err
+					}
+
+					err = service.UpdateProjectMemberRole(p.Context, projectID, email, role)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.GetProject(p.Context, projectID)
+				},
+			},
 			// creates new api key
 			CreateAPIKeyMutation: &graphql.Field{
 				Type: types.createAPIKey,
@@ -236,6 +285,15 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					FieldName: &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.String),
 					},
+					FieldRateLimit: &graphql.ArgumentConfig{
+						Type: graphql.Int,
+					},
+					FieldRequestQuota: &graphql.ArgumentConfig{
+						Type: graphql.Float,
+					},
+					FieldRestrictions: &graphql.ArgumentConfig{
+						Type: types.apiKeyRestrictions,
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					projectIDField, _ := p.Args[FieldProjectID].(string)
@@ -246,7 +304,19 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 						return nil, err
 					}
 
-					info, key, err := service.CreateAPIKey(p.Context, projectID, name)
+					var limits console.APIKeyLimits
+					if rateLimit, ok := p.Args[FieldRateLimit].(int); ok {
+						limits.RateLimit = &rateLimit
+					}
+					if requestQuota, ok := p.Args[FieldRequestQuota].(float64); ok {
+						quota := int64(requestQuota)
+						limits.RequestQuota = &quota
+					}
+					if restrictions, ok := p.Args[FieldRestrictions].(map[string]interface{}); ok {
+						limits.Restrictions = fromMapAPIKeyRestrictions(restrictions)
+					}
+
+					info, key, err := service.CreateAPIKey(p.Context, projectID, name, limits)
 					if err != nil {
 						return nil, err
 					}
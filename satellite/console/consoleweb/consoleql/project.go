@@ -60,6 +60,10 @@ const (
 	FieldEgress = "egress"
 	// FieldObjectCount is a field name for objects count.
 	FieldObjectCount = "objectCount"
+	// FieldStorageLimit is a field name for bucket storage limit.
+	FieldStorageLimit = "storageLimit"
+	// FieldEgressLimit is a field name for bucket egress limit.
+	FieldEgressLimit = "egressLimit"
 	// FieldPageCount is a field name for total page count.
 	FieldPageCount = "pageCount"
 	// FieldCurrentPage is a field name for current page number.
@@ -146,6 +150,7 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 						users = append(users, projectMember{
 							User:     user,
 							JoinedAt: member.CreatedAt,
+							Role:     member.Role,
 						})
 					}
 
@@ -316,6 +321,12 @@ func graphqlBucketUsage() *graphql.Object {
 			FieldObjectCount: &graphql.Field{
 				Type: graphql.Float,
 			},
+			FieldStorageLimit: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldEgressLimit: &graphql.Field{
+				Type: graphql.Float,
+			},
 			SinceArg: &graphql.Field{
 				Type: graphql.DateTime,
 			},
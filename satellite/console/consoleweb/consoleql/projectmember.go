@@ -16,6 +16,8 @@ const (
 	ProjectMemberType = "projectMember"
 	// FieldJoinedAt is a field name for joined at timestamp.
 	FieldJoinedAt = "joinedAt"
+	// FieldRole is a field name for the role of a project member.
+	FieldRole = "role"
 )
 
 // graphqlProjectMember creates projectMember type.
@@ -34,6 +36,13 @@ func graphqlProjectMember(service *console.Service, types *TypeCreator) *graphql
 			FieldJoinedAt: &graphql.Field{
 				Type: graphql.DateTime,
 			},
+			FieldRole: &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					member, _ := p.Source.(projectMember)
+					return member.Role.String(), nil
+				},
+			},
 		},
 	})
 }
@@ -96,10 +105,11 @@ func graphqlProjectMembersPage(types *TypeCreator) *graphql.Object {
 	})
 }
 
-// projectMember encapsulates User and joinedAt.
+// projectMember encapsulates User, joinedAt and role.
 type projectMember struct {
 	User     *console.User
 	JoinedAt time.Time
+	Role     console.ProjectMemberRole
 }
 
 type projectMembersPage struct {
@@ -35,6 +35,7 @@ type TypeCreator struct {
 	bucketUsageCursor    *graphql.InputObject
 	projectMembersCursor *graphql.InputObject
 	apiKeysCursor        *graphql.InputObject
+	apiKeyRestrictions   *graphql.InputObject
 }
 
 // Create create types and check for error.
@@ -65,6 +66,11 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.apiKeyRestrictions = graphqlAPIKeyRestrictions()
+	if err := c.apiKeyRestrictions.Error(); err != nil {
+		return err
+	}
+
 	// entities
 	c.user = graphqlUser()
 	if err := c.user.Error(); err != nil {
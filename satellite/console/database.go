@@ -23,6 +23,8 @@ type DB interface {
 	RegistrationTokens() RegistrationTokens
 	// ResetPasswordTokens is a getter for ResetPasswordTokens repository.
 	ResetPasswordTokens() ResetPasswordTokens
+	// AuditEvents is a getter for AuditEvents repository.
+	AuditEvents() AuditEvents
 
 	// WithTx is a method for executing transactions with retrying as necessary.
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx DBTx) error) error
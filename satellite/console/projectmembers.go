@@ -18,8 +18,10 @@ type ProjectMembers interface {
 	GetByMemberID(ctx context.Context, memberID uuid.UUID) ([]ProjectMember, error)
 	// GetPagedByProjectID is a method for querying project members from the database by projectID and cursor
 	GetPagedByProjectID(ctx context.Context, projectID uuid.UUID, cursor ProjectMembersCursor) (*ProjectMembersPage, error)
-	// Insert is a method for inserting project member into the database.
-	Insert(ctx context.Context, memberID, projectID uuid.UUID) (*ProjectMember, error)
+	// Insert is a method for inserting project member with the role into the database.
+	Insert(ctx context.Context, memberID, projectID uuid.UUID, role ProjectMemberRole) (*ProjectMember, error)
+	// UpdateRole is a method for changing the role of a project member.
+	UpdateRole(ctx context.Context, memberID, projectID uuid.UUID, role ProjectMemberRole) error
 	// Delete is a method for deleting project member by memberID and projectID from the database.
 	Delete(ctx context.Context, memberID, projectID uuid.UUID) error
 }
@@ -31,9 +33,90 @@ type ProjectMember struct {
 	// FK on Projects table.
 	ProjectID uuid.UUID
 
+	Role ProjectMemberRole
+
 	CreatedAt time.Time
 }
 
+// ProjectMemberRole is the role of a member in a project, it defines what the member is allowed to do.
+type ProjectMemberRole int
+
+const (
+	// RoleOwner is the role of the project owner, it's allowed to do everything.
+	RoleOwner ProjectMemberRole = 1
+	// RoleAdmin is allowed to do everything except deleting the project.
+	RoleAdmin ProjectMemberRole = 2
+	// RoleDeveloper is allowed to manage API keys and buckets, but not the project and its members.
+	RoleDeveloper ProjectMemberRole = 3
+	// RoleBilling is only allowed to view the project, its members, its usage and its charges.
+	RoleBilling ProjectMemberRole = 4
+)
+
+// ParseProjectMemberRole parses the name of a project member role.
+func ParseProjectMemberRole(name string) (ProjectMemberRole, error) {
+	for _, role := range []ProjectMemberRole{RoleOwner, RoleAdmin, RoleDeveloper, RoleBilling} {
+		if role.String() == name {
+			return role, nil
+		}
+	}
+	return 0, ErrValidation.New("invalid project member role %q", name)
+}
+
+// String returns the name of the role.
+func (role ProjectMemberRole) String() string {
+	switch role {
+	case RoleOwner:
+		return "owner"
+	case RoleAdmin:
+		return "admin"
+	case RoleDeveloper:
+		return "developer"
+	case RoleBilling:
+		return "billing"
+	default:
+		return "unknown"
+	}
+}
+
+// ProjectPermission is an action on a project which is allowed only to some of the roles.
+type ProjectPermission int
+
+const (
+	// PermissionViewProject allows viewing the project, its members, usage and limits.
+	PermissionViewProject ProjectPermission = iota
+	// PermissionManageAPIKeys allows viewing, creating and deleting API keys.
+	PermissionManageAPIKeys
+	// PermissionManageBuckets allows viewing and changing the buckets.
+	PermissionManageBuckets
+	// PermissionUpdateProject allows changing the project name, description and bucket limits.
+	PermissionUpdateProject
+	// PermissionManageMembers allows adding and deleting members and changing their roles.
+	PermissionManageMembers
+	// PermissionDeleteProject allows deleting the project.
+	PermissionDeleteProject
+	// PermissionViewBilling allows viewing the charges of the project.
+	PermissionViewBilling
+)
+
+// Allows returns whether the role has the permission.
+func (role ProjectMemberRole) Allows(permission ProjectPermission) bool {
+	switch role {
+	case RoleOwner:
+		return true
+	case RoleAdmin:
+		return permission != PermissionDeleteProject
+	case RoleDeveloper:
+		return permission == PermissionViewProject ||
+			permission == PermissionManageAPIKeys ||
+			permission == PermissionManageBuckets
+	case RoleBilling:
+		return permission == PermissionViewProject ||
+			permission == PermissionViewBilling
+	default:
+		return false
+	}
+}
+
 // ProjectMembersCursor holds info for project members cursor pagination.
 type ProjectMembersCursor struct {
 	Search         string
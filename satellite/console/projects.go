@@ -50,6 +50,8 @@ type Projects interface {
 type UsageLimitsConfig struct {
 	Storage   StorageLimitConfig
 	Bandwidth BandwidthLimitConfig
+	Segment   SegmentLimitConfig
+	Object    ObjectLimitConfig
 }
 
 // StorageLimitConfig is a configuration struct for default storage per-project usage limits.
@@ -64,6 +66,18 @@ type BandwidthLimitConfig struct {
 	Paid memory.Size `help:"the default paid-tier bandwidth usage limit" default:"100.00TB" testDefault:"25.00 GB"`
 }
 
+// SegmentLimitConfig is a configuration struct for default segments per-project usage limits.
+type SegmentLimitConfig struct {
+	Free int64 `help:"the default free-tier segment usage limit" default:"150000"`
+	Paid int64 `help:"the default paid-tier segment usage limit" default:"1000000"`
+}
+
+// ObjectLimitConfig is a configuration struct for default objects per-project usage limits.
+type ObjectLimitConfig struct {
+	Free int64 `help:"the default free-tier object usage limit" default:"100000"`
+	Paid int64 `help:"the default paid-tier object usage limit" default:"1000000"`
+}
+
 // Project is a database object that describes Project entity.
 type Project struct {
 	ID uuid.UUID `json:"id"`
@@ -78,6 +92,8 @@ type Project struct {
 	MemberCount    int          `json:"memberCount"`
 	StorageLimit   *memory.Size `json:"storageLimit"`
 	BandwidthLimit *memory.Size `json:"bandwidthLimit"`
+	SegmentLimit   *int64       `json:"segmentLimit"`
+	ObjectLimit    *int64       `json:"objectLimit"`
 }
 
 // ProjectInfo holds data needed to create/update Project.
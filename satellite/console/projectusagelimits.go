@@ -9,4 +9,8 @@ type ProjectUsageLimits struct {
 	BandwidthLimit int64 `json:"bandwidthLimit"`
 	StorageUsed    int64 `json:"storageUsed"`
 	BandwidthUsed  int64 `json:"bandwidthUsed"`
+	SegmentLimit   int64 `json:"segmentLimit"`
+	SegmentUsed    int64 `json:"segmentUsed"`
+	ObjectLimit    int64 `json:"objectLimit"`
+	ObjectUsed     int64 `json:"objectUsed"`
 }
@@ -51,8 +51,12 @@ const (
 	credentialsErrMsg                    = "Your email or password was incorrect, please try again"
 	passwordIncorrectErrMsg              = "Your password needs at least %d characters long"
 	projectOwnerDeletionForbiddenErrMsg  = "%s is a project owner and can not be deleted"
+	projectOwnerRoleErrMsg               = "The role of the project owner can not be changed or given to another member"
 	apiKeyWithNameExistsErrMsg           = "An API Key with this name already exists in this project, please use a different name"
 	apiKeyWithNameDoesntExistErrMsg      = "An API Key with this name doesn't exist in this project."
+	apiKeyLimitsErrMsg                   = "API Key limits must be positive numbers"
+	bucketLimitsErrMsg                   = "Bucket limits must be positive numbers"
+	bucketLimitsAboveProjectErrMsg       = "Bucket %s limit can not be higher than the project limit of %s"
 	teamMemberDoesNotExistErrMsg         = `There is no account on this Satellite for the user(s) you have entered.
 									     Please add team members with active accounts`
 
@@ -139,6 +143,10 @@ type RecaptchaConfig struct {
 }
 
 // PaymentsService separates all payment related functionality.
+//
+// The methods manage the payment account of the authenticated user, only the
+// project charges include projects of other users, when the role of the user
+// in the project allows viewing its billing.
 type PaymentsService struct {
 	service *Service
 }
@@ -281,6 +289,14 @@ func (paymentService PaymentsService) AddCreditCard(ctx context.Context, creditC
 				project.BandwidthLimit = new(memory.Size)
 				*project.BandwidthLimit = paymentService.service.config.UsageLimits.Bandwidth.Paid
 			}
+			if project.SegmentLimit == nil || *project.SegmentLimit < paymentService.service.config.UsageLimits.Segment.Paid {
+				project.SegmentLimit = new(int64)
+				*project.SegmentLimit = paymentService.service.config.UsageLimits.Segment.Paid
+			}
+			if project.ObjectLimit == nil || *project.ObjectLimit < paymentService.service.config.UsageLimits.Object.Paid {
+				project.ObjectLimit = new(int64)
+				*project.ObjectLimit = paymentService.service.config.UsageLimits.Object.Paid
+			}
 			err = paymentService.service.store.Projects().Update(ctx, &project)
 			if err != nil {
 				return Error.Wrap(err)
@@ -303,7 +319,8 @@ func (paymentService PaymentsService) MakeCreditCardDefault(ctx context.Context,
 	return paymentService.service.accounts.CreditCards().MakeDefault(ctx, auth.User.ID, cardID)
 }
 
-// ProjectsCharges returns how much money current user will be charged for each project which he owns.
+// ProjectsCharges returns how much money will be charged for each project which the current user owns
+// or is allowed to view the billing of.
 func (paymentService PaymentsService) ProjectsCharges(ctx context.Context, since, before time.Time) (_ []payments.ProjectCharge, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -312,7 +329,29 @@ func (paymentService PaymentsService) ProjectsCharges(ctx context.Context, since
 		return nil, Error.Wrap(err)
 	}
 
-	return paymentService.service.accounts.ProjectCharges(ctx, auth.User.ID, since, before)
+	charges, err := paymentService.service.accounts.ProjectCharges(ctx, auth.User.ID, since, before)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	memberships, err := paymentService.service.store.ProjectMembers().GetByMemberID(ctx, auth.User.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, membership := range memberships {
+		// the charges of the owned projects are already included
+		if membership.Role == RoleOwner || !membership.Role.Allows(PermissionViewBilling) {
+			continue
+		}
+
+		charge, err := paymentService.service.accounts.ProjectCharge(ctx, membership.ProjectID, since, before)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		charges = append(charges, charge)
+	}
+
+	return charges, nil
 }
 
 // ListCreditCards returns a list of credit cards for a given payment account.
@@ -921,13 +960,27 @@ func (s *Service) UpdateAccount(ctx context.Context, fullName string, shortName
 		return ErrValidation.Wrap(err)
 	}
 
-	err = s.store.Users().Update(ctx, &User{
-		ID:           auth.User.ID,
-		FullName:     fullName,
-		ShortName:    shortName,
-		Email:        auth.User.Email,
-		PasswordHash: nil,
-		Status:       auth.User.Status,
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		err := tx.Users().Update(ctx, &User{
+			ID:           auth.User.ID,
+			FullName:     fullName,
+			ShortName:    shortName,
+			Email:        auth.User.Email,
+			PasswordHash: nil,
+			Status:       auth.User.Status,
+		})
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, tx, auth, AuditEvent{
+			Action: "update account",
+			UserID: auth.User.ID,
+			Target: auth.User.ID.String(),
+		},
+			map[string]string{"fullName": auth.User.FullName, "shortName": auth.User.ShortName},
+			map[string]string{"fullName": fullName, "shortName": shortName},
+		)
 	})
 	if err != nil {
 		return Error.Wrap(err)
@@ -953,8 +1006,24 @@ func (s *Service) ChangeEmail(ctx context.Context, newEmail string) (err error)
 		return ErrEmailUsed.New(emailUsedErrMsg)
 	}
 
-	auth.User.Email = newEmail
-	err = s.store.Users().Update(ctx, &auth.User)
+	oldEmail := auth.User.Email
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		user := auth.User
+		user.Email = newEmail
+		err := tx.Users().Update(ctx, &user)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, tx, auth, AuditEvent{
+			Action: "change email",
+			UserID: auth.User.ID,
+			Target: auth.User.ID.String(),
+		},
+			map[string]string{"email": oldEmail},
+			map[string]string{"email": newEmail},
+		)
+	})
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -984,8 +1053,21 @@ func (s *Service) ChangePassword(ctx context.Context, pass, newPass string) (err
 		return Error.Wrap(err)
 	}
 
-	auth.User.PasswordHash = hash
-	err = s.store.Users().Update(ctx, &auth.User)
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		user := auth.User
+		user.PasswordHash = hash
+		err := tx.Users().Update(ctx, &user)
+		if err != nil {
+			return err
+		}
+
+		// the password hashes aren't recorded
+		return recordAuditEvent(ctx, tx, auth, AuditEvent{
+			Action: "change password",
+			UserID: auth.User.ID,
+			Target: auth.User.ID.String(),
+		}, nil, nil)
+	})
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -1011,7 +1093,18 @@ func (s *Service) DeleteAccount(ctx context.Context, password string) (err error
 		return Error.Wrap(err)
 	}
 
-	err = s.store.Users().Delete(ctx, auth.User.ID)
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		err := tx.Users().Delete(ctx, auth.User.ID)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, tx, auth, AuditEvent{
+			Action: "delete account",
+			UserID: auth.User.ID,
+			Target: auth.User.ID.String(),
+		}, map[string]string{"email": auth.User.Email}, nil)
+	})
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -1027,7 +1120,7 @@ func (s *Service) GetProject(ctx context.Context, projectID uuid.UUID) (p *Proje
 		return nil, Error.Wrap(err)
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if _, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject); err != nil {
 		return nil, Error.Wrap(err)
 	}
 
@@ -1088,9 +1181,13 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
 		storageLimit := s.config.UsageLimits.Storage.Free
 		bandwidthLimit := s.config.UsageLimits.Bandwidth.Free
+		segmentLimit := s.config.UsageLimits.Segment.Free
+		objectLimit := s.config.UsageLimits.Object.Free
 		if auth.User.PaidTier {
 			storageLimit = s.config.UsageLimits.Storage.Paid
 			bandwidthLimit = s.config.UsageLimits.Bandwidth.Paid
+			segmentLimit = s.config.UsageLimits.Segment.Paid
+			objectLimit = s.config.UsageLimits.Object.Paid
 		}
 		p, err = tx.Projects().Insert(ctx,
 			&Project{
@@ -1100,20 +1197,27 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 				PartnerID:      auth.User.PartnerID,
 				StorageLimit:   &storageLimit,
 				BandwidthLimit: &bandwidthLimit,
+				SegmentLimit:   &segmentLimit,
+				ObjectLimit:    &objectLimit,
 			},
 		)
 		if err != nil {
 			return Error.Wrap(err)
 		}
 
-		_, err = tx.ProjectMembers().Insert(ctx, auth.User.ID, p.ID)
+		_, err = tx.ProjectMembers().Insert(ctx, auth.User.ID, p.ID, RoleOwner)
 		if err != nil {
 			return Error.Wrap(err)
 		}
 
 		projectID = p.ID
 
-		return nil
+		return recordAuditEvent(ctx, tx, auth, AuditEvent{
+			Action:    "create project",
+			UserID:    auth.User.ID,
+			ProjectID: p.ID,
+			Target:    p.ID.String(),
+		}, nil, map[string]string{"name": p.Name, "description": p.Description})
 	})
 
 	if err != nil {
@@ -1139,7 +1243,7 @@ func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err e
 		return Error.Wrap(err)
 	}
 
-	_, err = s.isProjectOwner(ctx, auth.User.ID, projectID)
+	_, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionDeleteProject)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -1149,7 +1253,18 @@ func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err e
 		return Error.Wrap(err)
 	}
 
-	err = s.store.Projects().Delete(ctx, projectID)
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		err := tx.Projects().Delete(ctx, projectID)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, tx, auth, AuditEvent{
+			Action:    "delete project",
+			ProjectID: projectID,
+			Target:    projectID.String(),
+		}, nil, nil)
+	})
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -1171,15 +1286,27 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, name s
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	isMember, err := s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionUpdateProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 	project := isMember.project
+	oldValue := map[string]string{"name": project.Name, "description": project.Description}
 	project.Name = name
 	project.Description = description
 
-	err = s.store.Projects().Update(ctx, project)
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		err := tx.Projects().Update(ctx, project)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, tx, auth, AuditEvent{
+			Action:    "update project",
+			ProjectID: projectID,
+			Target:    projectID.String(),
+		}, oldValue, map[string]string{"name": name, "description": description})
+	})
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1187,15 +1314,19 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, name s
 	return project, nil
 }
 
-// AddProjectMembers adds users by email to given project.
-func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (users []*User, err error) {
+// AddProjectMembers adds users by email to given project with the role.
+func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string, role ProjectMemberRole) (users []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
-	auth, err := s.getAuthAndAuditLog(ctx, "add project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails))
+	auth, err := s.getAuthAndAuditLog(ctx, "add project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails), zap.Stringer("role", role))
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if err = validateMemberRole(role); err != nil {
+		return nil, err
+	}
+
+	if _, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionManageMembers); err != nil {
 		return nil, Error.Wrap(err)
 	}
 
@@ -1219,7 +1350,17 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 	// add project members in transaction scope
 	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
 		for _, user := range users {
-			if _, err := tx.ProjectMembers().Insert(ctx, user.ID, projectID); err != nil {
+			if _, err := tx.ProjectMembers().Insert(ctx, user.ID, projectID, role); err != nil {
+				return err
+			}
+
+			err := recordAuditEvent(ctx, tx, auth, AuditEvent{
+				Action:    "add project member",
+				UserID:    user.ID,
+				ProjectID: projectID,
+				Target:    user.ID.String(),
+			}, nil, map[string]string{"email": user.Email, "role": role.String()})
+			if err != nil {
 				return err
 			}
 		}
@@ -1240,11 +1381,11 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 		return Error.Wrap(err)
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if _, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionManageMembers); err != nil {
 		return Error.Wrap(err)
 	}
 
-	var userIDs []uuid.UUID
+	var members []*User
 	var userErr errs.Group
 
 	// collect user querying errors
@@ -1264,7 +1405,7 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 			return Error.Wrap(err)
 		}
 
-		userIDs = append(userIDs, user.ID)
+		members = append(members, user)
 	}
 
 	if err = userErr.Err(); err != nil {
@@ -1273,8 +1414,18 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 
 	// delete project members in transaction scope
 	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
-		for _, uID := range userIDs {
-			err = tx.ProjectMembers().Delete(ctx, uID, projectID)
+		for _, member := range members {
+			err = tx.ProjectMembers().Delete(ctx, member.ID, projectID)
+			if err != nil {
+				return err
+			}
+
+			err = recordAuditEvent(ctx, tx, auth, AuditEvent{
+				Action:    "delete project member",
+				UserID:    member.ID,
+				ProjectID: projectID,
+				Target:    member.ID.String(),
+			}, map[string]string{"email": member.Email}, nil)
 			if err != nil {
 				return err
 			}
@@ -1284,6 +1435,55 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 	return Error.Wrap(err)
 }
 
+// UpdateProjectMemberRole changes the role of the project member with the email.
+func (s *Service) UpdateProjectMemberRole(ctx context.Context, projectID uuid.UUID, email string, role ProjectMemberRole) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := s.getAuthAndAuditLog(ctx, "update project member role", zap.String("projectID", projectID.String()), zap.String("email", email), zap.Stringer("role", role))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err = validateMemberRole(role); err != nil {
+		return err
+	}
+
+	if _, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionManageMembers); err != nil {
+		return Error.Wrap(err)
+	}
+
+	user, err := s.store.Users().GetByEmail(ctx, email)
+	if err != nil {
+		return ErrValidation.New(teamMemberDoesNotExistErrMsg)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		if ErrNoMembership.Has(err) {
+			return ErrValidation.New(teamMemberDoesNotExistErrMsg)
+		}
+		return Error.Wrap(err)
+	}
+	oldRole := isMember.membership.Role
+	if oldRole == RoleOwner {
+		return ErrValidation.New(projectOwnerRoleErrMsg)
+	}
+
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		err := tx.ProjectMembers().UpdateRole(ctx, user.ID, projectID, role)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, tx, auth, AuditEvent{
+			Action:    "update project member role",
+			UserID:    user.ID,
+			ProjectID: projectID,
+			Target:    user.ID.String(),
+		}, map[string]string{"role": oldRole.String()}, map[string]string{"role": role.String()})
+	})
+	return Error.Wrap(err)
+}
+
 // GetProjectMembers returns ProjectMembers for given Project.
 func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, cursor ProjectMembersCursor) (pmp *ProjectMembersPage, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1293,7 +1493,7 @@ func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, cu
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1310,8 +1510,8 @@ func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, cu
 	return
 }
 
-// CreateAPIKey creates new api key.
-func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name string) (_ *APIKeyInfo, _ *macaroon.APIKey, err error) {
+// CreateAPIKey creates new api key with the optional limits.
+func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name string, limits APIKeyLimits) (_ *APIKeyInfo, _ *macaroon.APIKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	auth, err := s.getAuthAndAuditLog(ctx, "create api key", zap.String("projectID", projectID.String()))
@@ -1319,7 +1519,7 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionManageAPIKeys)
 	if err != nil {
 		return nil, nil, Error.Wrap(err)
 	}
@@ -1329,6 +1529,14 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, ErrValidation.New(apiKeyWithNameExistsErrMsg)
 	}
 
+	if (limits.RateLimit != nil && *limits.RateLimit <= 0) || (limits.RequestQuota != nil && *limits.RequestQuota <= 0) {
+		return nil, nil, ErrValidation.New(apiKeyLimitsErrMsg)
+	}
+
+	if err = limits.Restrictions.Validate(); err != nil {
+		return nil, nil, err
+	}
+
 	secret, err := macaroon.NewSecret()
 	if err != nil {
 		return nil, nil, Error.Wrap(err)
@@ -1344,16 +1552,38 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		ProjectID: projectID,
 		Secret:    secret,
 		PartnerID: auth.User.PartnerID,
+
+		RateLimit:    limits.RateLimit,
+		RequestQuota: limits.RequestQuota,
+		Restrictions: limits.Restrictions.String(),
+	}
+
+	// the head of the restricted key is the same, the key is stored unrestricted
+	restricted, err := limits.Restrictions.Restrict(key)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
 	}
 
-	info, err := s.store.APIKeys().Create(ctx, key.Head(), apikey)
+	var info *APIKeyInfo
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) (err error) {
+		info, err = tx.APIKeys().Create(ctx, key.Head(), apikey)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, tx, auth, AuditEvent{
+			Action:    "create api key",
+			ProjectID: projectID,
+			Target:    info.ID.String(),
+		}, nil, APIKeyAuditValue(info))
+	})
 	if err != nil {
 		return nil, nil, Error.Wrap(err)
 	}
 
 	s.analytics.TrackAccessGrantCreated(auth.User.ID)
 
-	return info, key, nil
+	return info, restricted, nil
 }
 
 // GetAPIKeyInfo retrieves api key by id.
@@ -1370,7 +1600,7 @@ func (s *Service) GetAPIKeyInfo(ctx context.Context, id uuid.UUID) (_ *APIKeyInf
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, key.ProjectID)
+	_, err = s.hasProjectPermission(ctx, auth.User.ID, key.ProjectID, PermissionManageAPIKeys)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1393,6 +1623,7 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 	}
 
 	var keysErr errs.Group
+	var keys []*APIKeyInfo
 
 	for _, keyID := range ids {
 		key, err := s.store.APIKeys().Get(ctx, keyID)
@@ -1401,11 +1632,13 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 			continue
 		}
 
-		_, err = s.isProjectMember(ctx, auth.User.ID, key.ProjectID)
+		_, err = s.hasProjectPermission(ctx, auth.User.ID, key.ProjectID, PermissionManageAPIKeys)
 		if err != nil {
 			keysErr.Add(ErrUnauthorized.Wrap(err))
 			continue
 		}
+
+		keys = append(keys, key)
 	}
 
 	if err = keysErr.Err(); err != nil {
@@ -1413,8 +1646,17 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 	}
 
 	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
-		for _, keyToDeleteID := range ids {
-			err = tx.APIKeys().Delete(ctx, keyToDeleteID)
+		for _, key := range keys {
+			err = tx.APIKeys().Delete(ctx, key.ID)
+			if err != nil {
+				return err
+			}
+
+			err = recordAuditEvent(ctx, tx, auth, AuditEvent{
+				Action:    "delete api key",
+				ProjectID: key.ProjectID,
+				Target:    key.ID.String(),
+			}, APIKeyAuditValue(key), nil)
 			if err != nil {
 				return err
 			}
@@ -1434,7 +1676,7 @@ func (s *Service) DeleteAPIKeyByNameAndProjectID(ctx context.Context, name strin
 		return Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionManageAPIKeys)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -1444,7 +1686,18 @@ func (s *Service) DeleteAPIKeyByNameAndProjectID(ctx context.Context, name strin
 		return ErrNoAPIKey.New(apiKeyWithNameDoesntExistErrMsg)
 	}
 
-	err = s.store.APIKeys().Delete(ctx, key.ID)
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		err := tx.APIKeys().Delete(ctx, key.ID)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, tx, auth, AuditEvent{
+			Action:    "delete api key",
+			ProjectID: projectID,
+			Target:    key.ID.String(),
+		}, APIKeyAuditValue(key), nil)
+	})
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -1461,7 +1714,7 @@ func (s *Service) GetAPIKeys(ctx context.Context, projectID uuid.UUID, cursor AP
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionManageAPIKeys)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1487,7 +1740,7 @@ func (s *Service) GetProjectUsage(ctx context.Context, projectID uuid.UUID, sinc
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1509,7 +1762,7 @@ func (s *Service) GetBucketTotals(ctx context.Context, projectID uuid.UUID, curs
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	isMember, err := s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1531,7 +1784,7 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionManageBuckets)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1557,6 +1810,55 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 	return list, nil
 }
 
+// UpdateBucketLimits updates the storage and bandwidth limits of a bucket.
+// A nil limit removes it, so the bucket is only limited by the project limits.
+func (s *Service) UpdateBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName string, limits accounting.BucketLimits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "update bucket limits", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if _, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionUpdateProject); err != nil {
+		return Error.Wrap(err)
+	}
+
+	if (limits.Usage != nil && *limits.Usage <= 0) || (limits.Bandwidth != nil && *limits.Bandwidth <= 0) {
+		return ErrValidation.New(bucketLimitsErrMsg)
+	}
+
+	if limits.Usage != nil {
+		projectLimit, err := s.projectUsage.GetProjectStorageLimit(ctx, projectID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if *limits.Usage > projectLimit.Int64() {
+			return ErrValidation.New(bucketLimitsAboveProjectErrMsg, "storage", projectLimit)
+		}
+	}
+
+	if limits.Bandwidth != nil {
+		projectLimit, err := s.projectUsage.GetProjectBandwidthLimit(ctx, projectID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if *limits.Bandwidth > projectLimit.Int64() {
+			return ErrValidation.New(bucketLimitsAboveProjectErrMsg, "bandwidth", projectLimit)
+		}
+	}
+
+	err = s.buckets.UpdateBucketLimits(ctx, []byte(bucketName), projectID, limits)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return ErrValidation.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
 // GetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period.
 func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.BucketUsageRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1566,7 +1868,7 @@ func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1591,7 +1893,7 @@ func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 		return nil, Error.Wrap(err)
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if _, err = s.hasProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject); err != nil {
 		return nil, Error.Wrap(err)
 	}
 
@@ -1605,6 +1907,10 @@ func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 		BandwidthLimit: prUsageLimits.BandwidthLimit,
 		StorageUsed:    prUsageLimits.StorageUsed,
 		BandwidthUsed:  prUsageLimits.BandwidthUsed,
+		SegmentLimit:   prUsageLimits.SegmentLimit,
+		SegmentUsed:    prUsageLimits.SegmentUsed,
+		ObjectLimit:    prUsageLimits.ObjectLimit,
+		ObjectUsed:     prUsageLimits.ObjectUsed,
 	}, nil
 }
 
@@ -1622,29 +1928,24 @@ func (s *Service) GetTotalUsageLimits(ctx context.Context) (_ *ProjectUsageLimit
 		return nil, Error.Wrap(err)
 	}
 
-	var totalStorageLimit int64
-	var totalBandwidthLimit int64
-	var totalStorageUsed int64
-	var totalBandwidthUsed int64
-
+	var total ProjectUsageLimits
 	for _, pr := range projects {
 		prUsageLimits, err := s.getProjectUsageLimits(ctx, pr.ID)
 		if err != nil {
 			return nil, Error.Wrap(err)
 		}
 
-		totalStorageLimit += prUsageLimits.StorageLimit
-		totalBandwidthLimit += prUsageLimits.BandwidthLimit
-		totalStorageUsed += prUsageLimits.StorageUsed
-		totalBandwidthUsed += prUsageLimits.BandwidthUsed
+		total.StorageLimit += prUsageLimits.StorageLimit
+		total.BandwidthLimit += prUsageLimits.BandwidthLimit
+		total.StorageUsed += prUsageLimits.StorageUsed
+		total.BandwidthUsed += prUsageLimits.BandwidthUsed
+		total.SegmentLimit += prUsageLimits.SegmentLimit
+		total.SegmentUsed += prUsageLimits.SegmentUsed
+		total.ObjectLimit += prUsageLimits.ObjectLimit
+		total.ObjectUsed += prUsageLimits.ObjectUsed
 	}
 
-	return &ProjectUsageLimits{
-		StorageLimit:   totalStorageLimit,
-		BandwidthLimit: totalBandwidthLimit,
-		StorageUsed:    totalStorageUsed,
-		BandwidthUsed:  totalBandwidthUsed,
-	}, nil
+	return &total, nil
 }
 
 func (s *Service) getProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (_ *ProjectUsageLimits, err error) {
@@ -1668,11 +1969,33 @@ func (s *Service) getProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 		return nil, err
 	}
 
+	segmentLimit, err := s.projectUsage.GetProjectSegmentLimit(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	segmentUsed, err := s.projectUsage.GetProjectSegmentTotals(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	objectLimit, err := s.projectUsage.GetProjectObjectLimit(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	objectUsed, err := s.projectUsage.GetProjectObjectTotals(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ProjectUsageLimits{
 		StorageLimit:   storageLimit.Int64(),
 		BandwidthLimit: bandwidthLimit.Int64(),
 		StorageUsed:    storageUsed,
 		BandwidthUsed:  bandwidthUsed,
+		SegmentLimit:   segmentLimit,
+		SegmentUsed:    segmentUsed,
+		ObjectLimit:    objectLimit,
+		ObjectUsed:     objectUsed,
 	}, nil
 }
 
@@ -1829,6 +2152,34 @@ type isProjectMember struct {
 	membership *ProjectMember
 }
 
+// hasProjectPermission checks if the user is a member of given project and
+// whether the role of the member allows the action.
+func (s *Service) hasProjectPermission(ctx context.Context, userID uuid.UUID, projectID uuid.UUID, permission ProjectPermission) (_ isProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	isMember, err := s.isProjectMember(ctx, userID, projectID)
+	if err != nil {
+		return isProjectMember{}, err
+	}
+
+	if !isMember.membership.Role.Allows(permission) {
+		return isProjectMember{}, ErrUnauthorized.New(unauthorizedErrMsg)
+	}
+
+	return isMember, nil
+}
+
+// validateMemberRole checks that the role can be given to a project member.
+func validateMemberRole(role ProjectMemberRole) error {
+	switch role {
+	case RoleAdmin, RoleDeveloper, RoleBilling:
+		return nil
+	case RoleOwner:
+		return ErrValidation.New(projectOwnerRoleErrMsg)
+	default:
+		return ErrValidation.New("invalid project member role %d", int(role))
+	}
+}
+
 // isProjectOwner checks if the user is an owner of a project.
 func (s *Service) isProjectOwner(ctx context.Context, userID uuid.UUID, projectID uuid.UUID) (isOwner bool, err error) {
 	defer mon.Task()(&ctx)(&err)
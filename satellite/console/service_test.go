@@ -19,7 +19,9 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
 )
 
 func TestService(t *testing.T) {
@@ -72,13 +74,13 @@ func TestService(t *testing.T) {
 
 			t.Run("TestAddProjectMembers", func(t *testing.T) {
 				// Adding members to own project should work
-				addedUsers, err := service.AddProjectMembers(authCtx1, up1Pro1.ID, []string{up2User.Email})
+				addedUsers, err := service.AddProjectMembers(authCtx1, up1Pro1.ID, []string{up2User.Email}, console.RoleAdmin)
 				require.NoError(t, err)
 				require.Len(t, addedUsers, 1)
 				require.Contains(t, addedUsers, up2User)
 
 				// Adding members to someone else project should not work
-				addedUsers, err = service.AddProjectMembers(authCtx1, up2Pro1.ID, []string{up2User.Email})
+				addedUsers, err = service.AddProjectMembers(authCtx1, up2Pro1.ID, []string{up2User.Email}, console.RoleAdmin)
 				require.Error(t, err)
 				require.Nil(t, addedUsers)
 			})
@@ -100,6 +102,61 @@ func TestService(t *testing.T) {
 				require.Nil(t, userPage)
 			})
 
+			t.Run("TestProjectMemberRoles", func(t *testing.T) {
+				up1User, err := sat.API.DB.Console().Users().Get(ctx, up1Pro1.OwnerID)
+				require.NoError(t, err)
+
+				// The role of the owner can't be changed
+				err = service.UpdateProjectMemberRole(authCtx1, up1Pro1.ID, up1User.Email, console.RoleAdmin)
+				require.True(t, console.ErrValidation.Has(err))
+
+				err = service.UpdateProjectMemberRole(authCtx1, up1Pro1.ID, up2User.Email, console.RoleBilling)
+				require.NoError(t, err)
+
+				// Billing members can only view the project
+				_, err = service.GetProject(authCtx2, up1Pro1.ID)
+				require.NoError(t, err)
+				_, err = service.GetProjectUsageLimits(authCtx2, up1Pro1.ID)
+				require.NoError(t, err)
+				_, err = service.GetAPIKeys(authCtx2, up1Pro1.ID, console.APIKeyCursor{Page: 1, Limit: 10})
+				require.True(t, console.ErrUnauthorized.Has(err))
+				_, _, err = service.CreateAPIKey(authCtx2, up1Pro1.ID, "billing key", console.APIKeyLimits{})
+				require.True(t, console.ErrUnauthorized.Has(err))
+
+				// Billing members see the charges of the project
+				now := time.Now()
+				charges, err := service.Payments().ProjectsCharges(authCtx2, now.Add(-time.Hour), now)
+				require.NoError(t, err)
+				require.True(t, hasProjectCharge(charges, up1Pro1.ID))
+
+				err = service.UpdateProjectMemberRole(authCtx1, up1Pro1.ID, up2User.Email, console.RoleDeveloper)
+				require.NoError(t, err)
+
+				// Developers can manage API keys, but not the project and its members
+				_, err = service.GetAPIKeys(authCtx2, up1Pro1.ID, console.APIKeyCursor{Page: 1, Limit: 10})
+				require.NoError(t, err)
+				_, err = service.UpdateProject(authCtx2, up1Pro1.ID, "devName", "TestUpdate")
+				require.True(t, console.ErrUnauthorized.Has(err))
+				charges, err = service.Payments().ProjectsCharges(authCtx2, now.Add(-time.Hour), now)
+				require.NoError(t, err)
+				require.False(t, hasProjectCharge(charges, up1Pro1.ID))
+				err = service.UpdateProjectMemberRole(authCtx2, up1Pro1.ID, up2User.Email, console.RoleAdmin)
+				require.True(t, console.ErrUnauthorized.Has(err))
+				err = service.DeleteProjectMembers(authCtx2, up1Pro1.ID, []string{up2User.Email})
+				require.True(t, console.ErrUnauthorized.Has(err))
+				err = service.DeleteProject(authCtx2, up1Pro1.ID)
+				require.True(t, console.ErrUnauthorized.Has(err))
+
+				members, err := service.GetProjectMembers(authCtx1, up1Pro1.ID, console.ProjectMembersCursor{Page: 1, Limit: 10})
+				require.NoError(t, err)
+				roles := map[uuid.UUID]console.ProjectMemberRole{}
+				for _, member := range members.ProjectMembers {
+					roles[member.MemberID] = member.Role
+				}
+				require.Equal(t, console.RoleOwner, roles[up1User.ID])
+				require.Equal(t, console.RoleDeveloper, roles[up2User.ID])
+			})
+
 			t.Run("TestDeleteProjectMembers", func(t *testing.T) {
 				// Deleting project members of an own project should work
 				err := service.DeleteProjectMembers(authCtx1, up1Pro1.ID, []string{up2User.Email})
@@ -183,6 +240,40 @@ func TestService(t *testing.T) {
 				require.Nil(t, bucketsForUnauthorizedUser)
 			})
 
+			t.Run("TestUpdateBucketLimits", func(t *testing.T) {
+				_, err := sat.DB.Buckets().CreateBucket(authCtx2, storj.Bucket{
+					ID:        testrand.UUID(),
+					Name:      "limitedBucket",
+					ProjectID: up2Pro1.ID,
+				})
+				require.NoError(t, err)
+
+				limit := (10 * memory.MB).Int64()
+				err = service.UpdateBucketLimits(authCtx2, up2Pro1.ID, "limitedBucket", accounting.BucketLimits{Usage: &limit, Bandwidth: &limit})
+				require.NoError(t, err)
+
+				limits, err := sat.DB.Buckets().GetBucketLimits(ctx, []byte("limitedBucket"), up2Pro1.ID)
+				require.NoError(t, err)
+				require.Equal(t, limit, *limits.Usage)
+				require.Equal(t, limit, *limits.Bandwidth)
+
+				// limits must be positive
+				zero := int64(0)
+				err = service.UpdateBucketLimits(authCtx2, up2Pro1.ID, "limitedBucket", accounting.BucketLimits{Usage: &zero})
+				require.True(t, console.ErrValidation.Has(err))
+
+				// limits can't be higher than the project limits
+				aboveProject := (1 * memory.PB).Int64()
+				err = service.UpdateBucketLimits(authCtx2, up2Pro1.ID, "limitedBucket", accounting.BucketLimits{Usage: &aboveProject})
+				require.True(t, console.ErrValidation.Has(err))
+				err = service.UpdateBucketLimits(authCtx2, up2Pro1.ID, "limitedBucket", accounting.BucketLimits{Bandwidth: &aboveProject})
+				require.True(t, console.ErrValidation.Has(err))
+
+				// updating someone else bucket limits should not work
+				err = service.UpdateBucketLimits(authCtx1, up2Pro1.ID, "limitedBucket", accounting.BucketLimits{})
+				require.Error(t, err)
+			})
+
 			t.Run("TestDeleteAPIKeyByNameAndProjectID", func(t *testing.T) {
 				secret, err := macaroon.NewSecret()
 				require.NoError(t, err)
@@ -430,3 +521,12 @@ func TestMFA(t *testing.T) {
 		})
 	})
 }
+
+func hasProjectCharge(charges []payments.ProjectCharge, projectID uuid.UUID) bool {
+	for _, charge := range charges {
+		if charge.ProjectID == projectID {
+			return true
+		}
+	}
+	return false
+}
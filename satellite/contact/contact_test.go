@@ -8,14 +8,18 @@ import (
 	"crypto/x509"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcpeer"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/storagenode"
 )
 
@@ -53,6 +57,39 @@ func TestSatelliteContactEndpoint(t *testing.T) {
 	})
 }
 
+func TestSatelliteContactEndpoint_CountryCode(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.GeoIP.MockCountries = []string{"DE"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		require.NoError(t, node.Contact.Service.PingSatellites(ctx, 0))
+
+		dossier, err := planet.Satellites[0].Overlay.Service.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.Equal(t, "DE", dossier.CountryCode)
+
+		// a failed lookup keeps the previous country code.
+		err = planet.Satellites[0].Overlay.DB.UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+			NodeID:     node.ID(),
+			Address:    &pb.NodeAddress{Address: dossier.Address.Address},
+			LastNet:    dossier.LastNet,
+			LastIPPort: dossier.LastIPPort,
+			IsUp:       true,
+			Version:    &dossier.Version,
+		}, time.Now(), planet.Satellites[0].Config.Overlay.Node)
+		require.NoError(t, err)
+
+		dossier, err = planet.Satellites[0].Overlay.Service.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.Equal(t, "DE", dossier.CountryCode)
+	})
+}
+
 func TestSatelliteContactEndpoint_QUIC_Unreachable(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
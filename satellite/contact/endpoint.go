@@ -74,6 +74,11 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, errCheckInNetwork.New("failed to resolve IP from address: %s, err: %v", req.Address, err).Error())
 	}
 
+	countryCode, err := endpoint.service.overlay.LookupCountryCode(ctx, resolvedIPPort)
+	if err != nil {
+		endpoint.log.Info("failed to resolve country code", zap.String("node address", req.Address), zap.Stringer("Node ID", nodeID), zap.Error(err))
+	}
+
 	nodeurl := storj.NodeURL{
 		ID:      nodeID,
 		Address: req.Address,
@@ -107,12 +112,13 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 			Address:   req.Address,
 			Transport: pb.NodeTransport_TCP_TLS_GRPC,
 		},
-		LastNet:    resolvedNetwork,
-		LastIPPort: resolvedIPPort,
-		IsUp:       pingNodeSuccess,
-		Capacity:   req.Capacity,
-		Operator:   req.Operator,
-		Version:    req.Version,
+		LastNet:     resolvedNetwork,
+		LastIPPort:  resolvedIPPort,
+		CountryCode: countryCode,
+		IsUp:        pingNodeSuccess,
+		Capacity:    req.Capacity,
+		Operator:    req.Operator,
+		Version:     req.Version,
 	}
 
 	err = endpoint.service.overlay.UpdateCheckIn(ctx, nodeInfo, time.Now().UTC())
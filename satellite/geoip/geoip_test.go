@@ -0,0 +1,44 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package geoip_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/geoip"
+)
+
+func TestMockIPToCountry(t *testing.T) {
+	mock := geoip.NewMockIPToCountry([]string{"de", "US"})
+
+	for _, tt := range []struct {
+		address string
+		country string
+	}{
+		{"10.0.0.2", "DE"},
+		{"10.0.0.3", "US"},
+		{"127.0.0.1:7777", "US"},
+		{"[::2]:7777", "DE"},
+	} {
+		country, err := mock.LookupISOCountryCode(tt.address)
+		require.NoError(t, err, tt.address)
+		require.Equal(t, tt.country, country, tt.address)
+	}
+
+	_, err := mock.LookupISOCountryCode("not-an-ip")
+	require.Error(t, err)
+
+	country, err := geoip.NewMockIPToCountry(nil).LookupISOCountryCode("127.0.0.1")
+	require.NoError(t, err)
+	require.Empty(t, country)
+
+	require.NoError(t, mock.Close())
+}
+
+func TestOpenMaxmindDB(t *testing.T) {
+	_, err := geoip.OpenMaxmindDB("nonexistent.mmdb")
+	require.Error(t, err)
+}
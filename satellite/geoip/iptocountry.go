@@ -0,0 +1,42 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package geoip implements resolving IP addresses to the location of the host.
+package geoip
+
+import (
+	"io"
+	"net"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the default error class for the geoip package.
+var Error = errs.Class("geoip")
+
+// IPToCountry defines an abstraction for resolving the ISO country code
+// given the string representation of an IP address.
+//
+// architecture: Service
+type IPToCountry interface {
+	io.Closer
+	// LookupISOCountryCode returns the ISO 3166-1 alpha-2 country code of the address,
+	// or an empty string when the location is unknown.
+	// The address may contain a port.
+	LookupISOCountryCode(address string) (string, error)
+}
+
+// parseIP parses the IP address, which may contain a port.
+func parseIP(address string) (net.IP, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		// address without a port
+		host = address
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, Error.New("invalid IP address %q", address)
+	}
+	return ip, nil
+}
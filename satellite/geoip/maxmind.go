@@ -0,0 +1,51 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package geoip
+
+import (
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// ipInfo contains the fields of the MaxMind database record we are interested in.
+type ipInfo struct {
+	Country struct {
+		IsoCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+}
+
+// MaxmindDB provides a IPToCountry implementation that uses a MaxMind GeoIP2
+// or GeoLite2 country database.
+type MaxmindDB struct {
+	reader *maxminddb.Reader
+}
+
+var _ IPToCountry = (*MaxmindDB)(nil)
+
+// OpenMaxmindDB opens the MaxMind database file at path.
+func OpenMaxmindDB(path string) (*MaxmindDB, error) {
+	reader, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &MaxmindDB{reader: reader}, nil
+}
+
+// Close closes the database.
+func (db *MaxmindDB) Close() error {
+	return Error.Wrap(db.reader.Close())
+}
+
+// LookupISOCountryCode returns the ISO country code of the address.
+func (db *MaxmindDB) LookupISOCountryCode(address string) (string, error) {
+	ip, err := parseIP(address)
+	if err != nil {
+		return "", err
+	}
+
+	var info ipInfo
+	if err := db.reader.Lookup(ip, &info); err != nil {
+		return "", Error.Wrap(err)
+	}
+	return info.Country.IsoCode, nil
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package geoip
+
+import (
+	"strings"
+)
+
+// MockIPToCountry provides a IPToCountry implementation for testing.
+// The country of an address is chosen from the list of countries by
+// the last byte of the IP address.
+type MockIPToCountry []string
+
+var _ IPToCountry = (MockIPToCountry)(nil)
+
+// NewMockIPToCountry creates a new mock with the list of country codes.
+func NewMockIPToCountry(countries []string) MockIPToCountry {
+	mock := make(MockIPToCountry, 0, len(countries))
+	for _, country := range countries {
+		mock = append(mock, strings.ToUpper(strings.TrimSpace(country)))
+	}
+	return mock
+}
+
+// Close does nothing.
+func (mock MockIPToCountry) Close() error { return nil }
+
+// LookupISOCountryCode returns the mocked country code of the address.
+func (mock MockIPToCountry) LookupISOCountryCode(address string) (string, error) {
+	ip, err := parseIP(address)
+	if err != nil {
+		return "", err
+	}
+	if len(mock) == 0 {
+		return "", nil
+	}
+	return mock[int(ip[len(ip)-1])%len(mock)], nil
+}
@@ -0,0 +1,2008 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: metainfo_ext.proto
+
+package internalpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type EncryptedKeyAndNonce struct {
+	Position             *pb.SegmentPosition `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	EncryptedKeyNonce    []byte              `protobuf:"bytes,2,opt,name=encrypted_key_nonce,json=encryptedKeyNonce,proto3" json:"encrypted_key_nonce,omitempty"`
+	EncryptedKey         []byte              `protobuf:"bytes,3,opt,name=encrypted_key,json=encryptedKey,proto3" json:"encrypted_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *EncryptedKeyAndNonce) Reset()         { *m = EncryptedKeyAndNonce{} }
+func (m *EncryptedKeyAndNonce) String() string { return proto.CompactTextString(m) }
+func (*EncryptedKeyAndNonce) ProtoMessage()    {}
+func (*EncryptedKeyAndNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{0}
+}
+func (m *EncryptedKeyAndNonce) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncryptedKeyAndNonce.Unmarshal(m, b)
+}
+func (m *EncryptedKeyAndNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncryptedKeyAndNonce.Marshal(b, m, deterministic)
+}
+func (m *EncryptedKeyAndNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedKeyAndNonce.Merge(m, src)
+}
+func (m *EncryptedKeyAndNonce) XXX_Size() int {
+	return xxx_messageInfo_EncryptedKeyAndNonce.Size(m)
+}
+func (m *EncryptedKeyAndNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedKeyAndNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedKeyAndNonce proto.InternalMessageInfo
+
+func (m *EncryptedKeyAndNonce) GetPosition() *pb.SegmentPosition {
+	if m != nil {
+		return m.Position
+	}
+	return nil
+}
+
+func (m *EncryptedKeyAndNonce) GetEncryptedKeyNonce() []byte {
+	if m != nil {
+		return m.EncryptedKeyNonce
+	}
+	return nil
+}
+
+func (m *EncryptedKeyAndNonce) GetEncryptedKey() []byte {
+	if m != nil {
+		return m.EncryptedKey
+	}
+	return nil
+}
+
+type ObjectBeginMoveRequest struct {
+	Header                *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket                []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey    []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	NewBucket             []byte            `protobuf:"bytes,3,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedObjectKey []byte            `protobuf:"bytes,4,opt,name=new_encrypted_object_key,json=newEncryptedObjectKey,proto3" json:"new_encrypted_object_key,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
+}
+
+func (m *ObjectBeginMoveRequest) Reset()         { *m = ObjectBeginMoveRequest{} }
+func (m *ObjectBeginMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginMoveRequest) ProtoMessage()    {}
+func (*ObjectBeginMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{1}
+}
+func (m *ObjectBeginMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginMoveRequest.Unmarshal(m, b)
+}
+func (m *ObjectBeginMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginMoveRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginMoveRequest.Merge(m, src)
+}
+func (m *ObjectBeginMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginMoveRequest.Size(m)
+}
+func (m *ObjectBeginMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginMoveRequest proto.InternalMessageInfo
+
+func (m *ObjectBeginMoveRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveRequest) GetNewEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.NewEncryptedObjectKey
+	}
+	return nil
+}
+
+type ObjectBeginMoveResponse struct {
+	StreamId                  []byte                   `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	EncryptedMetadataKeyNonce []byte                   `protobuf:"bytes,2,opt,name=encrypted_metadata_key_nonce,json=encryptedMetadataKeyNonce,proto3" json:"encrypted_metadata_key_nonce,omitempty"`
+	EncryptedMetadataKey      []byte                   `protobuf:"bytes,3,opt,name=encrypted_metadata_key,json=encryptedMetadataKey,proto3" json:"encrypted_metadata_key,omitempty"`
+	SegmentKeys               []*EncryptedKeyAndNonce  `protobuf:"bytes,4,rep,name=segment_keys,json=segmentKeys,proto3" json:"segment_keys,omitempty"`
+	EncryptionParameters      *pb.EncryptionParameters `protobuf:"bytes,5,opt,name=encryption_parameters,json=encryptionParameters,proto3" json:"encryption_parameters,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                 `json:"-"`
+	XXX_unrecognized          []byte                   `json:"-"`
+	XXX_sizecache             int32                    `json:"-"`
+}
+
+func (m *ObjectBeginMoveResponse) Reset()         { *m = ObjectBeginMoveResponse{} }
+func (m *ObjectBeginMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginMoveResponse) ProtoMessage()    {}
+func (*ObjectBeginMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{2}
+}
+func (m *ObjectBeginMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginMoveResponse.Unmarshal(m, b)
+}
+func (m *ObjectBeginMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginMoveResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginMoveResponse.Merge(m, src)
+}
+func (m *ObjectBeginMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginMoveResponse.Size(m)
+}
+func (m *ObjectBeginMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginMoveResponse proto.InternalMessageInfo
+
+func (m *ObjectBeginMoveResponse) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveResponse) GetEncryptedMetadataKeyNonce() []byte {
+	if m != nil {
+		return m.EncryptedMetadataKeyNonce
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveResponse) GetEncryptedMetadataKey() []byte {
+	if m != nil {
+		return m.EncryptedMetadataKey
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveResponse) GetSegmentKeys() []*EncryptedKeyAndNonce {
+	if m != nil {
+		return m.SegmentKeys
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveResponse) GetEncryptionParameters() *pb.EncryptionParameters {
+	if m != nil {
+		return m.EncryptionParameters
+	}
+	return nil
+}
+
+type ObjectFinishMoveRequest struct {
+	Header                       *pb.RequestHeader       `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	StreamId                     []byte                  `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	NewBucket                    []byte                  `protobuf:"bytes,2,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedObjectKey        []byte                  `protobuf:"bytes,3,opt,name=new_encrypted_object_key,json=newEncryptedObjectKey,proto3" json:"new_encrypted_object_key,omitempty"`
+	NewEncryptedMetadataKeyNonce []byte                  `protobuf:"bytes,4,opt,name=new_encrypted_metadata_key_nonce,json=newEncryptedMetadataKeyNonce,proto3" json:"new_encrypted_metadata_key_nonce,omitempty"`
+	NewEncryptedMetadataKey      []byte                  `protobuf:"bytes,5,opt,name=new_encrypted_metadata_key,json=newEncryptedMetadataKey,proto3" json:"new_encrypted_metadata_key,omitempty"`
+	NewSegmentKeys               []*EncryptedKeyAndNonce `protobuf:"bytes,6,rep,name=new_segment_keys,json=newSegmentKeys,proto3" json:"new_segment_keys,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                `json:"-"`
+	XXX_unrecognized             []byte                  `json:"-"`
+	XXX_sizecache                int32                   `json:"-"`
+}
+
+func (m *ObjectFinishMoveRequest) Reset()         { *m = ObjectFinishMoveRequest{} }
+func (m *ObjectFinishMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishMoveRequest) ProtoMessage()    {}
+func (*ObjectFinishMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{3}
+}
+func (m *ObjectFinishMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishMoveRequest.Unmarshal(m, b)
+}
+func (m *ObjectFinishMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishMoveRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishMoveRequest.Merge(m, src)
+}
+func (m *ObjectFinishMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishMoveRequest.Size(m)
+}
+func (m *ObjectFinishMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishMoveRequest proto.InternalMessageInfo
+
+func (m *ObjectFinishMoveRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetNewEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.NewEncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetNewEncryptedMetadataKeyNonce() []byte {
+	if m != nil {
+		return m.NewEncryptedMetadataKeyNonce
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetNewEncryptedMetadataKey() []byte {
+	if m != nil {
+		return m.NewEncryptedMetadataKey
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetNewSegmentKeys() []*EncryptedKeyAndNonce {
+	if m != nil {
+		return m.NewSegmentKeys
+	}
+	return nil
+}
+
+type ObjectFinishMoveResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectFinishMoveResponse) Reset()         { *m = ObjectFinishMoveResponse{} }
+func (m *ObjectFinishMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishMoveResponse) ProtoMessage()    {}
+func (*ObjectFinishMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{4}
+}
+func (m *ObjectFinishMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishMoveResponse.Unmarshal(m, b)
+}
+func (m *ObjectFinishMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishMoveResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishMoveResponse.Merge(m, src)
+}
+func (m *ObjectFinishMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishMoveResponse.Size(m)
+}
+func (m *ObjectFinishMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishMoveResponse proto.InternalMessageInfo
+
+type ObjectBeginCopyRequest struct {
+	Header                *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket                []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey    []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	NewBucket             []byte            `protobuf:"bytes,3,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedObjectKey []byte            `protobuf:"bytes,4,opt,name=new_encrypted_object_key,json=newEncryptedObjectKey,proto3" json:"new_encrypted_object_key,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
+}
+
+func (m *ObjectBeginCopyRequest) Reset()         { *m = ObjectBeginCopyRequest{} }
+func (m *ObjectBeginCopyRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginCopyRequest) ProtoMessage()    {}
+func (*ObjectBeginCopyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{5}
+}
+func (m *ObjectBeginCopyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginCopyRequest.Unmarshal(m, b)
+}
+func (m *ObjectBeginCopyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginCopyRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginCopyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginCopyRequest.Merge(m, src)
+}
+func (m *ObjectBeginCopyRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginCopyRequest.Size(m)
+}
+func (m *ObjectBeginCopyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginCopyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginCopyRequest proto.InternalMessageInfo
+
+func (m *ObjectBeginCopyRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyRequest) GetNewEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.NewEncryptedObjectKey
+	}
+	return nil
+}
+
+type ObjectBeginCopyResponse struct {
+	StreamId                  []byte                   `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	EncryptedMetadataKeyNonce []byte                   `protobuf:"bytes,2,opt,name=encrypted_metadata_key_nonce,json=encryptedMetadataKeyNonce,proto3" json:"encrypted_metadata_key_nonce,omitempty"`
+	EncryptedMetadataKey      []byte                   `protobuf:"bytes,3,opt,name=encrypted_metadata_key,json=encryptedMetadataKey,proto3" json:"encrypted_metadata_key,omitempty"`
+	SegmentKeys               []*EncryptedKeyAndNonce  `protobuf:"bytes,4,rep,name=segment_keys,json=segmentKeys,proto3" json:"segment_keys,omitempty"`
+	EncryptionParameters      *pb.EncryptionParameters `protobuf:"bytes,5,opt,name=encryption_parameters,json=encryptionParameters,proto3" json:"encryption_parameters,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                 `json:"-"`
+	XXX_unrecognized          []byte                   `json:"-"`
+	XXX_sizecache             int32                    `json:"-"`
+}
+
+func (m *ObjectBeginCopyResponse) Reset()         { *m = ObjectBeginCopyResponse{} }
+func (m *ObjectBeginCopyResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginCopyResponse) ProtoMessage()    {}
+func (*ObjectBeginCopyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{6}
+}
+func (m *ObjectBeginCopyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginCopyResponse.Unmarshal(m, b)
+}
+func (m *ObjectBeginCopyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginCopyResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginCopyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginCopyResponse.Merge(m, src)
+}
+func (m *ObjectBeginCopyResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginCopyResponse.Size(m)
+}
+func (m *ObjectBeginCopyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginCopyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginCopyResponse proto.InternalMessageInfo
+
+func (m *ObjectBeginCopyResponse) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyResponse) GetEncryptedMetadataKeyNonce() []byte {
+	if m != nil {
+		return m.EncryptedMetadataKeyNonce
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyResponse) GetEncryptedMetadataKey() []byte {
+	if m != nil {
+		return m.EncryptedMetadataKey
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyResponse) GetSegmentKeys() []*EncryptedKeyAndNonce {
+	if m != nil {
+		return m.SegmentKeys
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyResponse) GetEncryptionParameters() *pb.EncryptionParameters {
+	if m != nil {
+		return m.EncryptionParameters
+	}
+	return nil
+}
+
+type ObjectFinishCopyRequest struct {
+	Header                       *pb.RequestHeader       `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	StreamId                     []byte                  `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	NewBucket                    []byte                  `protobuf:"bytes,2,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedObjectKey        []byte                  `protobuf:"bytes,3,opt,name=new_encrypted_object_key,json=newEncryptedObjectKey,proto3" json:"new_encrypted_object_key,omitempty"`
+	NewEncryptedMetadataKeyNonce []byte                  `protobuf:"bytes,4,opt,name=new_encrypted_metadata_key_nonce,json=newEncryptedMetadataKeyNonce,proto3" json:"new_encrypted_metadata_key_nonce,omitempty"`
+	NewEncryptedMetadataKey      []byte                  `protobuf:"bytes,5,opt,name=new_encrypted_metadata_key,json=newEncryptedMetadataKey,proto3" json:"new_encrypted_metadata_key,omitempty"`
+	NewSegmentKeys               []*EncryptedKeyAndNonce `protobuf:"bytes,6,rep,name=new_segment_keys,json=newSegmentKeys,proto3" json:"new_segment_keys,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                `json:"-"`
+	XXX_unrecognized             []byte                  `json:"-"`
+	XXX_sizecache                int32                   `json:"-"`
+}
+
+func (m *ObjectFinishCopyRequest) Reset()         { *m = ObjectFinishCopyRequest{} }
+func (m *ObjectFinishCopyRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishCopyRequest) ProtoMessage()    {}
+func (*ObjectFinishCopyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{7}
+}
+func (m *ObjectFinishCopyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishCopyRequest.Unmarshal(m, b)
+}
+func (m *ObjectFinishCopyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishCopyRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishCopyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishCopyRequest.Merge(m, src)
+}
+func (m *ObjectFinishCopyRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishCopyRequest.Size(m)
+}
+func (m *ObjectFinishCopyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishCopyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishCopyRequest proto.InternalMessageInfo
+
+func (m *ObjectFinishCopyRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetNewEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.NewEncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetNewEncryptedMetadataKeyNonce() []byte {
+	if m != nil {
+		return m.NewEncryptedMetadataKeyNonce
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetNewEncryptedMetadataKey() []byte {
+	if m != nil {
+		return m.NewEncryptedMetadataKey
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetNewSegmentKeys() []*EncryptedKeyAndNonce {
+	if m != nil {
+		return m.NewSegmentKeys
+	}
+	return nil
+}
+
+type ObjectFinishCopyResponse struct {
+	Object               *pb.Object `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ObjectFinishCopyResponse) Reset()         { *m = ObjectFinishCopyResponse{} }
+func (m *ObjectFinishCopyResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishCopyResponse) ProtoMessage()    {}
+func (*ObjectFinishCopyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{8}
+}
+func (m *ObjectFinishCopyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishCopyResponse.Unmarshal(m, b)
+}
+func (m *ObjectFinishCopyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishCopyResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishCopyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishCopyResponse.Merge(m, src)
+}
+func (m *ObjectFinishCopyResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishCopyResponse.Size(m)
+}
+func (m *ObjectFinishCopyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishCopyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishCopyResponse proto.InternalMessageInfo
+
+func (m *ObjectFinishCopyResponse) GetObject() *pb.Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type BucketGetVersioningRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BucketGetVersioningRequest) Reset()         { *m = BucketGetVersioningRequest{} }
+func (m *BucketGetVersioningRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketGetVersioningRequest) ProtoMessage()    {}
+func (*BucketGetVersioningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{9}
+}
+func (m *BucketGetVersioningRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketGetVersioningRequest.Unmarshal(m, b)
+}
+func (m *BucketGetVersioningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketGetVersioningRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketGetVersioningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketGetVersioningRequest.Merge(m, src)
+}
+func (m *BucketGetVersioningRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketGetVersioningRequest.Size(m)
+}
+func (m *BucketGetVersioningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketGetVersioningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketGetVersioningRequest proto.InternalMessageInfo
+
+func (m *BucketGetVersioningRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BucketGetVersioningRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+type BucketGetVersioningResponse struct {
+	Versioning           int32    `protobuf:"varint,1,opt,name=versioning,proto3" json:"versioning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketGetVersioningResponse) Reset()         { *m = BucketGetVersioningResponse{} }
+func (m *BucketGetVersioningResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketGetVersioningResponse) ProtoMessage()    {}
+func (*BucketGetVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{10}
+}
+func (m *BucketGetVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketGetVersioningResponse.Unmarshal(m, b)
+}
+func (m *BucketGetVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketGetVersioningResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketGetVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketGetVersioningResponse.Merge(m, src)
+}
+func (m *BucketGetVersioningResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketGetVersioningResponse.Size(m)
+}
+func (m *BucketGetVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketGetVersioningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketGetVersioningResponse proto.InternalMessageInfo
+
+func (m *BucketGetVersioningResponse) GetVersioning() int32 {
+	if m != nil {
+		return m.Versioning
+	}
+	return 0
+}
+
+type BucketSetVersioningRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Versioning           int32             `protobuf:"varint,2,opt,name=versioning,proto3" json:"versioning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BucketSetVersioningRequest) Reset()         { *m = BucketSetVersioningRequest{} }
+func (m *BucketSetVersioningRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketSetVersioningRequest) ProtoMessage()    {}
+func (*BucketSetVersioningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{11}
+}
+func (m *BucketSetVersioningRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetVersioningRequest.Unmarshal(m, b)
+}
+func (m *BucketSetVersioningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetVersioningRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketSetVersioningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetVersioningRequest.Merge(m, src)
+}
+func (m *BucketSetVersioningRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketSetVersioningRequest.Size(m)
+}
+func (m *BucketSetVersioningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetVersioningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetVersioningRequest proto.InternalMessageInfo
+
+func (m *BucketSetVersioningRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BucketSetVersioningRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *BucketSetVersioningRequest) GetVersioning() int32 {
+	if m != nil {
+		return m.Versioning
+	}
+	return 0
+}
+
+type BucketSetVersioningResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketSetVersioningResponse) Reset()         { *m = BucketSetVersioningResponse{} }
+func (m *BucketSetVersioningResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketSetVersioningResponse) ProtoMessage()    {}
+func (*BucketSetVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{12}
+}
+func (m *BucketSetVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetVersioningResponse.Unmarshal(m, b)
+}
+func (m *BucketSetVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetVersioningResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketSetVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetVersioningResponse.Merge(m, src)
+}
+func (m *BucketSetVersioningResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketSetVersioningResponse.Size(m)
+}
+func (m *BucketSetVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetVersioningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetVersioningResponse proto.InternalMessageInfo
+
+type ObjectListVersionsRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPrefix      []byte            `protobuf:"bytes,2,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	EncryptedCursor      []byte            `protobuf:"bytes,3,opt,name=encrypted_cursor,json=encryptedCursor,proto3" json:"encrypted_cursor,omitempty"`
+	CursorVersion        int64             `protobuf:"varint,4,opt,name=cursor_version,json=cursorVersion,proto3" json:"cursor_version,omitempty"`
+	Recursive            bool              `protobuf:"varint,5,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Limit                int32             `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectListVersionsRequest) Reset()         { *m = ObjectListVersionsRequest{} }
+func (m *ObjectListVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectListVersionsRequest) ProtoMessage()    {}
+func (*ObjectListVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{13}
+}
+func (m *ObjectListVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListVersionsRequest.Unmarshal(m, b)
+}
+func (m *ObjectListVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListVersionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectListVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListVersionsRequest.Merge(m, src)
+}
+func (m *ObjectListVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectListVersionsRequest.Size(m)
+}
+func (m *ObjectListVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListVersionsRequest proto.InternalMessageInfo
+
+func (m *ObjectListVersionsRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsRequest) GetEncryptedPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsRequest) GetEncryptedCursor() []byte {
+	if m != nil {
+		return m.EncryptedCursor
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsRequest) GetCursorVersion() int64 {
+	if m != nil {
+		return m.CursorVersion
+	}
+	return 0
+}
+
+func (m *ObjectListVersionsRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+func (m *ObjectListVersionsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ObjectListVersionsResponse struct {
+	Items                []*ObjectVersion `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	More                 bool             `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ObjectListVersionsResponse) Reset()         { *m = ObjectListVersionsResponse{} }
+func (m *ObjectListVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectListVersionsResponse) ProtoMessage()    {}
+func (*ObjectListVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{14}
+}
+func (m *ObjectListVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListVersionsResponse.Unmarshal(m, b)
+}
+func (m *ObjectListVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListVersionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectListVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListVersionsResponse.Merge(m, src)
+}
+func (m *ObjectListVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectListVersionsResponse.Size(m)
+}
+func (m *ObjectListVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListVersionsResponse proto.InternalMessageInfo
+
+func (m *ObjectListVersionsResponse) GetItems() []*ObjectVersion {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type ObjectVersion struct {
+	EncryptedObjectKey   []byte    `protobuf:"bytes,1,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	Version              int64     `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	IsPrefix             bool      `protobuf:"varint,3,opt,name=is_prefix,json=isPrefix,proto3" json:"is_prefix,omitempty"`
+	IsDeleteMarker       bool      `protobuf:"varint,4,opt,name=is_delete_marker,json=isDeleteMarker,proto3" json:"is_delete_marker,omitempty"`
+	IsLatest             bool      `protobuf:"varint,5,opt,name=is_latest,json=isLatest,proto3" json:"is_latest,omitempty"`
+	CreatedAt            time.Time `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	PlainSize            int64     `protobuf:"varint,7,opt,name=plain_size,json=plainSize,proto3" json:"plain_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ObjectVersion) Reset()         { *m = ObjectVersion{} }
+func (m *ObjectVersion) String() string { return proto.CompactTextString(m) }
+func (*ObjectVersion) ProtoMessage()    {}
+func (*ObjectVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{15}
+}
+func (m *ObjectVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectVersion.Unmarshal(m, b)
+}
+func (m *ObjectVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectVersion.Marshal(b, m, deterministic)
+}
+func (m *ObjectVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectVersion.Merge(m, src)
+}
+func (m *ObjectVersion) XXX_Size() int {
+	return xxx_messageInfo_ObjectVersion.Size(m)
+}
+func (m *ObjectVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectVersion proto.InternalMessageInfo
+
+func (m *ObjectVersion) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ObjectVersion) GetIsPrefix() bool {
+	if m != nil {
+		return m.IsPrefix
+	}
+	return false
+}
+
+func (m *ObjectVersion) GetIsDeleteMarker() bool {
+	if m != nil {
+		return m.IsDeleteMarker
+	}
+	return false
+}
+
+func (m *ObjectVersion) GetIsLatest() bool {
+	if m != nil {
+		return m.IsLatest
+	}
+	return false
+}
+
+func (m *ObjectVersion) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *ObjectVersion) GetPlainSize() int64 {
+	if m != nil {
+		return m.PlainSize
+	}
+	return 0
+}
+
+type ObjectDeleteVersionRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey   []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	Version              int64             `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectDeleteVersionRequest) Reset()         { *m = ObjectDeleteVersionRequest{} }
+func (m *ObjectDeleteVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectDeleteVersionRequest) ProtoMessage()    {}
+func (*ObjectDeleteVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{16}
+}
+func (m *ObjectDeleteVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectDeleteVersionRequest.Unmarshal(m, b)
+}
+func (m *ObjectDeleteVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectDeleteVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectDeleteVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectDeleteVersionRequest.Merge(m, src)
+}
+func (m *ObjectDeleteVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectDeleteVersionRequest.Size(m)
+}
+func (m *ObjectDeleteVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectDeleteVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectDeleteVersionRequest proto.InternalMessageInfo
+
+func (m *ObjectDeleteVersionRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectDeleteVersionRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectDeleteVersionRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectDeleteVersionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type ObjectDeleteVersionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectDeleteVersionResponse) Reset()         { *m = ObjectDeleteVersionResponse{} }
+func (m *ObjectDeleteVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectDeleteVersionResponse) ProtoMessage()    {}
+func (*ObjectDeleteVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{17}
+}
+func (m *ObjectDeleteVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectDeleteVersionResponse.Unmarshal(m, b)
+}
+func (m *ObjectDeleteVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectDeleteVersionResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectDeleteVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectDeleteVersionResponse.Merge(m, src)
+}
+func (m *ObjectDeleteVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectDeleteVersionResponse.Size(m)
+}
+func (m *ObjectDeleteVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectDeleteVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectDeleteVersionResponse proto.InternalMessageInfo
+
+type ObjectGetRetentionRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey   []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	Version              int64             `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectGetRetentionRequest) Reset()         { *m = ObjectGetRetentionRequest{} }
+func (m *ObjectGetRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectGetRetentionRequest) ProtoMessage()    {}
+func (*ObjectGetRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{18}
+}
+func (m *ObjectGetRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectGetRetentionRequest.Unmarshal(m, b)
+}
+func (m *ObjectGetRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectGetRetentionRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectGetRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectGetRetentionRequest.Merge(m, src)
+}
+func (m *ObjectGetRetentionRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectGetRetentionRequest.Size(m)
+}
+func (m *ObjectGetRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectGetRetentionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectGetRetentionRequest proto.InternalMessageInfo
+
+func (m *ObjectGetRetentionRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectGetRetentionRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectGetRetentionRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectGetRetentionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type ObjectGetRetentionResponse struct {
+	Version              int64      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	RetainUntil          *time.Time `protobuf:"bytes,2,opt,name=retain_until,json=retainUntil,proto3,stdtime" json:"retain_until,omitempty"`
+	LegalHold            bool       `protobuf:"varint,3,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ObjectGetRetentionResponse) Reset()         { *m = ObjectGetRetentionResponse{} }
+func (m *ObjectGetRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectGetRetentionResponse) ProtoMessage()    {}
+func (*ObjectGetRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{19}
+}
+func (m *ObjectGetRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectGetRetentionResponse.Unmarshal(m, b)
+}
+func (m *ObjectGetRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectGetRetentionResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectGetRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectGetRetentionResponse.Merge(m, src)
+}
+func (m *ObjectGetRetentionResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectGetRetentionResponse.Size(m)
+}
+func (m *ObjectGetRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectGetRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectGetRetentionResponse proto.InternalMessageInfo
+
+func (m *ObjectGetRetentionResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ObjectGetRetentionResponse) GetRetainUntil() *time.Time {
+	if m != nil {
+		return m.RetainUntil
+	}
+	return nil
+}
+
+func (m *ObjectGetRetentionResponse) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
+	}
+	return false
+}
+
+type ObjectSetRetentionRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey   []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	Version              int64             `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	RetainUntil          time.Time         `protobuf:"bytes,4,opt,name=retain_until,json=retainUntil,proto3,stdtime" json:"retain_until"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectSetRetentionRequest) Reset()         { *m = ObjectSetRetentionRequest{} }
+func (m *ObjectSetRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectSetRetentionRequest) ProtoMessage()    {}
+func (*ObjectSetRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{20}
+}
+func (m *ObjectSetRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectSetRetentionRequest.Unmarshal(m, b)
+}
+func (m *ObjectSetRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectSetRetentionRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectSetRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectSetRetentionRequest.Merge(m, src)
+}
+func (m *ObjectSetRetentionRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectSetRetentionRequest.Size(m)
+}
+func (m *ObjectSetRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectSetRetentionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectSetRetentionRequest proto.InternalMessageInfo
+
+func (m *ObjectSetRetentionRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectSetRetentionRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectSetRetentionRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectSetRetentionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ObjectSetRetentionRequest) GetRetainUntil() time.Time {
+	if m != nil {
+		return m.RetainUntil
+	}
+	return time.Time{}
+}
+
+type ObjectSetRetentionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectSetRetentionResponse) Reset()         { *m = ObjectSetRetentionResponse{} }
+func (m *ObjectSetRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectSetRetentionResponse) ProtoMessage()    {}
+func (*ObjectSetRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{21}
+}
+func (m *ObjectSetRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectSetRetentionResponse.Unmarshal(m, b)
+}
+func (m *ObjectSetRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectSetRetentionResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectSetRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectSetRetentionResponse.Merge(m, src)
+}
+func (m *ObjectSetRetentionResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectSetRetentionResponse.Size(m)
+}
+func (m *ObjectSetRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectSetRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectSetRetentionResponse proto.InternalMessageInfo
+
+type ObjectSetLegalHoldRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey   []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	Version              int64             `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	LegalHold            bool              `protobuf:"varint,4,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectSetLegalHoldRequest) Reset()         { *m = ObjectSetLegalHoldRequest{} }
+func (m *ObjectSetLegalHoldRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectSetLegalHoldRequest) ProtoMessage()    {}
+func (*ObjectSetLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{22}
+}
+func (m *ObjectSetLegalHoldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectSetLegalHoldRequest.Unmarshal(m, b)
+}
+func (m *ObjectSetLegalHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectSetLegalHoldRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectSetLegalHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectSetLegalHoldRequest.Merge(m, src)
+}
+func (m *ObjectSetLegalHoldRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectSetLegalHoldRequest.Size(m)
+}
+func (m *ObjectSetLegalHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectSetLegalHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectSetLegalHoldRequest proto.InternalMessageInfo
+
+func (m *ObjectSetLegalHoldRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectSetLegalHoldRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectSetLegalHoldRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectSetLegalHoldRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ObjectSetLegalHoldRequest) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
+	}
+	return false
+}
+
+type ObjectSetLegalHoldResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectSetLegalHoldResponse) Reset()         { *m = ObjectSetLegalHoldResponse{} }
+func (m *ObjectSetLegalHoldResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectSetLegalHoldResponse) ProtoMessage()    {}
+func (*ObjectSetLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{23}
+}
+func (m *ObjectSetLegalHoldResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectSetLegalHoldResponse.Unmarshal(m, b)
+}
+func (m *ObjectSetLegalHoldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectSetLegalHoldResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectSetLegalHoldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectSetLegalHoldResponse.Merge(m, src)
+}
+func (m *ObjectSetLegalHoldResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectSetLegalHoldResponse.Size(m)
+}
+func (m *ObjectSetLegalHoldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectSetLegalHoldResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectSetLegalHoldResponse proto.InternalMessageInfo
+
+type LifecycleRule struct {
+	Id                        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EncryptedPrefix           []byte   `protobuf:"bytes,2,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	ExpireAfterDays           int32    `protobuf:"varint,3,opt,name=expire_after_days,json=expireAfterDays,proto3" json:"expire_after_days,omitempty"`
+	AbortPendingAfterDays     int32    `protobuf:"varint,4,opt,name=abort_pending_after_days,json=abortPendingAfterDays,proto3" json:"abort_pending_after_days,omitempty"`
+	NoncurrentExpireAfterDays int32    `protobuf:"varint,5,opt,name=noncurrent_expire_after_days,json=noncurrentExpireAfterDays,proto3" json:"noncurrent_expire_after_days,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *LifecycleRule) Reset()         { *m = LifecycleRule{} }
+func (m *LifecycleRule) String() string { return proto.CompactTextString(m) }
+func (*LifecycleRule) ProtoMessage()    {}
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{24}
+}
+func (m *LifecycleRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LifecycleRule.Unmarshal(m, b)
+}
+func (m *LifecycleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LifecycleRule.Marshal(b, m, deterministic)
+}
+func (m *LifecycleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleRule.Merge(m, src)
+}
+func (m *LifecycleRule) XXX_Size() int {
+	return xxx_messageInfo_LifecycleRule.Size(m)
+}
+func (m *LifecycleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleRule proto.InternalMessageInfo
+
+func (m *LifecycleRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LifecycleRule) GetEncryptedPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return nil
+}
+
+func (m *LifecycleRule) GetExpireAfterDays() int32 {
+	if m != nil {
+		return m.ExpireAfterDays
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetAbortPendingAfterDays() int32 {
+	if m != nil {
+		return m.AbortPendingAfterDays
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetNoncurrentExpireAfterDays() int32 {
+	if m != nil {
+		return m.NoncurrentExpireAfterDays
+	}
+	return 0
+}
+
+type BucketGetLifecycleRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BucketGetLifecycleRequest) Reset()         { *m = BucketGetLifecycleRequest{} }
+func (m *BucketGetLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketGetLifecycleRequest) ProtoMessage()    {}
+func (*BucketGetLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{25}
+}
+func (m *BucketGetLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketGetLifecycleRequest.Unmarshal(m, b)
+}
+func (m *BucketGetLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketGetLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketGetLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketGetLifecycleRequest.Merge(m, src)
+}
+func (m *BucketGetLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketGetLifecycleRequest.Size(m)
+}
+func (m *BucketGetLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketGetLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketGetLifecycleRequest proto.InternalMessageInfo
+
+func (m *BucketGetLifecycleRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BucketGetLifecycleRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+type BucketGetLifecycleResponse struct {
+	Rules                []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BucketGetLifecycleResponse) Reset()         { *m = BucketGetLifecycleResponse{} }
+func (m *BucketGetLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketGetLifecycleResponse) ProtoMessage()    {}
+func (*BucketGetLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{26}
+}
+func (m *BucketGetLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketGetLifecycleResponse.Unmarshal(m, b)
+}
+func (m *BucketGetLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketGetLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketGetLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketGetLifecycleResponse.Merge(m, src)
+}
+func (m *BucketGetLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketGetLifecycleResponse.Size(m)
+}
+func (m *BucketGetLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketGetLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketGetLifecycleResponse proto.InternalMessageInfo
+
+func (m *BucketGetLifecycleResponse) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// BucketSetLifecycleRequest replaces all lifecycle rules of the bucket,
+// no rules remove the lifecycle configuration.
+type BucketSetLifecycleRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rules                []*LifecycleRule  `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BucketSetLifecycleRequest) Reset()         { *m = BucketSetLifecycleRequest{} }
+func (m *BucketSetLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketSetLifecycleRequest) ProtoMessage()    {}
+func (*BucketSetLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{27}
+}
+func (m *BucketSetLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetLifecycleRequest.Unmarshal(m, b)
+}
+func (m *BucketSetLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketSetLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetLifecycleRequest.Merge(m, src)
+}
+func (m *BucketSetLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketSetLifecycleRequest.Size(m)
+}
+func (m *BucketSetLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetLifecycleRequest proto.InternalMessageInfo
+
+func (m *BucketSetLifecycleRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BucketSetLifecycleRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *BucketSetLifecycleRequest) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type BucketSetLifecycleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketSetLifecycleResponse) Reset()         { *m = BucketSetLifecycleResponse{} }
+func (m *BucketSetLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketSetLifecycleResponse) ProtoMessage()    {}
+func (*BucketSetLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{28}
+}
+func (m *BucketSetLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetLifecycleResponse.Unmarshal(m, b)
+}
+func (m *BucketSetLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketSetLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetLifecycleResponse.Merge(m, src)
+}
+func (m *BucketSetLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketSetLifecycleResponse.Size(m)
+}
+func (m *BucketSetLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetLifecycleResponse proto.InternalMessageInfo
+
+type Webhook struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events               []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	EncryptedPrefix      []byte   `protobuf:"bytes,4,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{29}
+}
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Webhook.Unmarshal(m, b)
+}
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
+}
+func (m *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(m, src)
+}
+func (m *Webhook) XXX_Size() int {
+	return xxx_messageInfo_Webhook.Size(m)
+}
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
+
+func (m *Webhook) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Webhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Webhook) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Webhook) GetEncryptedPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return nil
+}
+
+type BucketGetNotificationsRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BucketGetNotificationsRequest) Reset()         { *m = BucketGetNotificationsRequest{} }
+func (m *BucketGetNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketGetNotificationsRequest) ProtoMessage()    {}
+func (*BucketGetNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{30}
+}
+func (m *BucketGetNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketGetNotificationsRequest.Unmarshal(m, b)
+}
+func (m *BucketGetNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketGetNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketGetNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketGetNotificationsRequest.Merge(m, src)
+}
+func (m *BucketGetNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketGetNotificationsRequest.Size(m)
+}
+func (m *BucketGetNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketGetNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketGetNotificationsRequest proto.InternalMessageInfo
+
+func (m *BucketGetNotificationsRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BucketGetNotificationsRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+type BucketGetNotificationsResponse struct {
+	Webhooks             []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BucketGetNotificationsResponse) Reset()         { *m = BucketGetNotificationsResponse{} }
+func (m *BucketGetNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketGetNotificationsResponse) ProtoMessage()    {}
+func (*BucketGetNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{31}
+}
+func (m *BucketGetNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketGetNotificationsResponse.Unmarshal(m, b)
+}
+func (m *BucketGetNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketGetNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketGetNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketGetNotificationsResponse.Merge(m, src)
+}
+func (m *BucketGetNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketGetNotificationsResponse.Size(m)
+}
+func (m *BucketGetNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketGetNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketGetNotificationsResponse proto.InternalMessageInfo
+
+func (m *BucketGetNotificationsResponse) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+// BucketSetNotificationsRequest replaces all webhooks of the bucket,
+// no webhooks remove the notification configuration.
+type BucketSetNotificationsRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Webhooks             []*Webhook        `protobuf:"bytes,2,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BucketSetNotificationsRequest) Reset()         { *m = BucketSetNotificationsRequest{} }
+func (m *BucketSetNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketSetNotificationsRequest) ProtoMessage()    {}
+func (*BucketSetNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{32}
+}
+func (m *BucketSetNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetNotificationsRequest.Unmarshal(m, b)
+}
+func (m *BucketSetNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketSetNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetNotificationsRequest.Merge(m, src)
+}
+func (m *BucketSetNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketSetNotificationsRequest.Size(m)
+}
+func (m *BucketSetNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetNotificationsRequest proto.InternalMessageInfo
+
+func (m *BucketSetNotificationsRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BucketSetNotificationsRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *BucketSetNotificationsRequest) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+type BucketSetNotificationsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketSetNotificationsResponse) Reset()         { *m = BucketSetNotificationsResponse{} }
+func (m *BucketSetNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketSetNotificationsResponse) ProtoMessage()    {}
+func (*BucketSetNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{33}
+}
+func (m *BucketSetNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetNotificationsResponse.Unmarshal(m, b)
+}
+func (m *BucketSetNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketSetNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetNotificationsResponse.Merge(m, src)
+}
+func (m *BucketSetNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketSetNotificationsResponse.Size(m)
+}
+func (m *BucketSetNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetNotificationsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*EncryptedKeyAndNonce)(nil), "satellite.metainfo.EncryptedKeyAndNonce")
+	proto.RegisterType((*ObjectBeginMoveRequest)(nil), "satellite.metainfo.ObjectBeginMoveRequest")
+	proto.RegisterType((*ObjectBeginMoveResponse)(nil), "satellite.metainfo.ObjectBeginMoveResponse")
+	proto.RegisterType((*ObjectFinishMoveRequest)(nil), "satellite.metainfo.ObjectFinishMoveRequest")
+	proto.RegisterType((*ObjectFinishMoveResponse)(nil), "satellite.metainfo.ObjectFinishMoveResponse")
+	proto.RegisterType((*ObjectBeginCopyRequest)(nil), "satellite.metainfo.ObjectBeginCopyRequest")
+	proto.RegisterType((*ObjectBeginCopyResponse)(nil), "satellite.metainfo.ObjectBeginCopyResponse")
+	proto.RegisterType((*ObjectFinishCopyRequest)(nil), "satellite.metainfo.ObjectFinishCopyRequest")
+	proto.RegisterType((*ObjectFinishCopyResponse)(nil), "satellite.metainfo.ObjectFinishCopyResponse")
+	proto.RegisterType((*BucketGetVersioningRequest)(nil), "satellite.metainfo.BucketGetVersioningRequest")
+	proto.RegisterType((*BucketGetVersioningResponse)(nil), "satellite.metainfo.BucketGetVersioningResponse")
+	proto.RegisterType((*BucketSetVersioningRequest)(nil), "satellite.metainfo.BucketSetVersioningRequest")
+	proto.RegisterType((*BucketSetVersioningResponse)(nil), "satellite.metainfo.BucketSetVersioningResponse")
+	proto.RegisterType((*ObjectListVersionsRequest)(nil), "satellite.metainfo.ObjectListVersionsRequest")
+	proto.RegisterType((*ObjectListVersionsResponse)(nil), "satellite.metainfo.ObjectListVersionsResponse")
+	proto.RegisterType((*ObjectVersion)(nil), "satellite.metainfo.ObjectVersion")
+	proto.RegisterType((*ObjectDeleteVersionRequest)(nil), "satellite.metainfo.ObjectDeleteVersionRequest")
+	proto.RegisterType((*ObjectDeleteVersionResponse)(nil), "satellite.metainfo.ObjectDeleteVersionResponse")
+	proto.RegisterType((*ObjectGetRetentionRequest)(nil), "satellite.metainfo.ObjectGetRetentionRequest")
+	proto.RegisterType((*ObjectGetRetentionResponse)(nil), "satellite.metainfo.ObjectGetRetentionResponse")
+	proto.RegisterType((*ObjectSetRetentionRequest)(nil), "satellite.metainfo.ObjectSetRetentionRequest")
+	proto.RegisterType((*ObjectSetRetentionResponse)(nil), "satellite.metainfo.ObjectSetRetentionResponse")
+	proto.RegisterType((*ObjectSetLegalHoldRequest)(nil), "satellite.metainfo.ObjectSetLegalHoldRequest")
+	proto.RegisterType((*ObjectSetLegalHoldResponse)(nil), "satellite.metainfo.ObjectSetLegalHoldResponse")
+	proto.RegisterType((*LifecycleRule)(nil), "satellite.metainfo.LifecycleRule")
+	proto.RegisterType((*BucketGetLifecycleRequest)(nil), "satellite.metainfo.BucketGetLifecycleRequest")
+	proto.RegisterType((*BucketGetLifecycleResponse)(nil), "satellite.metainfo.BucketGetLifecycleResponse")
+	proto.RegisterType((*BucketSetLifecycleRequest)(nil), "satellite.metainfo.BucketSetLifecycleRequest")
+	proto.RegisterType((*BucketSetLifecycleResponse)(nil), "satellite.metainfo.BucketSetLifecycleResponse")
+	proto.RegisterType((*Webhook)(nil), "satellite.metainfo.Webhook")
+	proto.RegisterType((*BucketGetNotificationsRequest)(nil), "satellite.metainfo.BucketGetNotificationsRequest")
+	proto.RegisterType((*BucketGetNotificationsResponse)(nil), "satellite.metainfo.BucketGetNotificationsResponse")
+	proto.RegisterType((*BucketSetNotificationsRequest)(nil), "satellite.metainfo.BucketSetNotificationsRequest")
+	proto.RegisterType((*BucketSetNotificationsResponse)(nil), "satellite.metainfo.BucketSetNotificationsResponse")
+}
+
+func init() { proto.RegisterFile("metainfo_ext.proto", fileDescriptor_d8cdca9bebb3074f) }
+
+var fileDescriptor_d8cdca9bebb3074f = []byte{
+	// 1578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x72, 0xdc, 0xc4,
+	0x16, 0xbe, 0xf3, 0x63, 0x67, 0xe6, 0xf8, 0x37, 0x1d, 0xc7, 0x19, 0xcb, 0x4e, 0xe2, 0xab, 0x54,
+	0xaa, 0x7c, 0x93, 0x5c, 0xcd, 0xbd, 0xbe, 0x97, 0xca, 0x82, 0xa2, 0x52, 0xb1, 0xe3, 0x38, 0x94,
+	0xed, 0xe0, 0x92, 0x08, 0x14, 0x6c, 0x84, 0x3c, 0x73, 0x3c, 0xee, 0x58, 0x23, 0x0d, 0x52, 0x8f,
+	0xed, 0xc9, 0x86, 0xe2, 0x0d, 0xd8, 0x50, 0x05, 0x54, 0xb1, 0x81, 0x17, 0x80, 0xb7, 0x60, 0x47,
+	0xf1, 0x02, 0xf0, 0x00, 0xd9, 0xb0, 0x65, 0x47, 0xa9, 0xbb, 0xf5, 0x37, 0xa3, 0xb1, 0x47, 0xc4,
+	0x29, 0x42, 0x91, 0x9d, 0xd4, 0xfd, 0x9d, 0x73, 0xbe, 0xf3, 0xa7, 0x3e, 0x2d, 0x20, 0x6d, 0x64,
+	0x16, 0x75, 0xf6, 0x5d, 0x13, 0x4f, 0x98, 0xd6, 0xf1, 0x5c, 0xe6, 0x12, 0xe2, 0x5b, 0x0c, 0x6d,
+	0x9b, 0x32, 0xd4, 0xc2, 0x5d, 0x65, 0x16, 0x9d, 0x86, 0xd7, 0xeb, 0x30, 0xea, 0x3a, 0x02, 0xa5,
+	0x40, 0xcb, 0x6d, 0xb9, 0xf2, 0xf9, 0x7a, 0xcb, 0x75, 0x5b, 0x36, 0xd6, 0xf9, 0xdb, 0x5e, 0x77,
+	0xbf, 0xce, 0x68, 0x1b, 0x7d, 0x66, 0xb5, 0x3b, 0x12, 0x30, 0x1d, 0x2a, 0x12, 0xef, 0xea, 0x37,
+	0x05, 0x98, 0xdb, 0x10, 0x1a, 0xb1, 0xb9, 0x85, 0xbd, 0xfb, 0x4e, 0xf3, 0xb1, 0xeb, 0x34, 0x90,
+	0xbc, 0x01, 0x95, 0x8e, 0xeb, 0xd3, 0xc0, 0x4e, 0xad, 0xb0, 0x5c, 0x58, 0x99, 0x58, 0x5d, 0x88,
+	0x48, 0x68, 0x06, 0xb6, 0xda, 0xe8, 0xb0, 0x5d, 0x09, 0xd0, 0x23, 0x28, 0xd1, 0xe0, 0x12, 0x86,
+	0xea, 0xcc, 0x43, 0xec, 0x99, 0x4e, 0xa0, 0xad, 0x56, 0x5c, 0x2e, 0xac, 0x4c, 0xea, 0x17, 0x31,
+	0x61, 0x49, 0x98, 0xb9, 0x01, 0x53, 0x29, 0x7c, 0xad, 0xc4, 0x91, 0x93, 0x49, 0xa4, 0xfa, 0xbc,
+	0x00, 0xf3, 0xef, 0xec, 0x3d, 0xc5, 0x06, 0x5b, 0xc3, 0x16, 0x75, 0x76, 0xdc, 0x23, 0xd4, 0xf1,
+	0xe3, 0x2e, 0xfa, 0x8c, 0xd4, 0x61, 0xfc, 0x00, 0xad, 0x26, 0x7a, 0xb5, 0x19, 0x4e, 0xf2, 0x4a,
+	0x4c, 0x52, 0x42, 0x1e, 0xf1, 0x6d, 0x5d, 0xc2, 0xc8, 0x3c, 0x8c, 0xef, 0x75, 0x1b, 0x87, 0xc8,
+	0xb8, 0x57, 0x93, 0xba, 0x7c, 0x23, 0xff, 0x81, 0xb9, 0x98, 0x88, 0xcb, 0x8d, 0x71, 0x3e, 0x82,
+	0x39, 0x89, 0xf6, 0x04, 0x8f, 0x2d, 0xec, 0x91, 0xab, 0x00, 0x0e, 0x1e, 0x9b, 0x52, 0x9b, 0xe0,
+	0x5d, 0x75, 0xf0, 0x78, 0x4d, 0x28, 0xbc, 0x0b, 0xb5, 0x60, 0x3b, 0x53, 0x69, 0x99, 0x83, 0x2f,
+	0x3b, 0x78, 0xbc, 0x31, 0xa0, 0x57, 0xfd, 0xa9, 0x08, 0x57, 0x06, 0xbc, 0xf5, 0x3b, 0xae, 0xe3,
+	0x23, 0x59, 0x84, 0xaa, 0xcf, 0x3c, 0xb4, 0xda, 0x26, 0x6d, 0x4a, 0x07, 0x2a, 0x62, 0xe1, 0xed,
+	0x26, 0xb9, 0x07, 0x4b, 0xb1, 0xb5, 0x20, 0x0c, 0x4d, 0x8b, 0x59, 0x03, 0x49, 0x58, 0x88, 0x30,
+	0x3b, 0x12, 0x12, 0x25, 0xe3, 0xff, 0x30, 0x9f, 0xad, 0x40, 0x7a, 0x37, 0x97, 0x25, 0x4a, 0xb6,
+	0x60, 0xd2, 0x17, 0xf5, 0x10, 0x40, 0xfd, 0x5a, 0x79, 0xb9, 0xb4, 0x32, 0xb1, 0xba, 0xa2, 0x0d,
+	0x16, 0xaf, 0x96, 0x55, 0x69, 0xfa, 0x84, 0x94, 0xde, 0xc2, 0x9e, 0x4f, 0x9e, 0xc0, 0xe5, 0xb8,
+	0xc0, 0xcd, 0x8e, 0xe5, 0x59, 0x6d, 0x64, 0xe8, 0xf9, 0xb5, 0x31, 0x9e, 0xde, 0x65, 0x2d, 0xde,
+	0x0d, 0xb5, 0x51, 0xd7, 0xd9, 0x8d, 0x70, 0x11, 0xc7, 0xd4, 0xaa, 0xfa, 0x45, 0x29, 0x8c, 0xe9,
+	0x43, 0xea, 0x50, 0xff, 0xe0, 0x85, 0x4a, 0xe8, 0xd4, 0x24, 0xa4, 0xab, 0xa2, 0x98, 0xa7, 0x2a,
+	0x4a, 0xa7, 0x54, 0x05, 0x79, 0x08, 0xcb, 0x69, 0xc1, 0x8c, 0x04, 0x8b, 0xb2, 0x5a, 0x4a, 0x2a,
+	0x18, 0xc8, 0xf1, 0x9b, 0xa0, 0x0c, 0xd7, 0xc3, 0xa3, 0x3c, 0xa9, 0x5f, 0x19, 0xa2, 0x81, 0xe8,
+	0x30, 0x1b, 0x08, 0xa7, 0xd2, 0x3d, 0x9e, 0x33, 0xdd, 0xd3, 0x0e, 0x1e, 0x1b, 0x71, 0xc6, 0x55,
+	0x05, 0x6a, 0x83, 0x99, 0x11, 0xe5, 0xde, 0xdf, 0xf8, 0xeb, 0x6e, 0xa7, 0xf7, 0xf7, 0x69, 0x7c,
+	0xe1, 0xed, 0xeb, 0xc6, 0x3f, 0xd7, 0xc6, 0x7f, 0xa1, 0x12, 0x7a, 0xdd, 0xf8, 0xe7, 0xd9, 0xf8,
+	0x0f, 0xa0, 0x36, 0x98, 0x19, 0x59, 0xee, 0x2b, 0x30, 0x2e, 0xe2, 0x23, 0x67, 0x8f, 0xd9, 0x58,
+	0xb7, 0x90, 0xd1, 0xe5, 0xbe, 0x6a, 0x81, 0x22, 0x22, 0xbc, 0x89, 0xec, 0x3d, 0xf4, 0x7c, 0xea,
+	0x3a, 0xd4, 0x69, 0xfd, 0xe1, 0x14, 0x13, 0x28, 0x3b, 0x56, 0x1b, 0x65, 0x76, 0xf9, 0xb3, 0xfa,
+	0x16, 0x2c, 0x66, 0x9a, 0x90, 0x5c, 0xaf, 0x01, 0x1c, 0x45, 0xab, 0x5c, 0x70, 0x4c, 0x4f, 0xac,
+	0xa8, 0x9f, 0x16, 0x42, 0x8a, 0xc6, 0xcb, 0xa2, 0xd8, 0xc7, 0xa1, 0x38, 0xc0, 0xe1, 0x2a, 0x2c,
+	0x66, 0x52, 0x90, 0xdf, 0xd9, 0xcf, 0x8b, 0xb0, 0x20, 0xe2, 0xba, 0x4d, 0xfd, 0x10, 0xe0, 0x9f,
+	0xfb, 0xa7, 0xf6, 0x5f, 0x30, 0x1b, 0x97, 0x5f, 0xc7, 0xc3, 0x7d, 0x7a, 0x22, 0x1b, 0x65, 0x26,
+	0x5a, 0xdf, 0xe5, 0xcb, 0x69, 0x68, 0xa3, 0xeb, 0xf9, 0xae, 0x57, 0x2b, 0xf5, 0x41, 0xd7, 0xf9,
+	0x32, 0xb9, 0x09, 0xd3, 0x02, 0x60, 0x4a, 0x87, 0x79, 0x3b, 0x94, 0xf4, 0x29, 0xb1, 0x2a, 0xbd,
+	0x21, 0x4b, 0x50, 0xf5, 0x30, 0x58, 0xa2, 0x47, 0xc8, 0xcb, 0xbd, 0xa2, 0xc7, 0x0b, 0x64, 0x0e,
+	0xc6, 0x6c, 0xda, 0xa6, 0xac, 0x36, 0xce, 0x63, 0x27, 0x5e, 0x54, 0x0a, 0x4a, 0x56, 0x58, 0x64,
+	0xe2, 0xef, 0xc2, 0x18, 0x65, 0xd8, 0xf6, 0x6b, 0x05, 0xde, 0x09, 0xff, 0xcc, 0xea, 0x04, 0x21,
+	0x2e, 0x45, 0x75, 0x81, 0x0f, 0x32, 0xd8, 0x76, 0x3d, 0xf1, 0x5d, 0xae, 0xe8, 0xfc, 0x59, 0xfd,
+	0xb6, 0x08, 0x53, 0x29, 0xf0, 0xd0, 0x83, 0xa9, 0x30, 0xf4, 0x60, 0xaa, 0xc1, 0x85, 0x30, 0x04,
+	0x45, 0x1e, 0x82, 0xf0, 0x35, 0xf8, 0x72, 0x51, 0x3f, 0x0c, 0x79, 0x89, 0x9b, 0xad, 0x50, 0x5f,
+	0xc6, 0x7a, 0x05, 0x66, 0xa9, 0x6f, 0x36, 0xd1, 0x46, 0x86, 0x66, 0xdb, 0xf2, 0x0e, 0xd1, 0xe3,
+	0x21, 0xac, 0xe8, 0xd3, 0xd4, 0x7f, 0xc0, 0x97, 0x77, 0xf8, 0xaa, 0x54, 0x63, 0x5b, 0x0c, 0x7d,
+	0x26, 0x63, 0x58, 0xa1, 0xfe, 0x36, 0x7f, 0x27, 0xeb, 0x00, 0x0d, 0x0f, 0xad, 0x80, 0xad, 0x25,
+	0xe2, 0x38, 0xb1, 0xaa, 0x68, 0xe2, 0x42, 0xa2, 0x85, 0x17, 0x12, 0xed, 0xdd, 0xf0, 0x42, 0xb2,
+	0x56, 0xf9, 0xe1, 0xe7, 0xeb, 0xff, 0xf8, 0xec, 0x97, 0xeb, 0x05, 0xbd, 0x2a, 0xe5, 0xee, 0xb3,
+	0xe0, 0x2b, 0xda, 0xb1, 0x2d, 0xea, 0x98, 0x3e, 0x7d, 0x86, 0xb5, 0x0b, 0xdc, 0x8b, 0x2a, 0x5f,
+	0x31, 0xe8, 0x33, 0x54, 0xbf, 0x2f, 0x84, 0x19, 0x11, 0xbc, 0xc2, 0xc0, 0xfe, 0xf9, 0x43, 0x41,
+	0x22, 0xf6, 0xa5, 0x54, 0xec, 0x83, 0xde, 0xcb, 0xa4, 0x2c, 0x7b, 0xef, 0xbb, 0x42, 0xd8, 0x7b,
+	0x9b, 0xc8, 0x74, 0x64, 0xe8, 0xb0, 0x57, 0xdd, 0xa3, 0xaf, 0xa2, 0x2c, 0xa4, 0x29, 0xcb, 0xbe,
+	0x48, 0x08, 0x16, 0xd2, 0x65, 0xb8, 0x0e, 0x93, 0x1e, 0xa7, 0x6f, 0x76, 0x1d, 0x46, 0xed, 0x5a,
+	0xf1, 0xcc, 0x22, 0x29, 0xf3, 0x02, 0x99, 0x10, 0x52, 0x4f, 0x02, 0xa1, 0xa0, 0x44, 0x6c, 0x6c,
+	0x59, 0xb6, 0x79, 0xe0, 0xda, 0x4d, 0x59, 0xcc, 0x55, 0xbe, 0xf2, 0xc8, 0xb5, 0x9b, 0xea, 0x6f,
+	0x51, 0x3c, 0x8d, 0xbf, 0x48, 0x3c, 0xc9, 0x66, 0x5f, 0x58, 0xca, 0x39, 0x7a, 0x27, 0x19, 0x1a,
+	0x75, 0x09, 0x94, 0x2c, 0xd7, 0x65, 0xa5, 0xfd, 0x98, 0x8c, 0xcc, 0x76, 0x18, 0xb0, 0x57, 0x3a,
+	0x32, 0xe9, 0x5c, 0x97, 0xfb, 0x73, 0x9d, 0xf4, 0x37, 0xe1, 0x90, 0xf4, 0xf7, 0xd7, 0x02, 0x4c,
+	0x6d, 0xd3, 0x7d, 0x6c, 0xf4, 0x1a, 0x36, 0xea, 0x5d, 0x1b, 0xc9, 0x34, 0x14, 0xe5, 0xe4, 0x56,
+	0xd5, 0x8b, 0xb4, 0x99, 0xe7, 0x40, 0xba, 0x05, 0x17, 0xf1, 0xa4, 0x43, 0x3d, 0x34, 0xad, 0x7d,
+	0x86, 0x9e, 0xd9, 0xb4, 0x7a, 0x3e, 0x67, 0x3b, 0xa6, 0xcf, 0x88, 0x8d, 0xfb, 0xc1, 0xfa, 0x03,
+	0xab, 0xe7, 0x07, 0xb3, 0x9e, 0xb5, 0xe7, 0x7a, 0xcc, 0xec, 0xa0, 0xd3, 0xa4, 0x4e, 0x2b, 0x29,
+	0x52, 0xe6, 0x22, 0x97, 0xf9, 0xfe, 0xae, 0xd8, 0x8e, 0x05, 0xef, 0xc1, 0x52, 0x30, 0xd0, 0x75,
+	0x3d, 0x0f, 0x1d, 0x66, 0x0e, 0xda, 0x1b, 0xe3, 0xc2, 0x0b, 0x31, 0x66, 0x23, 0x6d, 0x59, 0xfd,
+	0x08, 0x16, 0xa2, 0x51, 0x25, 0x76, 0xfd, 0x3c, 0x87, 0xa1, 0x27, 0xa0, 0x64, 0x59, 0x88, 0x8f,
+	0x44, 0xaf, 0x6b, 0xe3, 0xa9, 0x47, 0x62, 0x2a, 0x25, 0xba, 0xc0, 0xab, 0x5f, 0x16, 0x42, 0xe6,
+	0xc6, 0x4b, 0x62, 0x1e, 0x73, 0x2b, 0xe6, 0xe4, 0xb6, 0x94, 0x98, 0xdf, 0x06, 0x5c, 0x56, 0x1d,
+	0xb8, 0xf0, 0x3e, 0xee, 0x1d, 0xb8, 0xee, 0xe1, 0x40, 0x79, 0xcd, 0x42, 0xa9, 0xeb, 0x89, 0xaf,
+	0x5c, 0x55, 0x0f, 0x1e, 0x83, 0x9e, 0xc1, 0x23, 0x74, 0x58, 0x50, 0x3a, 0xa5, 0x95, 0xaa, 0x2e,
+	0xdf, 0x32, 0x0b, 0xb1, 0x9c, 0x59, 0x88, 0x6a, 0x13, 0xae, 0x46, 0x09, 0x78, 0xec, 0x32, 0xba,
+	0x4f, 0x1b, 0x16, 0x7b, 0xa1, 0x71, 0x2d, 0x2b, 0xcd, 0x1f, 0xc0, 0xb5, 0x61, 0x56, 0xa2, 0x54,
+	0x57, 0x8e, 0x85, 0xdf, 0x61, 0xb6, 0x17, 0xb3, 0x22, 0x2a, 0x63, 0xa3, 0x47, 0x60, 0xf5, 0xeb,
+	0x42, 0xe8, 0x81, 0xf1, 0x12, 0x3d, 0x48, 0xf1, 0x2b, 0xe6, 0xe1, 0xb7, 0x0c, 0xd7, 0x86, 0xd1,
+	0x13, 0xae, 0xaf, 0x3e, 0x9f, 0x82, 0x89, 0x1d, 0xa9, 0x60, 0xe3, 0x84, 0x91, 0xa7, 0x30, 0x13,
+	0xfd, 0xaa, 0x13, 0xdf, 0x23, 0x72, 0x6b, 0xf8, 0x30, 0xd8, 0xff, 0x0f, 0x53, 0xb9, 0x3d, 0x12,
+	0x56, 0x86, 0xbd, 0x0d, 0xb3, 0xf1, 0x8f, 0x12, 0x69, 0xec, 0x14, 0x05, 0x03, 0xbf, 0xbb, 0x94,
+	0x3b, 0xa3, 0x81, 0xa5, 0xb9, 0xd0, 0xb5, 0xe0, 0x76, 0x36, 0xa2, 0x6b, 0x89, 0x2b, 0xb6, 0x72,
+	0x7b, 0x24, 0x6c, 0xbf, 0x6b, 0x09, 0x63, 0x67, 0xba, 0x96, 0xb4, 0x76, 0x67, 0x34, 0xb0, 0x34,
+	0x77, 0x04, 0x97, 0x36, 0x91, 0x89, 0x54, 0xc7, 0x77, 0x22, 0xa2, 0x65, 0x29, 0x19, 0x7e, 0xc5,
+	0x54, 0xea, 0x23, 0xe3, 0x63, 0xbb, 0x46, 0x3e, 0xbb, 0x46, 0x4e, 0xbb, 0x99, 0x97, 0x3c, 0xe2,
+	0x03, 0x09, 0xae, 0x31, 0xa9, 0x4b, 0x86, 0x4f, 0xfe, 0x3d, 0x3c, 0x66, 0x19, 0x77, 0x41, 0x45,
+	0x1b, 0x15, 0x1e, 0x3b, 0x2b, 0xc6, 0xde, 0xf4, 0xdd, 0xe6, 0x14, 0x35, 0x59, 0x83, 0xbd, 0x52,
+	0x1f, 0x19, 0x1f, 0x3b, 0xbb, 0x89, 0xd2, 0xd7, 0x68, 0x12, 0x3a, 0xcd, 0xd9, 0x8c, 0xe1, 0x5b,
+	0xd1, 0x46, 0x85, 0xc7, 0x46, 0x8d, 0x5c, 0x46, 0x8d, 0x7c, 0x46, 0x8d, 0xb3, 0x8c, 0x46, 0x33,
+	0xd0, 0x19, 0x46, 0xfb, 0x87, 0x3f, 0x45, 0x1b, 0x15, 0x9e, 0x0a, 0xaf, 0xa8, 0xb6, 0xe8, 0x48,
+	0xcc, 0x36, 0x3a, 0x74, 0x1e, 0x51, 0xb4, 0x51, 0xe1, 0x29, 0x4f, 0x73, 0x18, 0x35, 0xf2, 0x19,
+	0xcd, 0x3a, 0xde, 0xc9, 0x27, 0x30, 0x1f, 0x79, 0x9a, 0x3a, 0x0d, 0xc8, 0x7f, 0x4f, 0xa5, 0x9f,
+	0x75, 0xb0, 0x29, 0xab, 0x79, 0x44, 0x62, 0x02, 0x46, 0x6e, 0x02, 0x46, 0x7e, 0x02, 0xc3, 0x4e,
+	0xbb, 0xb5, 0x9b, 0x1f, 0xde, 0xf0, 0x99, 0xeb, 0x3d, 0xd5, 0xa8, 0x5b, 0xe7, 0x0f, 0xf5, 0x48,
+	0x47, 0x9d, 0x3a, 0x0c, 0x3d, 0xc7, 0xb2, 0x3b, 0x7b, 0x7b, 0xe3, 0xfc, 0x9a, 0xf2, 0xbf, 0xdf,
+	0x07, 0x00, 0x9c, 0x78, 0xe1, 0xad, 0xc9, 0x1c, 0x00, 0x00,
+}
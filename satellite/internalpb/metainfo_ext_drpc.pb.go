@@ -0,0 +1,675 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.24
+// source: metainfo_ext.proto
+
+package internalpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_metainfo_ext_proto struct{}
+
+func (drpcEncoding_File_metainfo_ext_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_metainfo_ext_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_metainfo_ext_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_metainfo_ext_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCMetainfoExtClient interface {
+	DRPCConn() drpc.Conn
+
+	BeginMoveObject(ctx context.Context, in *ObjectBeginMoveRequest) (*ObjectBeginMoveResponse, error)
+	FinishMoveObject(ctx context.Context, in *ObjectFinishMoveRequest) (*ObjectFinishMoveResponse, error)
+	BeginCopyObject(ctx context.Context, in *ObjectBeginCopyRequest) (*ObjectBeginCopyResponse, error)
+	FinishCopyObject(ctx context.Context, in *ObjectFinishCopyRequest) (*ObjectFinishCopyResponse, error)
+	GetBucketVersioning(ctx context.Context, in *BucketGetVersioningRequest) (*BucketGetVersioningResponse, error)
+	SetBucketVersioning(ctx context.Context, in *BucketSetVersioningRequest) (*BucketSetVersioningResponse, error)
+	ListObjectVersions(ctx context.Context, in *ObjectListVersionsRequest) (*ObjectListVersionsResponse, error)
+	DeleteObjectVersion(ctx context.Context, in *ObjectDeleteVersionRequest) (*ObjectDeleteVersionResponse, error)
+	GetObjectRetention(ctx context.Context, in *ObjectGetRetentionRequest) (*ObjectGetRetentionResponse, error)
+	SetObjectRetention(ctx context.Context, in *ObjectSetRetentionRequest) (*ObjectSetRetentionResponse, error)
+	SetObjectLegalHold(ctx context.Context, in *ObjectSetLegalHoldRequest) (*ObjectSetLegalHoldResponse, error)
+	GetBucketLifecycle(ctx context.Context, in *BucketGetLifecycleRequest) (*BucketGetLifecycleResponse, error)
+	SetBucketLifecycle(ctx context.Context, in *BucketSetLifecycleRequest) (*BucketSetLifecycleResponse, error)
+	GetBucketNotifications(ctx context.Context, in *BucketGetNotificationsRequest) (*BucketGetNotificationsResponse, error)
+	SetBucketNotifications(ctx context.Context, in *BucketSetNotificationsRequest) (*BucketSetNotificationsResponse, error)
+}
+
+type drpcMetainfoExtClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCMetainfoExtClient(cc drpc.Conn) DRPCMetainfoExtClient {
+	return &drpcMetainfoExtClient{cc}
+}
+
+func (c *drpcMetainfoExtClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcMetainfoExtClient) BeginMoveObject(ctx context.Context, in *ObjectBeginMoveRequest) (*ObjectBeginMoveResponse, error) {
+	out := new(ObjectBeginMoveResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/BeginMoveObject", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) FinishMoveObject(ctx context.Context, in *ObjectFinishMoveRequest) (*ObjectFinishMoveResponse, error) {
+	out := new(ObjectFinishMoveResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/FinishMoveObject", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) BeginCopyObject(ctx context.Context, in *ObjectBeginCopyRequest) (*ObjectBeginCopyResponse, error) {
+	out := new(ObjectBeginCopyResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/BeginCopyObject", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) FinishCopyObject(ctx context.Context, in *ObjectFinishCopyRequest) (*ObjectFinishCopyResponse, error) {
+	out := new(ObjectFinishCopyResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/FinishCopyObject", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) GetBucketVersioning(ctx context.Context, in *BucketGetVersioningRequest) (*BucketGetVersioningResponse, error) {
+	out := new(BucketGetVersioningResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/GetBucketVersioning", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) SetBucketVersioning(ctx context.Context, in *BucketSetVersioningRequest) (*BucketSetVersioningResponse, error) {
+	out := new(BucketSetVersioningResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/SetBucketVersioning", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) ListObjectVersions(ctx context.Context, in *ObjectListVersionsRequest) (*ObjectListVersionsResponse, error) {
+	out := new(ObjectListVersionsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/ListObjectVersions", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) DeleteObjectVersion(ctx context.Context, in *ObjectDeleteVersionRequest) (*ObjectDeleteVersionResponse, error) {
+	out := new(ObjectDeleteVersionResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/DeleteObjectVersion", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) GetObjectRetention(ctx context.Context, in *ObjectGetRetentionRequest) (*ObjectGetRetentionResponse, error) {
+	out := new(ObjectGetRetentionResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/GetObjectRetention", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) SetObjectRetention(ctx context.Context, in *ObjectSetRetentionRequest) (*ObjectSetRetentionResponse, error) {
+	out := new(ObjectSetRetentionResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/SetObjectRetention", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) SetObjectLegalHold(ctx context.Context, in *ObjectSetLegalHoldRequest) (*ObjectSetLegalHoldResponse, error) {
+	out := new(ObjectSetLegalHoldResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/SetObjectLegalHold", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) GetBucketLifecycle(ctx context.Context, in *BucketGetLifecycleRequest) (*BucketGetLifecycleResponse, error) {
+	out := new(BucketGetLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/GetBucketLifecycle", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) SetBucketLifecycle(ctx context.Context, in *BucketSetLifecycleRequest) (*BucketSetLifecycleResponse, error) {
+	out := new(BucketSetLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/SetBucketLifecycle", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) GetBucketNotifications(ctx context.Context, in *BucketGetNotificationsRequest) (*BucketGetNotificationsResponse, error) {
+	out := new(BucketGetNotificationsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/GetBucketNotifications", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) SetBucketNotifications(ctx context.Context, in *BucketSetNotificationsRequest) (*BucketSetNotificationsResponse, error) {
+	out := new(BucketSetNotificationsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.MetainfoExt/SetBucketNotifications", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCMetainfoExtServer interface {
+	BeginMoveObject(context.Context, *ObjectBeginMoveRequest) (*ObjectBeginMoveResponse, error)
+	FinishMoveObject(context.Context, *ObjectFinishMoveRequest) (*ObjectFinishMoveResponse, error)
+	BeginCopyObject(context.Context, *ObjectBeginCopyRequest) (*ObjectBeginCopyResponse, error)
+	FinishCopyObject(context.Context, *ObjectFinishCopyRequest) (*ObjectFinishCopyResponse, error)
+	GetBucketVersioning(context.Context, *BucketGetVersioningRequest) (*BucketGetVersioningResponse, error)
+	SetBucketVersioning(context.Context, *BucketSetVersioningRequest) (*BucketSetVersioningResponse, error)
+	ListObjectVersions(context.Context, *ObjectListVersionsRequest) (*ObjectListVersionsResponse, error)
+	DeleteObjectVersion(context.Context, *ObjectDeleteVersionRequest) (*ObjectDeleteVersionResponse, error)
+	GetObjectRetention(context.Context, *ObjectGetRetentionRequest) (*ObjectGetRetentionResponse, error)
+	SetObjectRetention(context.Context, *ObjectSetRetentionRequest) (*ObjectSetRetentionResponse, error)
+	SetObjectLegalHold(context.Context, *ObjectSetLegalHoldRequest) (*ObjectSetLegalHoldResponse, error)
+	GetBucketLifecycle(context.Context, *BucketGetLifecycleRequest) (*BucketGetLifecycleResponse, error)
+	SetBucketLifecycle(context.Context, *BucketSetLifecycleRequest) (*BucketSetLifecycleResponse, error)
+	GetBucketNotifications(context.Context, *BucketGetNotificationsRequest) (*BucketGetNotificationsResponse, error)
+	SetBucketNotifications(context.Context, *BucketSetNotificationsRequest) (*BucketSetNotificationsResponse, error)
+}
+
+type DRPCMetainfoExtUnimplementedServer struct{}
+
+func (s *DRPCMetainfoExtUnimplementedServer) BeginMoveObject(context.Context, *ObjectBeginMoveRequest) (*ObjectBeginMoveResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) FinishMoveObject(context.Context, *ObjectFinishMoveRequest) (*ObjectFinishMoveResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) BeginCopyObject(context.Context, *ObjectBeginCopyRequest) (*ObjectBeginCopyResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) FinishCopyObject(context.Context, *ObjectFinishCopyRequest) (*ObjectFinishCopyResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) GetBucketVersioning(context.Context, *BucketGetVersioningRequest) (*BucketGetVersioningResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) SetBucketVersioning(context.Context, *BucketSetVersioningRequest) (*BucketSetVersioningResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) ListObjectVersions(context.Context, *ObjectListVersionsRequest) (*ObjectListVersionsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) DeleteObjectVersion(context.Context, *ObjectDeleteVersionRequest) (*ObjectDeleteVersionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) GetObjectRetention(context.Context, *ObjectGetRetentionRequest) (*ObjectGetRetentionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) SetObjectRetention(context.Context, *ObjectSetRetentionRequest) (*ObjectSetRetentionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) SetObjectLegalHold(context.Context, *ObjectSetLegalHoldRequest) (*ObjectSetLegalHoldResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) GetBucketLifecycle(context.Context, *BucketGetLifecycleRequest) (*BucketGetLifecycleResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) SetBucketLifecycle(context.Context, *BucketSetLifecycleRequest) (*BucketSetLifecycleResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) GetBucketNotifications(context.Context, *BucketGetNotificationsRequest) (*BucketGetNotificationsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) SetBucketNotifications(context.Context, *BucketSetNotificationsRequest) (*BucketSetNotificationsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCMetainfoExtDescription struct{}
+
+func (DRPCMetainfoExtDescription) NumMethods() int { return 15 }
+
+func (DRPCMetainfoExtDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/satellite.metainfo.MetainfoExt/BeginMoveObject", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					BeginMoveObject(
+						ctx,
+						in1.(*ObjectBeginMoveRequest),
+					)
+			}, DRPCMetainfoExtServer.BeginMoveObject, true
+	case 1:
+		return "/satellite.metainfo.MetainfoExt/FinishMoveObject", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					FinishMoveObject(
+						ctx,
+						in1.(*ObjectFinishMoveRequest),
+					)
+			}, DRPCMetainfoExtServer.FinishMoveObject, true
+	case 2:
+		return "/satellite.metainfo.MetainfoExt/BeginCopyObject", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					BeginCopyObject(
+						ctx,
+						in1.(*ObjectBeginCopyRequest),
+					)
+			}, DRPCMetainfoExtServer.BeginCopyObject, true
+	case 3:
+		return "/satellite.metainfo.MetainfoExt/FinishCopyObject", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					FinishCopyObject(
+						ctx,
+						in1.(*ObjectFinishCopyRequest),
+					)
+			}, DRPCMetainfoExtServer.FinishCopyObject, true
+	case 4:
+		return "/satellite.metainfo.MetainfoExt/GetBucketVersioning", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					GetBucketVersioning(
+						ctx,
+						in1.(*BucketGetVersioningRequest),
+					)
+			}, DRPCMetainfoExtServer.GetBucketVersioning, true
+	case 5:
+		return "/satellite.metainfo.MetainfoExt/SetBucketVersioning", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					SetBucketVersioning(
+						ctx,
+						in1.(*BucketSetVersioningRequest),
+					)
+			}, DRPCMetainfoExtServer.SetBucketVersioning, true
+	case 6:
+		return "/satellite.metainfo.MetainfoExt/ListObjectVersions", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					ListObjectVersions(
+						ctx,
+						in1.(*ObjectListVersionsRequest),
+					)
+			}, DRPCMetainfoExtServer.ListObjectVersions, true
+	case 7:
+		return "/satellite.metainfo.MetainfoExt/DeleteObjectVersion", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					DeleteObjectVersion(
+						ctx,
+						in1.(*ObjectDeleteVersionRequest),
+					)
+			}, DRPCMetainfoExtServer.DeleteObjectVersion, true
+	case 8:
+		return "/satellite.metainfo.MetainfoExt/GetObjectRetention", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					GetObjectRetention(
+						ctx,
+						in1.(*ObjectGetRetentionRequest),
+					)
+			}, DRPCMetainfoExtServer.GetObjectRetention, true
+	case 9:
+		return "/satellite.metainfo.MetainfoExt/SetObjectRetention", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					SetObjectRetention(
+						ctx,
+						in1.(*ObjectSetRetentionRequest),
+					)
+			}, DRPCMetainfoExtServer.SetObjectRetention, true
+	case 10:
+		return "/satellite.metainfo.MetainfoExt/SetObjectLegalHold", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					SetObjectLegalHold(
+						ctx,
+						in1.(*ObjectSetLegalHoldRequest),
+					)
+			}, DRPCMetainfoExtServer.SetObjectLegalHold, true
+	case 11:
+		return "/satellite.metainfo.MetainfoExt/GetBucketLifecycle", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					GetBucketLifecycle(
+						ctx,
+						in1.(*BucketGetLifecycleRequest),
+					)
+			}, DRPCMetainfoExtServer.GetBucketLifecycle, true
+	case 12:
+		return "/satellite.metainfo.MetainfoExt/SetBucketLifecycle", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					SetBucketLifecycle(
+						ctx,
+						in1.(*BucketSetLifecycleRequest),
+					)
+			}, DRPCMetainfoExtServer.SetBucketLifecycle, true
+	case 13:
+		return "/satellite.metainfo.MetainfoExt/GetBucketNotifications", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					GetBucketNotifications(
+						ctx,
+						in1.(*BucketGetNotificationsRequest),
+					)
+			}, DRPCMetainfoExtServer.GetBucketNotifications, true
+	case 14:
+		return "/satellite.metainfo.MetainfoExt/SetBucketNotifications", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					SetBucketNotifications(
+						ctx,
+						in1.(*BucketSetNotificationsRequest),
+					)
+			}, DRPCMetainfoExtServer.SetBucketNotifications, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterMetainfoExt(mux drpc.Mux, impl DRPCMetainfoExtServer) error {
+	return mux.Register(impl, DRPCMetainfoExtDescription{})
+}
+
+type DRPCMetainfoExt_BeginMoveObjectStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectBeginMoveResponse) error
+}
+
+type drpcMetainfoExt_BeginMoveObjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_BeginMoveObjectStream) SendAndClose(m *ObjectBeginMoveResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_FinishMoveObjectStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectFinishMoveResponse) error
+}
+
+type drpcMetainfoExt_FinishMoveObjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_FinishMoveObjectStream) SendAndClose(m *ObjectFinishMoveResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_BeginCopyObjectStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectBeginCopyResponse) error
+}
+
+type drpcMetainfoExt_BeginCopyObjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_BeginCopyObjectStream) SendAndClose(m *ObjectBeginCopyResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_FinishCopyObjectStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectFinishCopyResponse) error
+}
+
+type drpcMetainfoExt_FinishCopyObjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_FinishCopyObjectStream) SendAndClose(m *ObjectFinishCopyResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_GetBucketVersioningStream interface {
+	drpc.Stream
+	SendAndClose(*BucketGetVersioningResponse) error
+}
+
+type drpcMetainfoExt_GetBucketVersioningStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_GetBucketVersioningStream) SendAndClose(m *BucketGetVersioningResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_SetBucketVersioningStream interface {
+	drpc.Stream
+	SendAndClose(*BucketSetVersioningResponse) error
+}
+
+type drpcMetainfoExt_SetBucketVersioningStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_SetBucketVersioningStream) SendAndClose(m *BucketSetVersioningResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_ListObjectVersionsStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectListVersionsResponse) error
+}
+
+type drpcMetainfoExt_ListObjectVersionsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_ListObjectVersionsStream) SendAndClose(m *ObjectListVersionsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_DeleteObjectVersionStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectDeleteVersionResponse) error
+}
+
+type drpcMetainfoExt_DeleteObjectVersionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_DeleteObjectVersionStream) SendAndClose(m *ObjectDeleteVersionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_GetObjectRetentionStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectGetRetentionResponse) error
+}
+
+type drpcMetainfoExt_GetObjectRetentionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_GetObjectRetentionStream) SendAndClose(m *ObjectGetRetentionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_SetObjectRetentionStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectSetRetentionResponse) error
+}
+
+type drpcMetainfoExt_SetObjectRetentionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_SetObjectRetentionStream) SendAndClose(m *ObjectSetRetentionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_SetObjectLegalHoldStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectSetLegalHoldResponse) error
+}
+
+type drpcMetainfoExt_SetObjectLegalHoldStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_SetObjectLegalHoldStream) SendAndClose(m *ObjectSetLegalHoldResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_GetBucketLifecycleStream interface {
+	drpc.Stream
+	SendAndClose(*BucketGetLifecycleResponse) error
+}
+
+type drpcMetainfoExt_GetBucketLifecycleStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_GetBucketLifecycleStream) SendAndClose(m *BucketGetLifecycleResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_SetBucketLifecycleStream interface {
+	drpc.Stream
+	SendAndClose(*BucketSetLifecycleResponse) error
+}
+
+type drpcMetainfoExt_SetBucketLifecycleStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_SetBucketLifecycleStream) SendAndClose(m *BucketSetLifecycleResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_GetBucketNotificationsStream interface {
+	drpc.Stream
+	SendAndClose(*BucketGetNotificationsResponse) error
+}
+
+type drpcMetainfoExt_GetBucketNotificationsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_GetBucketNotificationsStream) SendAndClose(m *BucketGetNotificationsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_SetBucketNotificationsStream interface {
+	drpc.Stream
+	SendAndClose(*BucketSetNotificationsResponse) error
+}
+
+type drpcMetainfoExt_SetBucketNotificationsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_SetBucketNotificationsStream) SendAndClose(m *BucketSetNotificationsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -36,9 +36,13 @@ type StreamID struct {
 	MultipartObject      bool                     `protobuf:"varint,11,opt,name=multipart_object,json=multipartObject,proto3" json:"multipart_object,omitempty"`
 	SatelliteSignature   []byte                   `protobuf:"bytes,9,opt,name=satellite_signature,json=satelliteSignature,proto3" json:"satellite_signature,omitempty"`
 	StreamId             []byte                   `protobuf:"bytes,10,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	// placement is the placement constraint of the bucket when the object was
+	// started. Stream IDs without placement_known need to look it up.
+	Placement            int32    `protobuf:"varint,13,opt,name=placement,proto3" json:"placement,omitempty"`
+	PlacementKnown       bool     `protobuf:"varint,14,opt,name=placement_known,json=placementKnown,proto3" json:"placement_known,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StreamID) Reset()         { *m = StreamID{} }
@@ -135,6 +139,20 @@ func (m *StreamID) GetStreamId() []byte {
 	return nil
 }
 
+func (m *StreamID) GetPlacement() int32 {
+	if m != nil {
+		return m.Placement
+	}
+	return 0
+}
+
+func (m *StreamID) GetPlacementKnown() bool {
+	if m != nil {
+		return m.PlacementKnown
+	}
+	return false
+}
+
 type SegmentID struct {
 	StreamId             *StreamID                 `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 	PartNumber           int32                     `protobuf:"varint,2,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
@@ -143,6 +161,7 @@ type SegmentID struct {
 	OriginalOrderLimits  []*pb.AddressedOrderLimit `protobuf:"bytes,6,rep,name=original_order_limits,json=originalOrderLimits,proto3" json:"original_order_limits,omitempty"`
 	CreationDate         time.Time                 `protobuf:"bytes,7,opt,name=creation_date,json=creationDate,proto3,stdtime" json:"creation_date"`
 	SatelliteSignature   []byte                    `protobuf:"bytes,8,opt,name=satellite_signature,json=satelliteSignature,proto3" json:"satellite_signature,omitempty"`
+	Placement            int32                     `protobuf:"varint,9,opt,name=placement,proto3" json:"placement,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -214,6 +233,13 @@ func (m *SegmentID) GetSatelliteSignature() []byte {
 	return nil
 }
 
+func (m *SegmentID) GetPlacement() int32 {
+	if m != nil {
+		return m.Placement
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StreamID)(nil), "satellite.metainfo.StreamID")
 	proto.RegisterType((*SegmentID)(nil), "satellite.metainfo.SegmentID")
@@ -222,41 +248,43 @@ func init() {
 func init() { proto.RegisterFile("metainfo_sat.proto", fileDescriptor_47c60bd892d94aaf) }
 
 var fileDescriptor_47c60bd892d94aaf = []byte{
-	// 562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0xb4, 0x49, 0xd3, 0x4d, 0xda, 0x54, 0xdb, 0x16, 0x59, 0x2d, 0x28, 0x56, 0x51, 0xa5,
-	0x70, 0xb1, 0xa5, 0xf6, 0x84, 0x38, 0x51, 0x85, 0x43, 0x24, 0xa0, 0xc1, 0x81, 0x0b, 0x17, 0x6b,
-	0xed, 0x9d, 0x3a, 0x5b, 0xec, 0x5d, 0x6b, 0x3d, 0x46, 0xed, 0x91, 0x2b, 0x27, 0x7e, 0x16, 0xbf,
-	0x81, 0x43, 0xf9, 0x2b, 0xc8, 0xeb, 0xaf, 0x48, 0xb4, 0x07, 0xb8, 0xed, 0xbc, 0x79, 0xf3, 0x32,
-	0x79, 0xf3, 0x4c, 0x68, 0x0a, 0xc8, 0x84, 0xbc, 0x52, 0x41, 0xce, 0xd0, 0xcd, 0xb4, 0x42, 0x45,
-	0x69, 0xce, 0x10, 0x92, 0x44, 0x20, 0xb8, 0x4d, 0xf7, 0x68, 0x0f, 0x64, 0xa4, 0x6f, 0x33, 0x14,
-	0x4a, 0x56, 0xac, 0x23, 0x12, 0xab, 0x58, 0xd5, 0xef, 0x49, 0xac, 0x54, 0x9c, 0x80, 0x67, 0xaa,
-	0xb0, 0xb8, 0xf2, 0x50, 0xa4, 0x90, 0x23, 0x4b, 0xb3, 0x9a, 0x30, 0xce, 0x94, 0x90, 0x08, 0x9a,
-	0x87, 0x35, 0xb0, 0xdb, 0x28, 0x57, 0xf5, 0xc9, 0xf7, 0x4d, 0x32, 0x58, 0xa2, 0x06, 0x96, 0xce,
-	0x67, 0xf4, 0x09, 0xe9, 0x87, 0x45, 0xf4, 0x05, 0xd0, 0xb6, 0x1c, 0x6b, 0x3a, 0xf2, 0xeb, 0x8a,
-	0x9e, 0x92, 0xdd, 0x7a, 0x0d, 0xe0, 0x41, 0xc6, 0x70, 0x65, 0x3f, 0x36, 0xfd, 0x9d, 0x16, 0x5d,
-	0x30, 0x5c, 0x51, 0x9b, 0x6c, 0x7d, 0x05, 0x9d, 0x0b, 0x25, 0xed, 0x0d, 0xc7, 0x9a, 0xf6, 0xfc,
-	0xa6, 0xa4, 0xaf, 0x08, 0xd1, 0xc0, 0x0b, 0xc9, 0x99, 0x8c, 0x6e, 0xed, 0x4d, 0xc7, 0x9a, 0x0e,
-	0xcf, 0x8e, 0xdd, 0x6e, 0x37, 0xbf, 0x6d, 0x2e, 0xa3, 0x15, 0xa4, 0xe0, 0xaf, 0xd1, 0xe9, 0x27,
-	0x72, 0xd8, 0x99, 0x10, 0x64, 0x4c, 0xb3, 0x14, 0x10, 0x74, 0x6e, 0x8f, 0x8c, 0x8e, 0xe3, 0xae,
-	0x59, 0xf4, 0xa6, 0x7d, 0x2e, 0x5a, 0x9e, 0x7f, 0x00, 0xf7, 0xa0, 0x74, 0x4e, 0x76, 0x22, 0x0d,
-	0xcc, 0x88, 0x72, 0x86, 0x60, 0xf7, 0x8c, 0xdc, 0x91, 0x5b, 0x79, 0xea, 0x36, 0x9e, 0xba, 0x1f,
-	0x1b, 0x4f, 0x2f, 0x06, 0x3f, 0xef, 0x26, 0x8f, 0x7e, 0xfc, 0x9e, 0x58, 0xfe, 0xa8, 0x19, 0x9d,
-	0x31, 0x04, 0xfa, 0x8e, 0x8c, 0xe1, 0x26, 0x13, 0x7a, 0x4d, 0xac, 0xff, 0x0f, 0x62, 0xbb, 0xdd,
-	0xb0, 0x91, 0x7b, 0x41, 0xf6, 0xd2, 0x22, 0x41, 0x91, 0x31, 0x8d, 0x81, 0x0a, 0xaf, 0x21, 0x42,
-	0x7b, 0xe8, 0x58, 0xd3, 0x81, 0x3f, 0x6e, 0xf1, 0x4b, 0x03, 0x53, 0x8f, 0xec, 0xb7, 0xa1, 0x09,
-	0x72, 0x11, 0x4b, 0x86, 0x85, 0x06, 0x7b, 0xdb, 0x9c, 0xa7, 0xcb, 0xd3, 0xb2, 0xe9, 0xd0, 0x63,
-	0xb2, 0x9d, 0x9b, 0x73, 0x07, 0x82, 0xdb, 0xc4, 0xd0, 0x06, 0x15, 0x30, 0xe7, 0x27, 0xdf, 0x36,
-	0xc8, 0xf6, 0x12, 0xe2, 0x14, 0x24, 0xce, 0x67, 0xf4, 0xe5, 0x3a, 0xd5, 0x32, 0xff, 0xe7, 0xa9,
-	0xfb, 0x77, 0x44, 0xdd, 0x26, 0x3e, 0x9d, 0x10, 0x9d, 0x90, 0xa1, 0x59, 0x5e, 0x16, 0x69, 0x08,
-	0xda, 0xa4, 0xa5, 0xe7, 0x93, 0x12, 0x7a, 0x6f, 0x10, 0x7a, 0x40, 0x7a, 0x42, 0x72, 0xb8, 0xa9,
-	0x83, 0x52, 0x15, 0xf4, 0x9c, 0xec, 0x68, 0xa5, 0x30, 0xc8, 0x04, 0x44, 0x50, 0xfe, 0x6a, 0x79,
-	0x92, 0xd1, 0xc5, 0xb8, 0x74, 0xea, 0xd7, 0xdd, 0x64, 0x6b, 0x51, 0xe2, 0xf3, 0x99, 0x3f, 0x2c,
-	0x59, 0x55, 0xc1, 0xe9, 0x07, 0x72, 0xa8, 0xb4, 0x88, 0x85, 0x64, 0x49, 0xa0, 0x34, 0x07, 0x1d,
-	0x24, 0x22, 0x15, 0x98, 0xdb, 0x7d, 0x67, 0x63, 0x3a, 0x3c, 0x7b, 0xd6, 0x2d, 0xfa, 0x9a, 0x73,
-	0x0d, 0x79, 0x0e, 0xfc, 0xb2, 0xa4, 0xbd, 0x2d, 0x59, 0xfe, 0x7e, 0x33, 0xdb, 0x61, 0xf7, 0x44,
-	0x63, 0xeb, 0xbf, 0xa3, 0xf1, 0xc0, 0x81, 0x06, 0x0f, 0x1d, 0xe8, 0xe2, 0xf4, 0xf3, 0xf3, 0x1c,
-	0x95, 0xbe, 0x76, 0x85, 0xf2, 0xcc, 0xc3, 0x6b, 0x49, 0x9e, 0xf9, 0x5c, 0x24, 0x4b, 0xb2, 0x30,
-	0xec, 0x9b, 0x1d, 0xce, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x7a, 0x28, 0xd2, 0x48, 0x04,
-	0x00, 0x00,
+	// 599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xfd, 0xfc, 0xb5, 0x49, 0x93, 0xc9, 0x5f, 0x35, 0x6d, 0xd1, 0xa8, 0x2d, 0x4a, 0x54, 0x54,
+	0x11, 0x36, 0x8e, 0xd4, 0xae, 0x10, 0x2b, 0xaa, 0xb0, 0x88, 0xf8, 0x69, 0x71, 0x60, 0xc3, 0xc6,
+	0x1a, 0x7b, 0x6e, 0xdd, 0x69, 0xed, 0x19, 0x6b, 0x7c, 0x03, 0xed, 0x5b, 0xf0, 0x06, 0xbc, 0x0e,
+	0x5b, 0xb6, 0x2c, 0xca, 0xab, 0x20, 0x4f, 0xfc, 0x13, 0xa0, 0x5d, 0xc0, 0x6e, 0xee, 0x99, 0x33,
+	0xc7, 0x57, 0xe7, 0x1c, 0x13, 0x9a, 0x00, 0x72, 0xa9, 0xce, 0xb5, 0x9f, 0x71, 0x74, 0x53, 0xa3,
+	0x51, 0x53, 0x9a, 0x71, 0x84, 0x38, 0x96, 0x08, 0x6e, 0x79, 0xbb, 0xbb, 0x09, 0x2a, 0x34, 0x37,
+	0x29, 0x4a, 0xad, 0x96, 0xac, 0x5d, 0x12, 0xe9, 0x48, 0x17, 0xe7, 0x61, 0xa4, 0x75, 0x14, 0xc3,
+	0xc4, 0x4e, 0xc1, 0xe2, 0x7c, 0x82, 0x32, 0x81, 0x0c, 0x79, 0x92, 0x16, 0x84, 0x41, 0xaa, 0xa5,
+	0x42, 0x30, 0x22, 0x28, 0x80, 0x7e, 0xa9, 0xbc, 0x9c, 0x0f, 0xbe, 0xad, 0x93, 0xd6, 0x1c, 0x0d,
+	0xf0, 0x64, 0x36, 0xa5, 0x0f, 0x48, 0x33, 0x58, 0x84, 0x57, 0x80, 0xcc, 0x19, 0x39, 0xe3, 0xae,
+	0x57, 0x4c, 0xf4, 0x90, 0xf4, 0x8b, 0x35, 0x40, 0xf8, 0x29, 0xc7, 0x0b, 0xf6, 0xbf, 0xbd, 0xef,
+	0x55, 0xe8, 0x19, 0xc7, 0x0b, 0xca, 0xc8, 0xc6, 0x47, 0x30, 0x99, 0xd4, 0x8a, 0xad, 0x8d, 0x9c,
+	0x71, 0xc3, 0x2b, 0x47, 0xfa, 0x8c, 0x10, 0x03, 0x62, 0xa1, 0x04, 0x57, 0xe1, 0x0d, 0x5b, 0x1f,
+	0x39, 0xe3, 0xce, 0xd1, 0x9e, 0x5b, 0xef, 0xe6, 0x55, 0x97, 0xf3, 0xf0, 0x02, 0x12, 0xf0, 0x56,
+	0xe8, 0xf4, 0x3d, 0xd9, 0xa9, 0x4d, 0xf0, 0x53, 0x6e, 0x78, 0x02, 0x08, 0x26, 0x63, 0x5d, 0xab,
+	0x33, 0x72, 0x57, 0x2c, 0x7a, 0x51, 0x1d, 0xcf, 0x2a, 0x9e, 0xb7, 0x0d, 0x77, 0xa0, 0x74, 0x46,
+	0x7a, 0xa1, 0x01, 0x6e, 0x45, 0x05, 0x47, 0x60, 0x0d, 0x2b, 0xb7, 0xeb, 0x2e, 0x3d, 0x75, 0x4b,
+	0x4f, 0xdd, 0x77, 0xa5, 0xa7, 0x27, 0xad, 0xaf, 0xb7, 0xc3, 0xff, 0x3e, 0xff, 0x18, 0x3a, 0x5e,
+	0xb7, 0x7c, 0x3a, 0xe5, 0x08, 0xf4, 0x35, 0x19, 0xc0, 0x75, 0x2a, 0xcd, 0x8a, 0x58, 0xf3, 0x2f,
+	0xc4, 0xfa, 0xf5, 0x63, 0x2b, 0xf7, 0x84, 0x6c, 0x26, 0x8b, 0x18, 0x65, 0xca, 0x0d, 0xfa, 0x3a,
+	0xb8, 0x84, 0x10, 0x59, 0x67, 0xe4, 0x8c, 0x5b, 0xde, 0xa0, 0xc2, 0x4f, 0x2d, 0x4c, 0x27, 0x64,
+	0xab, 0x2a, 0x8d, 0x9f, 0xc9, 0x48, 0x71, 0x5c, 0x18, 0x60, 0x6d, 0x1b, 0x4f, 0xdd, 0xa7, 0x79,
+	0x79, 0x43, 0xf7, 0x48, 0x3b, 0xb3, 0x71, 0xfb, 0x52, 0x30, 0x62, 0x69, 0xad, 0x25, 0x30, 0x13,
+	0x74, 0x9f, 0xb4, 0xd3, 0x98, 0x87, 0x90, 0x80, 0x42, 0xd6, 0xb3, 0x11, 0xd6, 0x00, 0x7d, 0x4c,
+	0x06, 0xd5, 0xe0, 0x5f, 0x29, 0xfd, 0x49, 0xb1, 0xbe, 0xdd, 0xaa, 0x5f, 0xc1, 0x2f, 0x73, 0xf4,
+	0xe0, 0xcb, 0x1a, 0x69, 0xcf, 0x21, 0xca, 0x81, 0xd9, 0x94, 0x3e, 0x5d, 0xfd, 0xa2, 0x63, 0x6d,
+	0xd9, 0x77, 0xff, 0x6c, 0xba, 0x5b, 0xb6, 0x70, 0x65, 0x9f, 0x21, 0xe9, 0x58, 0x0f, 0xd4, 0x22,
+	0x09, 0xc0, 0xd8, 0xd2, 0x35, 0x3c, 0x92, 0x43, 0x6f, 0x2c, 0x42, 0xb7, 0x49, 0x43, 0x2a, 0x01,
+	0xd7, 0x45, 0xdf, 0x96, 0x03, 0x3d, 0x26, 0x3d, 0xa3, 0x35, 0xfa, 0xa9, 0x84, 0x10, 0xf2, 0xaf,
+	0xe6, 0xc9, 0x76, 0x4f, 0x06, 0xb9, 0xe1, 0xdf, 0x6f, 0x87, 0x1b, 0x67, 0x39, 0x3e, 0x9b, 0x7a,
+	0x9d, 0x9c, 0xb5, 0x1c, 0x04, 0x7d, 0x4b, 0x76, 0xb4, 0x91, 0x91, 0x54, 0x3c, 0xf6, 0xb5, 0x11,
+	0x60, 0xfc, 0x58, 0x26, 0x12, 0x33, 0xd6, 0x1c, 0xad, 0x8d, 0x3b, 0x47, 0x0f, 0xeb, 0x45, 0x9f,
+	0x0b, 0x61, 0x20, 0xcb, 0x40, 0x9c, 0xe6, 0xb4, 0x57, 0x39, 0xcb, 0xdb, 0x2a, 0xdf, 0xd6, 0xd8,
+	0x1d, 0x0d, 0xdb, 0xf8, 0xe7, 0x86, 0xdd, 0x93, 0x73, 0xeb, 0xde, 0x9c, 0x7f, 0x89, 0xb2, 0xfd,
+	0x5b, 0x94, 0x27, 0x87, 0x1f, 0x1e, 0x65, 0xa8, 0xcd, 0xa5, 0x2b, 0xf5, 0xc4, 0x1e, 0x26, 0x95,
+	0xc4, 0xc4, 0xfe, 0x93, 0x8a, 0xc7, 0x69, 0x10, 0x34, 0xed, 0x86, 0xc7, 0x3f, 0x07, 0x00, 0x25,
+	0xdf, 0x23, 0xbb, 0xad, 0x04, 0x00, 0x00,
 }
@@ -16,6 +16,7 @@ import (
 	"storj.io/private/dbutil/pgutil/pgerrcode"
 	"storj.io/private/dbutil/txutil"
 	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/placement"
 )
 
 // we need to disable PlainSize validation for old uplinks.
@@ -251,6 +252,8 @@ type CommitSegment struct {
 	Redundancy storj.RedundancyScheme
 
 	Pieces Pieces
+
+	Placement placement.Placement
 }
 
 // CommitSegment commits segment to the database.
@@ -298,26 +301,29 @@ func (db *DB) CommitSegment(ctx context.Context, opts CommitSegment) (err error)
 			root_piece_id, encrypted_key_nonce, encrypted_key,
 			encrypted_size, plain_offset, plain_size, encrypted_etag,
 			redundancy,
-			remote_alias_pieces
+			remote_alias_pieces,
+			placement
 		) VALUES (
 			(SELECT stream_id
 				FROM objects WHERE
-					project_id   = $12 AND
-					bucket_name  = $13 AND
-					object_key   = $14 AND
-					version      = $15 AND
-					stream_id    = $16 AND
+					project_id   = $13 AND
+					bucket_name  = $14 AND
+					object_key   = $15 AND
+					version      = $16 AND
+					stream_id    = $17 AND
 					status       = `+pendingStatus+
 		`	), $1, $2,
 			$3, $4, $5,
 			$6, $7, $8, $9,
 			$10,
-			$11
+			$11,
+			$12
 		)`, opts.Position, opts.ExpiresAt,
 		opts.RootPieceID, opts.EncryptedKeyNonce, opts.EncryptedKey,
 		opts.EncryptedSize, opts.PlainOffset, opts.PlainSize, opts.EncryptedETag,
 		redundancyScheme{&opts.Redundancy},
 		aliasPieces,
+		opts.Placement,
 		opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version, opts.StreamID,
 	)
 	if err != nil {
@@ -427,6 +433,9 @@ type CommitObject struct {
 	EncryptedMetadata             []byte
 	EncryptedMetadataNonce        []byte
 	EncryptedMetadataEncryptedKey []byte
+
+	// Notify returns the events of the committed object.
+	Notify NotifyObjects
 }
 
 // CommitObject adds a pending object to the database.
@@ -540,7 +549,8 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 		object.TotalPlainSize = totalPlainSize
 		object.TotalEncryptedSize = totalEncryptedSize
 		object.FixedSegmentSize = fixedSegmentSize
-		return nil
+
+		return insertObjectEvents(ctx, tx, opts.Notify, []Object{object})
 	})
 	if err != nil {
 		return Object{}, err
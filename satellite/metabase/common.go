@@ -276,6 +276,9 @@ type Version int64
 // NextVersion means that the version should be chosen automatically.
 const NextVersion = Version(0)
 
+// MaxVersion is the largest version that can be stored in the database.
+const MaxVersion = Version(math.MaxInt32)
+
 // ObjectStatus defines the statuses that the object might be in.
 type ObjectStatus byte
 
@@ -285,9 +288,13 @@ const (
 	Pending = ObjectStatus(1)
 	// Committed means that the object is finished and should be visible for general listing.
 	Committed = ObjectStatus(3)
+	// DeleteMarker means that the object was deleted in a versioned bucket.
+	// The previous versions are kept, but the object isn't visible for general listing.
+	DeleteMarker = ObjectStatus(5)
 
-	pendingStatus   = "1"
-	committedStatus = "3"
+	pendingStatus      = "1"
+	committedStatus    = "3"
+	deleteMarkerStatus = "5"
 )
 
 // Pieces defines information for pieces.
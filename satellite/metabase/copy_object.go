@@ -0,0 +1,257 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	pgxerrcode "github.com/jackc/pgerrcode"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// BeginCopyObjectResult holds data needed to finish copy object.
+type BeginCopyObjectResult BeginMoveObjectResult
+
+// BeginCopyObject holds all data needed to begin copy object method.
+type BeginCopyObject BeginMoveObject
+
+// BeginCopyObject collects all data needed to begin object copy procedure.
+func (db *DB) BeginCopyObject(ctx context.Context, opts BeginCopyObject) (result BeginCopyObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	moveResult, err := db.BeginMoveObject(ctx, BeginMoveObject(opts))
+	if err != nil {
+		return BeginCopyObjectResult{}, err
+	}
+	return BeginCopyObjectResult(moveResult), nil
+}
+
+// FinishCopyObject holds metadata needed for finishing object copy.
+type FinishCopyObject struct {
+	ObjectStream
+	NewStreamID           uuid.UUID
+	NewBucket             string
+	NewEncryptedObjectKey []byte
+	NewSegmentKeys        []EncryptedKeyAndNonce
+	// Optional. Required if object has metadata.
+	NewEncryptedMetadataKeyNonce storj.Nonce
+	NewEncryptedMetadataKey      []byte
+
+	// NewBucketVersioned allows copying the object onto a committed object in a
+	// versioned bucket. The copy becomes the latest version of the key.
+	NewBucketVersioned bool
+
+	// Notify returns the events of the object copy.
+	Notify NotifyObjects
+}
+
+// Verify verifies metabase.FinishCopyObject data.
+func (finishCopy FinishCopyObject) Verify() error {
+	if err := finishCopy.ObjectStream.Verify(); err != nil {
+		return err
+	}
+
+	switch {
+	case finishCopy.NewStreamID.IsZero():
+		return ErrInvalidRequest.New("NewStreamID is missing")
+	case finishCopy.NewStreamID == finishCopy.StreamID:
+		return ErrInvalidRequest.New("NewStreamID must be different from StreamID")
+	case len(finishCopy.NewBucket) == 0:
+		return ErrInvalidRequest.New("NewBucket is missing")
+	case len(finishCopy.NewEncryptedObjectKey) == 0:
+		return ErrInvalidRequest.New("NewEncryptedObjectKey is missing")
+	}
+
+	return verifySegmentKeys(finishCopy.NewSegmentKeys)
+}
+
+// FinishCopyObject accepts new encryption keys for the object copy and
+// inserts the corresponding new object and segments.
+//
+// The copy shares pieces with the original object. The relation is stored in
+// segment_copies, so that deleting either of them doesn't remove pieces that
+// are still referenced.
+func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (object Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return Object{}, err
+	}
+
+	newLocation := ObjectLocation{
+		ProjectID:  opts.ProjectID,
+		BucketName: opts.NewBucket,
+		ObjectKey:  ObjectKey(opts.NewEncryptedObjectKey),
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		var expiresAt *time.Time
+		err = tx.QueryRowContext(ctx, `
+			SELECT
+				expires_at,
+				segment_count,
+				encrypted_metadata, encrypted_metadata_encrypted_key, encrypted_metadata_nonce,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption
+			FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4 AND
+				stream_id    = $5 AND
+				status       = `+committedStatus,
+			opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version, opts.StreamID).
+			Scan(
+				&expiresAt,
+				&object.SegmentCount,
+				&object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey, &object.EncryptedMetadataNonce,
+				&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+				encryptionParameters{&object.Encryption},
+			)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storj.ErrObjectNotFound.Wrap(Error.New("source object not found"))
+			}
+			return Error.New("unable to query source object: %w", err)
+		}
+
+		if int(object.SegmentCount) != len(opts.NewSegmentKeys) {
+			return ErrInvalidRequest.New("wrong amount of segments keys received (received %d, need %d)", len(opts.NewSegmentKeys), object.SegmentCount)
+		}
+
+		if object.EncryptedMetadata != nil {
+			object.EncryptedMetadataEncryptedKey = opts.NewEncryptedMetadataKey
+			object.EncryptedMetadataNonce = opts.NewEncryptedMetadataKeyNonce[:]
+		}
+
+		err = checkMoveOrCopyTarget(ctx, tx, newLocation, opts.NewBucketVersioned)
+		if err != nil {
+			return err
+		}
+
+		object.ProjectID = newLocation.ProjectID
+		object.BucketName = newLocation.BucketName
+		object.ObjectKey = newLocation.ObjectKey
+		object.StreamID = opts.NewStreamID
+		object.Status = Committed
+		object.ExpiresAt = expiresAt
+
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO objects (
+				project_id, bucket_name, object_key, version, stream_id,
+				expires_at, status, segment_count,
+				encrypted_metadata, encrypted_metadata_encrypted_key, encrypted_metadata_nonce,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption,
+				zombie_deletion_deadline
+			) VALUES (
+				$1, $2, $3,
+					coalesce((
+						SELECT max(version) + 1
+						FROM objects
+						WHERE project_id = $1 AND bucket_name = $2 AND object_key = $3
+					), $4),
+				$5,
+				$6, `+committedStatus+`, $7,
+				$8, $9, $10,
+				$11, $12, $13,
+				$14,
+				NULL
+			)
+			RETURNING version, created_at
+		`, object.ProjectID, []byte(object.BucketName), []byte(object.ObjectKey), opts.Version, object.StreamID,
+			object.ExpiresAt, object.SegmentCount,
+			object.EncryptedMetadata, object.EncryptedMetadataEncryptedKey, object.EncryptedMetadataNonce,
+			object.TotalPlainSize, object.TotalEncryptedSize, object.FixedSegmentSize,
+			encryptionParameters{&object.Encryption},
+		).Scan(&object.Version, &object.CreatedAt)
+		if err != nil {
+			if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
+				return ErrObjectAlreadyExists.New("")
+			}
+			return Error.New("unable to insert object copy: %w", err)
+		}
+
+		if len(opts.NewSegmentKeys) == 0 {
+			return insertObjectEvents(ctx, tx, opts.Notify, []Object{object})
+		}
+
+		var positions []int64
+		var encryptedKeys [][]byte
+		var encryptedKeyNonces [][]byte
+		for _, key := range opts.NewSegmentKeys {
+			positions = append(positions, int64(key.Position.Encode()))
+			encryptedKeys = append(encryptedKeys, key.EncryptedKey)
+			encryptedKeyNonces = append(encryptedKeyNonces, key.EncryptedKeyNonce)
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO segments (
+				stream_id, position,
+				expires_at, repaired_at,
+				root_piece_id, encrypted_key_nonce, encrypted_key,
+				encrypted_size, plain_offset, plain_size, encrypted_etag,
+				redundancy,
+				inline_data, remote_alias_pieces,
+				placement
+			)
+			SELECT
+				$2, segments.position,
+				segments.expires_at, segments.repaired_at,
+				segments.root_piece_id, P.encrypted_key_nonce, P.encrypted_key,
+				segments.encrypted_size, segments.plain_offset, segments.plain_size, segments.encrypted_etag,
+				segments.redundancy,
+				segments.inline_data, segments.remote_alias_pieces,
+				segments.placement
+			FROM segments
+			JOIN (SELECT unnest($3::INT8[]), unnest($4::BYTEA[]), unnest($5::BYTEA[])) as P(position, encrypted_key_nonce, encrypted_key)
+				ON segments.position = P.position
+			WHERE segments.stream_id = $1
+		`, opts.StreamID, opts.NewStreamID,
+			pgutil.Int8Array(positions), pgutil.ByteaArray(encryptedKeyNonces), pgutil.ByteaArray(encryptedKeys))
+		if err != nil {
+			return Error.New("unable to copy segments: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return Error.New("unable to get number of copied segments: %w", err)
+		}
+		if affected != int64(len(opts.NewSegmentKeys)) {
+			return ErrInvalidRequest.New("segment keys don't match object segments, expected %d got %d", len(opts.NewSegmentKeys), affected)
+		}
+
+		// copies of copies point to the original ancestor, so that all
+		// streams sharing the same pieces form a single group.
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO segment_copies (
+				stream_id, ancestor_stream_id
+			) VALUES (
+				$1, COALESCE((SELECT ancestor_stream_id FROM segment_copies WHERE stream_id = $2), $2)
+			)
+		`, opts.NewStreamID, opts.StreamID)
+		if err != nil {
+			return Error.New("unable to insert segment copy: %w", err)
+		}
+
+		return insertObjectEvents(ctx, tx, opts.Notify, []Object{object})
+	})
+	if err != nil {
+		return Object{}, err
+	}
+
+	mon.Meter("finish_copy_object").Mark(1)
+
+	return object, nil
+}
@@ -0,0 +1,260 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestFinishCopyObject(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		newBucketName := "New bucket name"
+
+		for _, test := range metabasetest.InvalidObjectStreams(obj) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.FinishCopyObject{
+					Opts: metabase.FinishCopyObject{
+						ObjectStream:          test.ObjectStream,
+						NewStreamID:           testrand.UUID(),
+						NewBucket:             newBucketName,
+						NewEncryptedObjectKey: []byte{1, 2, 3},
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("missing new stream id", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					ObjectStream:          obj,
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: []byte{1, 2, 3},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewStreamID is missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					ObjectStream:          obj,
+					NewStreamID:           obj.StreamID,
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: []byte{1, 2, 3},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewStreamID must be different from StreamID",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("object missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					ObjectStream:          obj,
+					NewStreamID:           testrand.UUID(),
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: []byte{1, 2, 3},
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("target already exists", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			target := metabasetest.RandObjectStream()
+			target.ProjectID = obj.ProjectID
+			target.BucketName = newBucketName
+			targetObject := metabasetest.CreateObject(ctx, t, db, target, 0)
+
+			metabasetest.FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					ObjectStream:          obj,
+					NewStreamID:           testrand.UUID(),
+					NewBucket:             target.BucketName,
+					NewEncryptedObjectKey: []byte(target.ObjectKey),
+				},
+				ErrClass: &metabase.ErrObjectAlreadyExists,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+					metabase.RawObject(targetObject),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("finish copy object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			original := metabasetest.CreateObject(ctx, t, db, obj, 2)
+			copyObject, segments, copySegments := copyTestObject(ctx, t, db, original, newBucketName)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(original),
+					metabase.RawObject(copyObject),
+				},
+				Segments: append(metabasetest.SegmentsToRaw(segments), metabasetest.SegmentsToRaw(copySegments)...),
+				Copies: []metabase.RawCopy{{
+					StreamID:         copyObject.StreamID,
+					AncestorStreamID: original.StreamID,
+				}},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("copy of copy", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			original := metabasetest.CreateObject(ctx, t, db, obj, 1)
+			copyObject, _, _ := copyTestObject(ctx, t, db, original, newBucketName)
+			copyOfCopy, _, _ := copyTestObject(ctx, t, db, copyObject, "another bucket")
+
+			state, err := db.TestingGetState(ctx)
+			require.NoError(t, err)
+
+			require.ElementsMatch(t, []metabase.RawCopy{
+				{StreamID: copyObject.StreamID, AncestorStreamID: original.StreamID},
+				{StreamID: copyOfCopy.StreamID, AncestorStreamID: original.StreamID},
+			}, state.Copies)
+		})
+
+		t.Run("delete original keeps shared pieces", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			original := metabasetest.CreateObject(ctx, t, db, obj, 2)
+			copyObject, _, copySegments := copyTestObject(ctx, t, db, original, newBucketName)
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					Version:        original.Version,
+					ObjectLocation: original.Location(),
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{original},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects:  []metabase.RawObject{metabase.RawObject(copyObject)},
+				Segments: metabasetest.SegmentsToRaw(copySegments),
+				Copies: []metabase.RawCopy{{
+					StreamID:         copyObject.StreamID,
+					AncestorStreamID: original.StreamID,
+				}},
+			}.Check(ctx, t, db)
+
+			expectedSegmentInfo := metabase.DeletedSegmentInfo{
+				RootPieceID: storj.PieceID{1},
+				Pieces:      metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{2}}},
+			}
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					Version:        copyObject.Version,
+					ObjectLocation: copyObject.Location(),
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects:  []metabase.Object{copyObject},
+					Segments: []metabase.DeletedSegmentInfo{expectedSegmentInfo, expectedSegmentInfo},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("delete copy keeps shared pieces", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			original := metabasetest.CreateObject(ctx, t, db, obj, 2)
+			copyObject, segments, _ := copyTestObject(ctx, t, db, original, newBucketName)
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					Version:        copyObject.Version,
+					ObjectLocation: copyObject.Location(),
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{copyObject},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects:  []metabase.RawObject{metabase.RawObject(original)},
+				Segments: metabasetest.SegmentsToRaw(segments),
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+// copyTestObject copies the object into the bucket with random segment keys and
+// returns the copy together with the original and copied segments.
+func copyTestObject(ctx *testcontext.Context, t *testing.T, db *metabase.DB, object metabase.Object, newBucket string) (metabase.Object, []metabase.Segment, []metabase.Segment) {
+	segments, err := db.TestingAllObjectSegments(ctx, object.Location())
+	require.NoError(t, err)
+
+	newStreamID := testrand.UUID()
+	newKeys := []metabase.EncryptedKeyAndNonce{}
+	copySegments := []metabase.Segment{}
+	for _, segment := range segments {
+		key := metabase.EncryptedKeyAndNonce{
+			Position:          segment.Position,
+			EncryptedKeyNonce: testrand.Nonce().Bytes(),
+			EncryptedKey:      testrand.Bytes(32),
+		}
+		newKeys = append(newKeys, key)
+
+		segment.StreamID = newStreamID
+		segment.EncryptedKeyNonce = key.EncryptedKeyNonce
+		segment.EncryptedKey = key.EncryptedKey
+		copySegments = append(copySegments, segment)
+	}
+
+	expected := object
+	expected.ObjectStream = metabase.ObjectStream{
+		ProjectID:  object.ProjectID,
+		BucketName: newBucket,
+		ObjectKey:  metabase.ObjectKey(testrand.Bytes(16)),
+		Version:    object.Version,
+		StreamID:   newStreamID,
+	}
+
+	copyObject := metabasetest.FinishCopyObject{
+		Opts: metabase.FinishCopyObject{
+			ObjectStream:          object.ObjectStream,
+			NewStreamID:           newStreamID,
+			NewBucket:             newBucket,
+			NewEncryptedObjectKey: []byte(expected.ObjectKey),
+			NewSegmentKeys:        newKeys,
+		},
+		Result: expected,
+	}.Check(ctx, t, db)
+
+	return copyObject, segments, copySegments
+}
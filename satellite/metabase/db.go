@@ -104,6 +104,8 @@ func (db *DB) DestroyTables(ctx context.Context) error {
 		DROP TABLE IF EXISTS objects;
 		DROP TABLE IF EXISTS segments;
 		DROP TABLE IF EXISTS node_aliases;
+		DROP TABLE IF EXISTS segment_copies;
+		DROP TABLE IF EXISTS object_events;
 		DROP SEQUENCE IF EXISTS node_alias_seq;
 	`)
 	db.aliasCache = NewNodeAliasCache(db)
@@ -310,6 +312,56 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					`ALTER TABLE segments ALTER COLUMN created_at SET NOT NULL`,
 				},
 			},
+			{
+				DB:          &db.db,
+				Description: "add segment_copies table",
+				Version:     14,
+				Action: migrate.SQL{
+					`CREATE TABLE segment_copies (
+						stream_id          BYTEA NOT NULL PRIMARY KEY,
+						ancestor_stream_id BYTEA NOT NULL,
+
+						CONSTRAINT not_self_ancestor CHECK (stream_id != ancestor_stream_id)
+					)`,
+					`CREATE INDEX ON segment_copies (ancestor_stream_id)`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add retain_until and legal_hold columns to objects",
+				Version:     15,
+				Action: migrate.SQL{
+					`ALTER TABLE objects ADD COLUMN retain_until TIMESTAMPTZ`,
+					`ALTER TABLE objects ADD COLUMN legal_hold BOOLEAN NOT NULL DEFAULT false`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add placement column to segments",
+				Version:     16,
+				Action: migrate.SQL{
+					`ALTER TABLE segments ADD COLUMN placement INT4 NOT NULL DEFAULT 0`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add object_events table",
+				Version:     17,
+				Action: migrate.SQL{
+					`CREATE TABLE object_events (
+						id              BYTEA NOT NULL PRIMARY KEY,
+						project_id      BYTEA NOT NULL,
+						bucket_name     BYTEA NOT NULL,
+						webhook_id      TEXT NOT NULL,
+						url             TEXT NOT NULL,
+						payload         BYTEA NOT NULL,
+						attempts        INT4 NOT NULL DEFAULT 0,
+						created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+						next_attempt_at TIMESTAMPTZ NOT NULL
+					)`,
+					`CREATE INDEX object_events_next_attempt_at_index ON object_events (next_attempt_at)`,
+				},
+			},
 		},
 	}
 }
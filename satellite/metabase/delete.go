@@ -11,6 +11,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/private/dbutil"
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
@@ -20,6 +21,9 @@ import (
 type DeleteObjectExactVersion struct {
 	Version Version
 	ObjectLocation
+
+	// Notify returns the events of the deleted objects.
+	Notify NotifyObjects
 }
 
 // Verify delete object fields.
@@ -53,6 +57,9 @@ type DeleteObjectAnyStatusAllVersions struct {
 // DeleteObjectsAllVersions contains arguments necessary for deleting all versions of multiple objects from the same bucket.
 type DeleteObjectsAllVersions struct {
 	Locations []ObjectLocation
+
+	// Notify returns the events of the deleted objects.
+	Notify NotifyObjects
 }
 
 // Verify delete objects fields.
@@ -98,7 +105,9 @@ func (db *DB) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 	if err := opts.Verify(); err != nil {
 		return DeleteObjectResult{}, err
 	}
-	err = withRows(db.db.QueryContext(ctx, `
+	result.Objects, result.Segments, err = db.deleteWithSegmentCopies(ctx, opts.Notify, func(rows tagsql.Rows) ([]Object, []streamSegment, error) {
+		return db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
+	}, `
 			WITH deleted_objects AS (
 				DELETE FROM objects
 				WHERE
@@ -106,7 +115,8 @@ func (db *DB) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 					bucket_name  = $2 AND
 					object_key   = $3 AND
 					version      = $4 AND
-					status       = `+committedStatus+`
+					status       IN (`+committedStatus+`, `+deleteMarkerStatus+`) AND
+					`+objectNotLocked+`
 				RETURNING
 					version, stream_id,
 					created_at, expires_at,
@@ -129,16 +139,13 @@ func (db *DB) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 				deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
 			FROM deleted_objects
 			LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
-		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
-		return err
-	})
+		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version)
 	if err != nil {
 		return DeleteObjectResult{}, err
 	}
 
 	if len(result.Objects) == 0 {
-		return DeleteObjectResult{}, storj.ErrObjectNotFound.Wrap(Error.New("no rows deleted"))
+		return DeleteObjectResult{}, db.noRowsDeletedError(ctx, opts.ObjectLocation, opts.Version)
 	}
 
 	mon.Meter("object_delete").Mark(len(result.Objects))
@@ -168,7 +175,9 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 		return DeleteObjectResult{}, err
 	}
 
-	err = withRows(db.db.QueryContext(ctx, `
+	result.Objects, result.Segments, err = db.deleteWithSegmentCopies(ctx, nil, func(rows tagsql.Rows) ([]Object, []streamSegment, error) {
+		return db.scanObjectDeletion(ctx, opts.Location(), rows)
+	}, `
 			WITH deleted_objects AS (
 				DELETE FROM objects
 				WHERE
@@ -200,10 +209,7 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 				deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
 			FROM deleted_objects
 			LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
-		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version, opts.StreamID))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.Location(), rows)
-		return err
-	})
+		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version, opts.StreamID)
 
 	if err != nil {
 		return DeleteObjectResult{}, err
@@ -237,7 +243,17 @@ func (db *DB) DeleteObjectLatestVersion(ctx context.Context, opts DeleteObjectLa
 					project_id   = $1 AND
 					bucket_name  = $2 AND
 					object_key   = $3 AND
-					status       = ` + committedStatus + `
+					status       = ` + committedStatus + ` AND
+					` + objectNotLocked + ` AND
+					NOT EXISTS (
+						SELECT 1 FROM objects AS newer
+						WHERE
+							newer.project_id  = $1 AND
+							newer.bucket_name = $2 AND
+							newer.object_key  = $3 AND
+							newer.status      = ` + committedStatus + ` AND
+							newer.version     > objects.version
+					)
 				ORDER BY version DESC
 				LIMIT 1
 				RETURNING
@@ -280,7 +296,8 @@ func (db *DB) DeleteObjectLatestVersion(ctx context.Context, opts DeleteObjectLa
 							status       = ` + committedStatus + `
 						ORDER BY version DESC LIMIT 1
 					) AND
-					status       = ` + committedStatus + `
+					status       = ` + committedStatus + ` AND
+					` + objectNotLocked + `
 				RETURNING
 					version, stream_id,
 					created_at, expires_at,
@@ -307,17 +324,16 @@ func (db *DB) DeleteObjectLatestVersion(ctx context.Context, opts DeleteObjectLa
 	default:
 		return DeleteObjectResult{}, Error.New("unhandled database: %v", db.impl)
 	}
-	err = withRows(db.db.QueryContext(ctx, query, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey)))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
-		return err
-	})
+	result.Objects, result.Segments, err = db.deleteWithSegmentCopies(ctx, nil, func(rows tagsql.Rows) ([]Object, []streamSegment, error) {
+		return db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
+	}, query, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey))
 
 	if err != nil {
 		return DeleteObjectResult{}, err
 	}
 
 	if len(result.Objects) == 0 {
-		return DeleteObjectResult{}, storj.ErrObjectNotFound.Wrap(Error.New("no rows deleted"))
+		return DeleteObjectResult{}, db.noRowsDeletedError(ctx, opts.ObjectLocation, 0)
 	}
 
 	mon.Meter("object_delete").Mark(len(result.Objects))
@@ -334,13 +350,23 @@ func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteO
 		return DeleteObjectResult{}, err
 	}
 
-	err = withRows(db.db.QueryContext(ctx, `
+	result.Objects, result.Segments, err = db.deleteWithSegmentCopies(ctx, nil, func(rows tagsql.Rows) ([]Object, []streamSegment, error) {
+		return db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
+	}, `
 			WITH deleted_objects AS (
 				DELETE FROM objects
 				WHERE
 				project_id   = $1 AND
 				bucket_name  = $2 AND
-				object_key   = $3
+				object_key   = $3 AND
+				NOT EXISTS (
+					SELECT 1 FROM objects AS locked_objects
+					WHERE
+						locked_objects.project_id  = $1 AND
+						locked_objects.bucket_name = $2 AND
+						locked_objects.object_key  = $3 AND
+						NOT `+objectNotLocked+`
+				)
 				RETURNING
 					version, stream_id,
 					created_at, expires_at,
@@ -363,17 +389,14 @@ func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteO
 				deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
 			FROM deleted_objects
 			LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
-		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey)))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
-		return err
-	})
+		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey))
 
 	if err != nil {
 		return DeleteObjectResult{}, err
 	}
 
 	if len(result.Objects) == 0 {
-		return DeleteObjectResult{}, storj.ErrObjectNotFound.Wrap(Error.New("no rows deleted"))
+		return DeleteObjectResult{}, db.noRowsDeletedError(ctx, opts.ObjectLocation, 0)
 	}
 
 	mon.Meter("object_delete").Mark(len(result.Objects))
@@ -409,14 +432,17 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 	sort.Slice(objectKeys, func(i, j int) bool {
 		return bytes.Compare(objectKeys[i], objectKeys[j]) < 0
 	})
-	err = withRows(db.db.QueryContext(ctx, `
+	result.Objects, result.Segments, err = db.deleteWithSegmentCopies(ctx, opts.Notify, func(rows tagsql.Rows) ([]Object, []streamSegment, error) {
+		return db.scanMultipleObjectsDeletion(ctx, rows)
+	}, `
 				WITH deleted_objects AS (
 					DELETE FROM objects
 					WHERE
 					project_id   = $1 AND
 					bucket_name  = $2 AND
 					object_key   = ANY ($3) AND
-					status       = `+committedStatus+`
+					status       = `+committedStatus+` AND
+					`+objectNotLocked+`
 					RETURNING
 						project_id, bucket_name,
 						object_key, version, stream_id,
@@ -441,10 +467,7 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 					deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
 				FROM deleted_objects
 				LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
-			`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys)))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanMultipleObjectsDeletion(ctx, rows)
-		return err
-	})
+			`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys))
 
 	if err != nil {
 		return DeleteObjectResult{}, err
@@ -456,11 +479,11 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 	return result, nil
 }
 
-func (db *DB) scanObjectDeletion(ctx context.Context, location ObjectLocation, rows tagsql.Rows) (objects []Object, segments []DeletedSegmentInfo, err error) {
+func (db *DB) scanObjectDeletion(ctx context.Context, location ObjectLocation, rows tagsql.Rows) (objects []Object, deletedSegments []streamSegment, err error) {
 	defer func() { err = errs.Combine(err, rows.Close()) }()
 
 	objects = make([]Object, 0, 10)
-	segments = make([]DeletedSegmentInfo, 0, 10)
+	deletedSegments = make([]streamSegment, 0, 10)
 
 	var rootPieceID *storj.PieceID
 	var object Object
@@ -492,7 +515,10 @@ func (db *DB) scanObjectDeletion(ctx context.Context, location ObjectLocation, r
 				return nil, nil, Error.Wrap(err)
 			}
 			if len(segment.Pieces) > 0 {
-				segments = append(segments, segment)
+				deletedSegments = append(deletedSegments, streamSegment{
+					StreamID: object.StreamID,
+					Segment:  segment,
+				})
 			}
 		}
 	}
@@ -501,17 +527,14 @@ func (db *DB) scanObjectDeletion(ctx context.Context, location ObjectLocation, r
 		return nil, nil, Error.New("unable to delete object: %w", err)
 	}
 
-	if len(segments) == 0 {
-		return objects, nil, nil
-	}
-	return objects, segments, nil
+	return objects, deletedSegments, nil
 }
 
-func (db *DB) scanMultipleObjectsDeletion(ctx context.Context, rows tagsql.Rows) (objects []Object, segments []DeletedSegmentInfo, err error) {
+func (db *DB) scanMultipleObjectsDeletion(ctx context.Context, rows tagsql.Rows) (objects []Object, deletedSegments []streamSegment, err error) {
 	defer func() { err = errs.Combine(err, rows.Close()) }()
 
 	objects = make([]Object, 0, 10)
-	segments = make([]DeletedSegmentInfo, 0, 10)
+	deletedSegments = make([]streamSegment, 0, 10)
 
 	var rootPieceID *storj.PieceID
 	var object Object
@@ -540,7 +563,10 @@ func (db *DB) scanMultipleObjectsDeletion(ctx context.Context, rows tagsql.Rows)
 				return nil, nil, Error.Wrap(err)
 			}
 			if len(segment.Pieces) > 0 {
-				segments = append(segments, segment)
+				deletedSegments = append(deletedSegments, streamSegment{
+					StreamID: object.StreamID,
+					Segment:  segment,
+				})
 			}
 		}
 	}
@@ -552,9 +578,14 @@ func (db *DB) scanMultipleObjectsDeletion(ctx context.Context, rows tagsql.Rows)
 	if len(objects) == 0 {
 		objects = nil
 	}
-	if len(segments) == 0 {
-		return objects, nil, nil
-	}
 
-	return objects, segments, nil
+	return objects, deletedSegments, nil
+}
+
+func objectStreamIDs(objects []Object) []uuid.UUID {
+	streamIDs := make([]uuid.UUID, 0, len(objects))
+	for _, object := range objects {
+		streamIDs = append(streamIDs, object.StreamID)
+	}
+	return streamIDs
 }
@@ -10,6 +10,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/private/dbutil"
+	"storj.io/private/dbutil/txutil"
 	"storj.io/private/tagsql"
 )
 
@@ -32,6 +33,7 @@ type DeleteBucketObjects struct {
 }
 
 // DeleteBucketObjects deletes all objects in the specified bucket.
+// Objects under retention or legal hold are kept.
 func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects) (deletedObjectCount int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -48,7 +50,8 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 		query = `
 		WITH deleted_objects AS (
 			DELETE FROM objects
-			WHERE project_id = $1 AND bucket_name = $2 LIMIT $3
+			WHERE project_id = $1 AND bucket_name = $2 AND ` + objectNotLocked + `
+			LIMIT $3
 			RETURNING objects.stream_id
 		)
 		DELETE FROM segments
@@ -61,7 +64,7 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 			DELETE FROM objects
 			WHERE stream_id IN (
 				SELECT stream_id FROM objects
-				WHERE project_id = $1 AND bucket_name = $2
+				WHERE project_id = $1 AND bucket_name = $2 AND ` + objectNotLocked + `
 				LIMIT $3
 			)
 			RETURNING objects.stream_id
@@ -77,47 +80,48 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 	// TODO: fix the count for objects without segments
 	deletedSegmentsBatch := make([]DeletedSegmentInfo, 0, opts.DeletePiecesBatchSize)
 	for {
-		deletedSegmentsBatch = deletedSegmentsBatch[:0]
-		batchDeletedObjects := 0
-		deletedSegments := 0
-		err = withRows(db.db.QueryContext(ctx, query,
-			opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize))(func(rows tagsql.Rows) error {
-			ids := map[uuid.UUID]struct{}{} // TODO: avoid map here
-			for rows.Next() {
-				var streamID uuid.UUID
-				var segment DeletedSegmentInfo
-				var aliasPieces AliasPieces
-				err := rows.Scan(&streamID, &segment.RootPieceID, &aliasPieces)
-				if err != nil {
-					return Error.Wrap(err)
-				}
-				segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
-				if err != nil {
-					return Error.Wrap(err)
-				}
-
-				ids[streamID] = struct{}{}
-				deletedSegmentsBatch = append(deletedSegmentsBatch, segment)
+		var deletedSegments []streamSegment
+		var streamIDs []uuid.UUID
+		var segments []DeletedSegmentInfo
+		err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+			deletedSegments, streamIDs = nil, nil
+			err = withRows(tx.QueryContext(ctx, query,
+				opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize))(func(rows tagsql.Rows) error {
+				ids := map[uuid.UUID]struct{}{} // TODO: avoid map here
+				for rows.Next() {
+					var streamID uuid.UUID
+					var segment DeletedSegmentInfo
+					var aliasPieces AliasPieces
+					err := rows.Scan(&streamID, &segment.RootPieceID, &aliasPieces)
+					if err != nil {
+						return Error.Wrap(err)
+					}
+					segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+					if err != nil {
+						return Error.Wrap(err)
+					}
 
-				if len(deletedSegmentsBatch) >= opts.DeletePiecesBatchSize {
-					if opts.DeletePieces != nil {
-						err = opts.DeletePieces(ctx, deletedSegmentsBatch)
-						if err != nil {
-							return Error.Wrap(err)
-						}
-						deletedSegmentsBatch = deletedSegmentsBatch[:0]
+					if _, ok := ids[streamID]; !ok {
+						ids[streamID] = struct{}{}
+						streamIDs = append(streamIDs, streamID)
 					}
+					deletedSegments = append(deletedSegments, streamSegment{
+						StreamID: streamID,
+						Segment:  segment,
+					})
 				}
-
-				deletedSegments++
+				return nil
+			})
+			if err != nil {
+				return err
 			}
-			batchDeletedObjects = len(ids)
-			deletedObjectCount += int64(len(ids))
-			return nil
+
+			segments, err = db.withoutSharedSegments(ctx, tx, streamIDs, deletedSegments)
+			return err
 		})
 
-		mon.Meter("object_delete").Mark(batchDeletedObjects)
-		mon.Meter("segment_delete").Mark(deletedSegments)
+		mon.Meter("object_delete").Mark(len(streamIDs))
+		mon.Meter("segment_delete").Mark(len(deletedSegments))
 
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
@@ -125,11 +129,24 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 			}
 			return deletedObjectCount, Error.Wrap(err)
 		}
-		if deletedSegments == 0 {
+		deletedObjectCount += int64(len(streamIDs))
+		if len(deletedSegments) == 0 {
 			return deletedObjectCount, nil
 		}
 
-		if opts.DeletePieces != nil {
+		if opts.DeletePieces == nil {
+			continue
+		}
+
+		for len(segments) > 0 {
+			batchSize := len(segments)
+			if batchSize > opts.DeletePiecesBatchSize {
+				batchSize = opts.DeletePiecesBatchSize
+			}
+
+			deletedSegmentsBatch = append(deletedSegmentsBatch[:0], segments[:batchSize]...)
+			segments = segments[batchSize:]
+
 			err = opts.DeletePieces(ctx, deletedSegmentsBatch)
 			if err != nil {
 				return deletedObjectCount, Error.Wrap(err)
@@ -0,0 +1,78 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// CreateDeleteMarker contains arguments necessary for deleting an object in a versioned bucket.
+type CreateDeleteMarker struct {
+	ObjectLocation
+
+	// Notify returns the events of the delete marker.
+	Notify NotifyObjects
+}
+
+// CreateDeleteMarker inserts a delete marker as the latest version of the object.
+// Previous versions are kept and can be still accessed by their exact version.
+func (db *DB) CreateDeleteMarker(ctx context.Context, opts CreateDeleteMarker) (marker Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return Object{}, err
+	}
+
+	streamID, err := uuid.New()
+	if err != nil {
+		return Object{}, Error.New("unable to generate stream id: %w", err)
+	}
+
+	marker = Object{
+		ObjectStream: ObjectStream{
+			ProjectID:  opts.ProjectID,
+			BucketName: opts.BucketName,
+			ObjectKey:  opts.ObjectKey,
+			StreamID:   streamID,
+		},
+		Status: DeleteMarker,
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO objects (
+				project_id, bucket_name, object_key, version, stream_id,
+				status, zombie_deletion_deadline
+			) VALUES (
+				$1, $2, $3,
+					coalesce((
+						SELECT version + 1
+						FROM objects
+						WHERE project_id = $1 AND bucket_name = $2 AND object_key = $3
+						ORDER BY version DESC
+						LIMIT 1
+					), 1),
+				$4,
+				`+deleteMarkerStatus+`, NULL)
+			RETURNING version, created_at
+		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), streamID,
+		).Scan(&marker.Version, &marker.CreatedAt)
+		if err != nil {
+			return Error.New("unable to insert delete marker: %w", err)
+		}
+
+		return insertObjectEvents(ctx, tx, opts.Notify, []Object{marker})
+	})
+	if err != nil {
+		return Object{}, err
+	}
+
+	mon.Meter("object_delete_marker").Mark(1)
+
+	return marker, nil
+}
@@ -0,0 +1,301 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestCreateDeleteMarker(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		location := obj.Location()
+
+		for _, test := range metabasetest.InvalidObjectLocations(location) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.CreateDeleteMarker{
+					Opts: metabase.CreateDeleteMarker{
+						ObjectLocation: test.ObjectLocation,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("hides latest version", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			marker := metabasetest.CreateDeleteMarker{
+				Opts: metabase.CreateDeleteMarker{
+					ObjectLocation: location,
+				},
+				Result: metabase.Object{
+					ObjectStream: metabase.ObjectStream{
+						ProjectID:  obj.ProjectID,
+						BucketName: obj.BucketName,
+						ObjectKey:  obj.ObjectKey,
+						Version:    obj.Version + 1,
+					},
+					CreatedAt: object.CreatedAt,
+					Status:    metabase.DeleteMarker,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectLatestVersion{
+				Opts: metabase.GetObjectLatestVersion{
+					ObjectLocation: location,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: object was deleted",
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					Version:        obj.Version,
+					ObjectLocation: location,
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+					metabase.RawObject(marker),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("deleting marker restores previous version", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			marker := metabasetest.CreateDeleteMarker{
+				Opts: metabase.CreateDeleteMarker{
+					ObjectLocation: location,
+				},
+				Result: metabase.Object{
+					ObjectStream: metabase.ObjectStream{
+						ProjectID:  obj.ProjectID,
+						BucketName: obj.BucketName,
+						ObjectKey:  obj.ObjectKey,
+						Version:    obj.Version + 1,
+					},
+					CreatedAt: object.CreatedAt,
+					Status:    metabase.DeleteMarker,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					Version:        marker.Version,
+					ObjectLocation: location,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{marker},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectLatestVersion{
+				Opts: metabase.GetObjectLatestVersion{
+					ObjectLocation: location,
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestIterateObjectsLatestVersion(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid arguments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsLatestVersion{
+				Opts: metabase.IterateObjectsLatestVersion{
+					BucketName: "sj://mybucket",
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("only latest versions", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			first := metabasetest.RandObjectStream()
+			first.ObjectKey = "a"
+			metabasetest.CreateObject(ctx, t, db, first, 0)
+
+			firstLatest := first
+			firstLatest.Version = 2
+			firstLatest.StreamID = testrand.UUID()
+			latest := metabasetest.CreateObject(ctx, t, db, firstLatest, 0)
+
+			deleted := first
+			deleted.ObjectKey = "b"
+			deleted.StreamID = testrand.UUID()
+			metabasetest.CreateObject(ctx, t, db, deleted, 0)
+
+			metabasetest.CreateDeleteMarker{
+				Opts: metabase.CreateDeleteMarker{
+					ObjectLocation: deleted.Location(),
+				},
+				Result: metabase.Object{
+					ObjectStream: metabase.ObjectStream{
+						ProjectID:  deleted.ProjectID,
+						BucketName: deleted.BucketName,
+						ObjectKey:  deleted.ObjectKey,
+						Version:    deleted.Version + 1,
+					},
+					CreatedAt: latest.CreatedAt,
+					Status:    metabase.DeleteMarker,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsLatestVersion{
+				Opts: metabase.IterateObjectsLatestVersion{
+					ProjectID:       first.ProjectID,
+					BucketName:      first.BucketName,
+					Recursive:       true,
+					IncludeMetadata: true,
+				},
+				Result: []metabase.ObjectEntry{
+					objectEntryFromRaw(metabase.RawObject(latest)),
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsLatestVersion{
+				Opts: metabase.IterateObjectsLatestVersion{
+					ProjectID:       first.ProjectID,
+					BucketName:      first.BucketName,
+					Recursive:       true,
+					IncludeMetadata: true,
+					Cursor: metabase.IterateCursor{
+						Key:     "a",
+						Version: 1,
+					},
+				},
+				Result: nil,
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestIterateObjectVersions(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid arguments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectVersions{
+				Opts: metabase.IterateObjectVersions{
+					BucketName: "sj://mybucket",
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("all versions and delete markers", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			first := metabasetest.RandObjectStream()
+			first.ObjectKey = "a"
+			firstObject := metabasetest.CreateObject(ctx, t, db, first, 0)
+
+			firstLatest := first
+			firstLatest.Version = 2
+			firstLatest.StreamID = testrand.UUID()
+			latest := metabasetest.CreateObject(ctx, t, db, firstLatest, 0)
+
+			deleted := first
+			deleted.ObjectKey = "b"
+			deleted.StreamID = testrand.UUID()
+			deletedObject := metabasetest.CreateObject(ctx, t, db, deleted, 0)
+
+			marker := metabasetest.CreateDeleteMarker{
+				Opts: metabase.CreateDeleteMarker{
+					ObjectLocation: deleted.Location(),
+				},
+				Result: metabase.Object{
+					ObjectStream: metabase.ObjectStream{
+						ProjectID:  deleted.ProjectID,
+						BucketName: deleted.BucketName,
+						ObjectKey:  deleted.ObjectKey,
+						Version:    deleted.Version + 1,
+					},
+					CreatedAt: latest.CreatedAt,
+					Status:    metabase.DeleteMarker,
+				},
+			}.Check(ctx, t, db)
+
+			pending := first
+			pending.ObjectKey = "c"
+			pending.StreamID = testrand.UUID()
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: pending,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: pending.Version,
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectVersions{
+				Opts: metabase.IterateObjectVersions{
+					ProjectID:       first.ProjectID,
+					BucketName:      first.BucketName,
+					Recursive:       true,
+					IncludeMetadata: true,
+				},
+				Result: []metabase.ObjectEntry{
+					objectEntryFromRaw(metabase.RawObject(firstObject)),
+					objectEntryFromRaw(metabase.RawObject(latest)),
+					objectEntryFromRaw(metabase.RawObject(deletedObject)),
+					objectEntryFromRaw(metabase.RawObject(marker)),
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectVersions{
+				Opts: metabase.IterateObjectVersions{
+					ProjectID:       first.ProjectID,
+					BucketName:      first.BucketName,
+					Recursive:       true,
+					IncludeMetadata: true,
+					Cursor: metabase.IterateCursor{
+						Key:     "a",
+						Version: 1,
+					},
+				},
+				Result: []metabase.ObjectEntry{
+					objectEntryFromRaw(metabase.RawObject(latest)),
+					objectEntryFromRaw(metabase.RawObject(deletedObject)),
+					objectEntryFromRaw(metabase.RawObject(marker)),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
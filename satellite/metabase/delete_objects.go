@@ -25,9 +25,13 @@ type DeleteExpiredObjects struct {
 	ExpiredBefore  time.Time
 	AsOfSystemTime time.Time
 	BatchSize      int
+
+	// Notify returns the events of the deleted objects.
+	Notify NotifyObjects
 }
 
 // DeleteExpiredObjects deletes all objects that expired before expiredBefore.
+// Objects under retention or legal hold are kept until they are unlocked.
 func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObjects) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -35,16 +39,17 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 		query := `
 			SELECT
 				project_id, bucket_name, object_key, version, stream_id,
-				expires_at
+				expires_at, status, total_encrypted_size
 			FROM objects
 			` + db.impl.AsOfSystemTime(opts.AsOfSystemTime) + `
 			WHERE
 				(project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
 				AND expires_at < $5
+				AND ` + objectNotLocked + `
 				ORDER BY project_id, bucket_name, object_key, version
 			LIMIT $6;`
 
-		expiredObjects := make([]ObjectStream, 0, batchsize)
+		expiredObjects := make([]Object, 0, batchsize)
 
 		err = withRows(db.db.QueryContext(ctx, query,
 			startAfter.ProjectID, []byte(startAfter.BucketName), []byte(startAfter.ObjectKey), startAfter.Version,
@@ -53,9 +58,10 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 		)(func(rows tagsql.Rows) error {
 			for rows.Next() {
 				var expiresAt time.Time
+				var object Object
 				err = rows.Scan(
 					&last.ProjectID, &last.BucketName, &last.ObjectKey, &last.Version, &last.StreamID,
-					&expiresAt)
+					&expiresAt, &object.Status, &object.TotalEncryptedSize)
 				if err != nil {
 					return Error.New("unable to delete expired objects: %w", err)
 				}
@@ -68,7 +74,9 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 					zap.String("StreamID", hex.EncodeToString(last.StreamID[:])),
 					zap.Time("Expired At", expiresAt),
 				)
-				expiredObjects = append(expiredObjects, last)
+				object.ObjectStream = last
+				object.ExpiresAt = &expiresAt
+				expiredObjects = append(expiredObjects, object)
 			}
 
 			return nil
@@ -77,7 +85,7 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 			return ObjectStream{}, Error.New("unable to delete expired objects: %w", err)
 		}
 
-		err = db.deleteObjectsAndSegments(ctx, expiredObjects)
+		_, err = db.deleteObjectsAndSegments(ctx, expiredObjects, opts.Notify)
 		if err != nil {
 			return ObjectStream{}, err
 		}
@@ -110,7 +118,7 @@ func (db *DB) DeleteZombieObjects(ctx context.Context, opts DeleteZombieObjects)
 				ORDER BY project_id, bucket_name, object_key, version
 			LIMIT $6;`
 
-		objects := make([]ObjectStream, 0, batchsize)
+		objects := make([]Object, 0, batchsize)
 
 		err = withRows(db.db.QueryContext(ctx, query,
 			startAfter.ProjectID, []byte(startAfter.BucketName), []byte(startAfter.ObjectKey), startAfter.Version,
@@ -130,7 +138,7 @@ func (db *DB) DeleteZombieObjects(ctx context.Context, opts DeleteZombieObjects)
 					zap.Int64("Version", int64(last.Version)),
 					zap.String("StreamID", hex.EncodeToString(last.StreamID[:])),
 				)
-				objects = append(objects, last)
+				objects = append(objects, Object{ObjectStream: last, Status: Pending})
 			}
 
 			return nil
@@ -139,7 +147,7 @@ func (db *DB) DeleteZombieObjects(ctx context.Context, opts DeleteZombieObjects)
 			return ObjectStream{}, Error.New("unable to delete zombie objects: %w", err)
 		}
 
-		err = db.deleteObjectsAndSegments(ctx, objects)
+		_, err = db.deleteObjectsAndSegments(ctx, objects, nil)
 		if err != nil {
 			return ObjectStream{}, err
 		}
@@ -148,6 +156,56 @@ func (db *DB) DeleteZombieObjects(ctx context.Context, opts DeleteZombieObjects)
 	})
 }
 
+// DeleteObjectStreams contains all the information necessary to delete a set of objects and their segments.
+type DeleteObjectStreams struct {
+	Objects []ObjectStream
+
+	// Notify returns the events of the deleted objects. The objects passed to it
+	// contain only the object stream.
+	Notify NotifyObjects
+}
+
+// Verify verifies delete object streams fields.
+func (opts *DeleteObjectStreams) Verify() error {
+	for i := range opts.Objects {
+		if err := opts.Objects[i].Verify(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteObjectStreams deletes the specified objects and their segments and returns
+// the objects which were actually deleted. Objects which were replaced by a different
+// stream or which are under retention or legal hold are kept.
+func (db *DB) DeleteObjectStreams(ctx context.Context, opts DeleteObjectStreams) (deleted []ObjectStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+
+	for len(opts.Objects) > 0 {
+		batch := opts.Objects
+		if len(batch) > deleteBatchsizeLimit.Max() {
+			batch = batch[:deleteBatchsizeLimit.Max()]
+		}
+		opts.Objects = opts.Objects[len(batch):]
+
+		objects := make([]Object, len(batch))
+		for i := range batch {
+			objects[i].ObjectStream = batch[i]
+		}
+
+		batchDeleted, err := db.deleteObjectsAndSegments(ctx, objects, opts.Notify)
+		deleted = append(deleted, batchDeleted...)
+		if err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
 func (db *DB) deleteObjectsAndSegmentsBatch(ctx context.Context, batchsize int, deleteBatch func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error)) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -166,11 +224,14 @@ func (db *DB) deleteObjectsAndSegmentsBatch(ctx context.Context, batchsize int,
 	}
 }
 
-func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStream) (err error) {
+// deleteObjectsAndSegments deletes the objects and their segments, and returns the
+// objects which were deleted. The events of an object are stored in the transaction
+// deleting it.
+func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []Object, notify NotifyObjects) (deleted []ObjectStream, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if len(objects) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	err = pgxutil.Conn(ctx, db.db, func(conn *pgx.Conn) error {
@@ -178,16 +239,46 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 		for _, obj := range objects {
 			obj := obj
 
-			batch.Queue(`START TRANSACTION`)
-			batch.Queue(`
+			events, err := notify.events([]Object{obj})
+			if err != nil {
+				return err
+			}
+
+			deleteObject := `
 				DELETE FROM objects
 				WHERE (project_id, bucket_name, object_key, version) = ($1::BYTEA, $2::BYTEA, $3::BYTEA, $4)
 					AND stream_id = $5::BYTEA
-			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID)
+					AND ` + objectNotLocked
+			args := []interface{}{obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID}
+			if len(events) > 0 {
+				// the events are inserted only when the object is deleted, so the
+				// number of affected rows is non-zero exactly when it was deleted.
+				insertEvents, eventArgs := insertObjectEventsQuery(events, len(args)+1, `EXISTS (SELECT 1 FROM deleted_object)`)
+				deleteObject = `WITH deleted_object AS (` + deleteObject + ` RETURNING 1) ` + insertEvents
+				args = append(args, eventArgs...)
+			}
+
+			batch.Queue(`START TRANSACTION`)
+			batch.Queue(deleteObject, args...)
+			// the object may have been locked after it was selected for deletion
 			batch.Queue(`
 				DELETE FROM segments
-				WHERE segments.stream_id = $1::BYTEA
-			`, obj.StreamID)
+				WHERE segments.stream_id = $5::BYTEA
+					AND NOT EXISTS (
+						SELECT 1 FROM objects
+						WHERE (project_id, bucket_name, object_key, version) = ($1::BYTEA, $2::BYTEA, $3::BYTEA, $4)
+							AND stream_id = $5::BYTEA
+					)
+			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID)
+			batch.Queue(`
+				DELETE FROM segment_copies
+				WHERE segment_copies.stream_id = $5::BYTEA
+					AND NOT EXISTS (
+						SELECT 1 FROM objects
+						WHERE (project_id, bucket_name, object_key, version) = ($1::BYTEA, $2::BYTEA, $3::BYTEA, $4)
+							AND stream_id = $5::BYTEA
+					)
+			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID)
 			batch.Queue(`COMMIT TRANSACTION`)
 		}
 
@@ -201,17 +292,19 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 			result, err := results.Exec()
 			errlist.Add(err)
 
-			switch i % 3 {
+			switch i % 5 {
 			case 0: // start transcation
 			case 1: // delete objects
-				if err == nil {
-					objectsDeleted += result.RowsAffected()
+				if err == nil && result.RowsAffected() > 0 {
+					objectsDeleted++
+					deleted = append(deleted, objects[i/5].ObjectStream)
 				}
 			case 2: // delete segments
 				if err == nil {
 					segmentsDeleted += result.RowsAffected()
 				}
-			case 3: // commit transaction
+			case 3: // delete segment copies
+			case 4: // commit transaction
 			}
 		}
 
@@ -221,7 +314,7 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 		return errlist.Err()
 	})
 	if err != nil {
-		return Error.New("unable to delete expired objects: %w", err)
+		return deleted, Error.New("unable to delete objects: %w", err)
 	}
-	return nil
+	return deleted, nil
 }
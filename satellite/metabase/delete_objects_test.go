@@ -10,6 +10,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/metabasetest"
 )
@@ -369,3 +370,82 @@ func TestDeleteZombieObjects(t *testing.T) {
 		})
 	})
 }
+
+func TestDeleteObjectStreams(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj1 := metabasetest.RandObjectStream()
+		obj2 := metabasetest.RandObjectStream()
+
+		t.Run("invalid object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			invalid := obj1
+			invalid.StreamID = uuid.UUID{}
+
+			metabasetest.DeleteObjectStreams{
+				Opts: metabase.DeleteObjectStreams{
+					Objects: []metabase.ObjectStream{obj2, invalid},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "StreamID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("none", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectStreams{}.Check(ctx, t, db)
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("delete objects and segments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj1, 2)
+			metabasetest.CreatePendingObject(ctx, t, db, obj2, 1)
+
+			metabasetest.DeleteObjectStreams{
+				Opts: metabase.DeleteObjectStreams{
+					Objects: []metabase.ObjectStream{obj1, obj2},
+				},
+				Deleted: []metabase.ObjectStream{obj1, obj2},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("keep replaced and locked objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			replaced := metabasetest.CreateObject(ctx, t, db, obj1, 0)
+			locked := metabasetest.CreateObject(ctx, t, db, obj2, 0)
+
+			metabasetest.SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: obj2.Location(),
+					Version:        obj2.Version,
+					LegalHold:      true,
+				},
+			}.Check(ctx, t, db)
+			locked.LegalHold = true
+
+			other := obj1
+			other.StreamID = testrand.UUID()
+
+			metabasetest.DeleteObjectStreams{
+				Opts: metabase.DeleteObjectStreams{
+					Objects: []metabase.ObjectStream{other, obj2},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(replaced),
+					metabase.RawObject(locked),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -79,7 +79,8 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 			segment_count,
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
-			encryption
+			encryption,
+			retain_until, legal_hold
 		FROM objects
 		WHERE
 			project_id   = $1 AND
@@ -95,6 +96,7 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 			&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
 			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
 			encryptionParameters{&object.Encryption},
+			&object.RetainUntil, &object.LegalHold,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -130,28 +132,30 @@ func (db *DB) GetObjectLatestVersion(ctx context.Context, opts GetObjectLatestVe
 	object := Object{}
 	err = db.db.QueryRowContext(ctx, `
 		SELECT
-			stream_id, version,
+			stream_id, version, status,
 			created_at, expires_at,
 			segment_count,
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
-			encryption
+			encryption,
+			retain_until, legal_hold
 		FROM objects
 		WHERE
 			project_id   = $1 AND
 			bucket_name  = $2 AND
 			object_key   = $3 AND
-			status       = `+committedStatus+`
+			status       IN (`+committedStatus+`, `+deleteMarkerStatus+`)
 		ORDER BY version desc
 		LIMIT 1
 	`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey)).
 		Scan(
-			&object.StreamID, &object.Version,
+			&object.StreamID, &object.Version, &object.Status,
 			&object.CreatedAt, &object.ExpiresAt,
 			&object.SegmentCount,
 			&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
 			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
 			encryptionParameters{&object.Encryption},
+			&object.RetainUntil, &object.LegalHold,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -160,12 +164,15 @@ func (db *DB) GetObjectLatestVersion(ctx context.Context, opts GetObjectLatestVe
 		return Object{}, Error.New("unable to query object status: %w", err)
 	}
 
+	// the latest version being a delete marker means that the object was deleted
+	if object.Status == DeleteMarker {
+		return Object{}, storj.ErrObjectNotFound.Wrap(Error.New("object was deleted"))
+	}
+
 	object.ProjectID = opts.ProjectID
 	object.BucketName = opts.BucketName
 	object.ObjectKey = opts.ObjectKey
 
-	object.Status = Committed
-
 	return object, nil
 }
 
@@ -191,7 +198,8 @@ func (db *DB) GetSegmentByLocation(ctx context.Context, opts GetSegmentByLocatio
 				encrypted_size, plain_offset, plain_size,
 				encrypted_etag,
 				redundancy,
-				inline_data, remote_alias_pieces
+				inline_data, remote_alias_pieces,
+				placement
 			FROM segments
 			WHERE
 				stream_id IN (SELECT stream_id FROM objects WHERE
@@ -211,6 +219,7 @@ func (db *DB) GetSegmentByLocation(ctx context.Context, opts GetSegmentByLocatio
 			&segment.EncryptedETag,
 			redundancyScheme{&segment.Redundancy},
 			&segment.InlineData, &aliasPieces,
+			&segment.Placement,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -258,7 +267,8 @@ func (db *DB) GetSegmentByPosition(ctx context.Context, opts GetSegmentByPositio
 			encrypted_size, plain_offset, plain_size,
 			encrypted_etag,
 			redundancy,
-			inline_data, remote_alias_pieces
+			inline_data, remote_alias_pieces,
+			placement
 		FROM segments
 		WHERE
 			stream_id = $1 AND
@@ -271,6 +281,7 @@ func (db *DB) GetSegmentByPosition(ctx context.Context, opts GetSegmentByPositio
 			&segment.EncryptedETag,
 			redundancyScheme{&segment.Redundancy},
 			&segment.InlineData, &aliasPieces,
+			&segment.Placement,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -312,14 +323,15 @@ func (db *DB) GetLatestObjectLastSegment(ctx context.Context, opts GetLatestObje
 			encrypted_size, plain_offset, plain_size,
 			encrypted_etag,
 			redundancy,
-			inline_data, remote_alias_pieces
+			inline_data, remote_alias_pieces,
+			placement
 		FROM segments
 		WHERE
 			stream_id IN (SELECT stream_id FROM objects WHERE
 				project_id   = $1 AND
 				bucket_name  = $2 AND
 				object_key   = $3 AND
-				status       = `+committedStatus+`
+				status       IN (`+committedStatus+`, `+deleteMarkerStatus+`)
 				ORDER BY version DESC
 				LIMIT 1
 			)
@@ -334,6 +346,7 @@ func (db *DB) GetLatestObjectLastSegment(ctx context.Context, opts GetLatestObje
 			&segment.EncryptedETag,
 			redundancyScheme{&segment.Redundancy},
 			&segment.InlineData, &aliasPieces,
+			&segment.Placement,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -377,14 +390,15 @@ func (db *DB) GetSegmentByOffset(ctx context.Context, opts GetSegmentByOffset) (
 			encrypted_size, plain_offset, plain_size,
 			encrypted_etag,
 			redundancy,
-			inline_data, remote_alias_pieces
+			inline_data, remote_alias_pieces,
+			placement
 		FROM segments
 		WHERE
 			stream_id IN (SELECT stream_id FROM objects WHERE
 				project_id   = $1 AND
 				bucket_name  = $2 AND
 				object_key   = $3 AND
-				status       = `+committedStatus+`
+				status       IN (`+committedStatus+`, `+deleteMarkerStatus+`)
 				ORDER BY version DESC
 				LIMIT 1
 			) AND
@@ -401,6 +415,7 @@ func (db *DB) GetSegmentByOffset(ctx context.Context, opts GetSegmentByOffset) (
 			&segment.EncryptedETag,
 			redundancyScheme{&segment.Redundancy},
 			&segment.InlineData, &aliasPieces,
+			&segment.Placement,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
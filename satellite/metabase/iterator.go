@@ -105,6 +105,73 @@ func iterateAllVersionsWithStatus(ctx context.Context, db *DB, opts IterateObjec
 	return iterate(ctx, it, fn)
 }
 
+func iterateLatestVersions(ctx context.Context, db *DB, opts IterateObjectsLatestVersion, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	it := &objectsIterator{
+		db: db,
+
+		projectID:       opts.ProjectID,
+		bucketName:      []byte(opts.BucketName),
+		status:          Committed,
+		prefix:          opts.Prefix,
+		prefixLimit:     prefixLimit(opts.Prefix),
+		batchSize:       opts.BatchSize,
+		recursive:       opts.Recursive,
+		includeMetadata: opts.IncludeMetadata,
+
+		curIndex: 0,
+		cursor:   firstIterateCursor(opts.Recursive, opts.Cursor, opts.Prefix),
+
+		doNextQuery: doNextQueryLatestVersions,
+	}
+
+	// only a single version is listed for each key, so the cursor needs to skip all of them
+	if !it.cursor.Inclusive {
+		it.cursor.Version = MaxVersion
+	}
+
+	// start from either the cursor or prefix, depending on which is larger
+	if lessKey(it.cursor.Key, opts.Prefix) {
+		it.cursor.Key = opts.Prefix
+		it.cursor.Version = -1
+		it.cursor.Inclusive = true
+	}
+
+	return iterate(ctx, it, fn)
+}
+
+func iterateVersions(ctx context.Context, db *DB, opts IterateObjectVersions, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	it := &objectsIterator{
+		db: db,
+
+		projectID:       opts.ProjectID,
+		bucketName:      []byte(opts.BucketName),
+		status:          Committed,
+		prefix:          opts.Prefix,
+		prefixLimit:     prefixLimit(opts.Prefix),
+		batchSize:       opts.BatchSize,
+		recursive:       opts.Recursive,
+		includeMetadata: opts.IncludeMetadata,
+
+		curIndex: 0,
+		cursor:   firstIterateCursor(opts.Recursive, opts.Cursor, opts.Prefix),
+
+		doNextQuery: doNextQueryVersions,
+	}
+
+	// start from either the cursor or prefix, depending on which is larger
+	if lessKey(it.cursor.Key, opts.Prefix) {
+		it.cursor.Key = opts.Prefix
+		it.cursor.Version = -1
+		it.cursor.Inclusive = true
+	}
+
+	return iterate(ctx, it, fn)
+}
+
 func iteratePendingObjectsByKey(ctx context.Context, db *DB, opts IteratePendingObjectsByKey, fn func(context.Context, ObjectsIterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -365,6 +432,137 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 	)
 }
 
+// doNextQueryLatestVersions returns only the latest committed version of each object.
+// Objects which latest version is a delete marker are not returned.
+func doNextQueryLatestVersions(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	metadataQuerySelectFields := ""
+	if it.includeMetadata {
+		metadataQuerySelectFields = "encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,"
+	}
+
+	cursorCompare := ">"
+	if it.cursor.Inclusive {
+		cursorCompare = ">="
+	}
+
+	latestVersion := `
+		version = (
+			SELECT max(latest.version) FROM objects AS latest
+			WHERE
+				latest.project_id = objects.project_id AND
+				latest.bucket_name = objects.bucket_name AND
+				latest.object_key = objects.object_key AND
+				latest.status IN (` + committedStatus + `, ` + deleteMarkerStatus + `)
+		)`
+
+	if it.prefixLimit == "" {
+		return it.db.db.QueryContext(ctx, `
+			SELECT
+				object_key, stream_id, version, status,
+				created_at, expires_at,
+				segment_count,
+				`+metadataQuerySelectFields+`
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption
+			FROM objects
+			WHERE
+				(project_id, bucket_name, object_key, version) `+cursorCompare+` ($1, $2, $3, $4)
+				AND (project_id, bucket_name) < ($1, $6)
+				AND status = `+committedStatus+`
+				AND `+latestVersion+`
+				ORDER BY (project_id, bucket_name, object_key, version) ASC
+			LIMIT $5
+			`, it.projectID, it.bucketName,
+			[]byte(it.cursor.Key), int(it.cursor.Version),
+			it.batchSize,
+			nextBucket(it.bucketName),
+		)
+	}
+
+	return it.db.db.QueryContext(ctx, `
+		SELECT
+			object_key, stream_id, version, status,
+			created_at, expires_at,
+			segment_count,
+			`+metadataQuerySelectFields+`
+			total_plain_size, total_encrypted_size, fixed_segment_size,
+			encryption
+		FROM objects
+		WHERE
+			(project_id, bucket_name, object_key, version) `+cursorCompare+` ($1, $2, $3, $4)
+			AND (project_id, bucket_name, object_key) < ($1, $2, $5)
+			AND status = `+committedStatus+`
+			AND `+latestVersion+`
+			ORDER BY (project_id, bucket_name, object_key, version) ASC
+		LIMIT $6
+	`, it.projectID, it.bucketName,
+		[]byte(it.cursor.Key), int(it.cursor.Version),
+		[]byte(it.prefixLimit),
+		it.batchSize,
+	)
+}
+
+// doNextQueryVersions returns all committed versions and delete markers of objects.
+func doNextQueryVersions(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	metadataQuerySelectFields := ""
+	if it.includeMetadata {
+		metadataQuerySelectFields = "encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,"
+	}
+
+	cursorCompare := ">"
+	if it.cursor.Inclusive {
+		cursorCompare = ">="
+	}
+
+	if it.prefixLimit == "" {
+		return it.db.db.QueryContext(ctx, `
+			SELECT
+				object_key, stream_id, version, status,
+				created_at, expires_at,
+				segment_count,
+				`+metadataQuerySelectFields+`
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption
+			FROM objects
+			WHERE
+				(project_id, bucket_name, object_key, version) `+cursorCompare+` ($1, $2, $3, $4)
+				AND (project_id, bucket_name) < ($1, $6)
+				AND status IN (`+committedStatus+`, `+deleteMarkerStatus+`)
+				ORDER BY (project_id, bucket_name, object_key, version) ASC
+			LIMIT $5
+			`, it.projectID, it.bucketName,
+			[]byte(it.cursor.Key), int(it.cursor.Version),
+			it.batchSize,
+			nextBucket(it.bucketName),
+		)
+	}
+
+	return it.db.db.QueryContext(ctx, `
+		SELECT
+			object_key, stream_id, version, status,
+			created_at, expires_at,
+			segment_count,
+			`+metadataQuerySelectFields+`
+			total_plain_size, total_encrypted_size, fixed_segment_size,
+			encryption
+		FROM objects
+		WHERE
+			(project_id, bucket_name, object_key, version) `+cursorCompare+` ($1, $2, $3, $4)
+			AND (project_id, bucket_name, object_key) < ($1, $2, $5)
+			AND status IN (`+committedStatus+`, `+deleteMarkerStatus+`)
+			ORDER BY (project_id, bucket_name, object_key, version) ASC
+		LIMIT $6
+	`, it.projectID, it.bucketName,
+		[]byte(it.cursor.Key), int(it.cursor.Version),
+		[]byte(it.prefixLimit),
+		it.batchSize,
+	)
+}
+
 // nextBucket returns the lexicographically next bucket.
 func nextBucket(b []byte) []byte {
 	xs := make([]byte, len(b)+1)
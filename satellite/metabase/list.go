@@ -128,6 +128,74 @@ func (opts *IterateObjectsWithStatus) Verify() error {
 	return nil
 }
 
+// IterateObjectsLatestVersion contains arguments necessary for listing the latest versions of objects in a bucket.
+type IterateObjectsLatestVersion struct {
+	ProjectID       uuid.UUID
+	BucketName      string
+	Recursive       bool
+	BatchSize       int
+	Prefix          ObjectKey
+	Cursor          IterateCursor
+	IncludeMetadata bool
+}
+
+// IterateObjectsLatestVersion iterates through the latest committed versions of all objects.
+// Objects, which latest version is a delete marker, are skipped.
+func (db *DB) IterateObjectsLatestVersion(ctx context.Context, opts IterateObjectsLatestVersion, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err = opts.Verify(); err != nil {
+		return err
+	}
+	return iterateLatestVersions(ctx, db, opts, fn)
+}
+
+// Verify verifies get object request fields.
+func (opts *IterateObjectsLatestVersion) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return ErrInvalidRequest.New("BucketName missing")
+	case opts.BatchSize < 0:
+		return ErrInvalidRequest.New("BatchSize is negative")
+	}
+	return nil
+}
+
+// IterateObjectVersions contains arguments necessary for listing all versions of objects in a bucket.
+type IterateObjectVersions struct {
+	ProjectID       uuid.UUID
+	BucketName      string
+	Recursive       bool
+	BatchSize       int
+	Prefix          ObjectKey
+	Cursor          IterateCursor
+	IncludeMetadata bool
+}
+
+// IterateObjectVersions iterates through all committed versions and delete markers of all objects.
+// The versions of an object are ordered from the oldest to the latest.
+func (db *DB) IterateObjectVersions(ctx context.Context, opts IterateObjectVersions, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err = opts.Verify(); err != nil {
+		return err
+	}
+	return iterateVersions(ctx, db, opts, fn)
+}
+
+// Verify verifies get object request fields.
+func (opts *IterateObjectVersions) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return ErrInvalidRequest.New("BucketName missing")
+	case opts.BatchSize < 0:
+		return ErrInvalidRequest.New("BatchSize is negative")
+	}
+	return nil
+}
+
 // IteratePendingObjectsByKey iterates through all streams of pending objects with the same ObjectKey.
 func (db *DB) IteratePendingObjectsByKey(ctx context.Context, opts IteratePendingObjectsByKey, fn func(context.Context, ObjectsIterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -48,7 +48,8 @@ func (db *DB) ListSegments(ctx context.Context, opts ListSegments) (result ListS
 			encrypted_size, plain_offset, plain_size,
 			encrypted_etag,
 			redundancy,
-			inline_data, remote_alias_pieces
+			inline_data, remote_alias_pieces,
+			placement
 		FROM segments
 		WHERE
 			stream_id = $1 AND
@@ -67,6 +68,7 @@ func (db *DB) ListSegments(ctx context.Context, opts ListSegments) (result ListS
 				&segment.EncryptedETag,
 				redundancyScheme{&segment.Redundancy},
 				&segment.InlineData, &aliasPieces,
+				&segment.Placement,
 			)
 			if err != nil {
 				return Error.New("failed to scan segments: %w", err)
@@ -15,6 +15,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/placement"
 )
 
 const loopIteratorBatchSizeLimit = 2500
@@ -221,6 +222,7 @@ type LoopSegmentEntry struct {
 	PlainSize     int32 // verify
 	Redundancy    storj.RedundancyScheme
 	Pieces        Pieces
+	Placement     placement.Placement
 }
 
 // Inline returns true if segment is inline.
@@ -258,7 +260,8 @@ func (db *DB) IterateLoopStreams(ctx context.Context, opts IterateLoopStreams, h
 			encrypted_size,
 			plain_offset, plain_size,
 			redundancy,
-			remote_alias_pieces
+			remote_alias_pieces,
+			placement
 		FROM segments
 		`+db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
 		WHERE
@@ -305,6 +308,7 @@ func (db *DB) IterateLoopStreams(ctx context.Context, opts IterateLoopStreams, h
 				&segment.PlainOffset, &segment.PlainSize,
 				redundancyScheme{&segment.Redundancy},
 				&aliasPieces,
+				&segment.Placement,
 			)
 			if err != nil {
 				internalError = Error.New("failed to scan segments: %w", err)
@@ -471,7 +475,8 @@ func (it *loopSegmentIterator) doNextQuery(ctx context.Context) (_ tagsql.Rows,
 			encrypted_size,
 			plain_offset, plain_size,
 			redundancy,
-			remote_alias_pieces
+			remote_alias_pieces,
+			placement
 		FROM segments
 		`+it.db.asOfTime(it.asOfSystemTime, it.asOfSystemInterval)+`
 		WHERE
@@ -494,6 +499,7 @@ func (it *loopSegmentIterator) scanItem(ctx context.Context, item *LoopSegmentEn
 		&item.PlainOffset, &item.PlainSize,
 		redundancyScheme{&item.Redundancy},
 		&aliasPieces,
+		&item.Placement,
 	)
 	if err != nil {
 		return Error.New("failed to scan segments: %w", err)
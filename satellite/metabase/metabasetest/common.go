@@ -39,12 +39,23 @@ func (step Verify) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB
 	sortRawObjects(step.Objects)
 	sortRawSegments(state.Segments)
 	sortRawSegments(step.Segments)
+	sortRawCopies(state.Copies)
+	sortRawCopies(step.Copies)
 
 	diff := cmp.Diff(metabase.RawState(step), *state,
 		cmpopts.EquateApproxTime(5*time.Second))
 	require.Zero(t, diff)
 }
 
+// SegmentsToRaw converts segments to raw segments.
+func SegmentsToRaw(segments []metabase.Segment) []metabase.RawSegment {
+	rawSegments := []metabase.RawSegment{}
+	for _, segment := range segments {
+		rawSegments = append(rawSegments, metabase.RawSegment(segment))
+	}
+	return rawSegments
+}
+
 func sortObjects(objects []metabase.Object) {
 	sort.Slice(objects, func(i, j int) bool {
 		return bytes.Compare(objects[i].StreamID[:], objects[j].StreamID[:]) < 0
@@ -63,6 +74,12 @@ func sortRawSegments(segments []metabase.RawSegment) {
 	})
 }
 
+func sortRawCopies(copies []metabase.RawCopy) {
+	sort.Slice(copies, func(i, j int) bool {
+		return bytes.Compare(copies[i].StreamID[:], copies[j].StreamID[:]) < 0
+	})
+}
+
 func sortDeletedSegments(segments []metabase.DeletedSegmentInfo) {
 	sort.Slice(segments, func(i, j int) bool {
 		return bytes.Compare(segments[i].RootPieceID[:], segments[j].RootPieceID[:]) < 0
@@ -181,6 +181,71 @@ func (step UpdateSegmentPieces) Check(ctx *testcontext.Context, t testing.TB, db
 	checkError(t, err, step.ErrClass, step.ErrText)
 }
 
+// BeginMoveObject is for testing metabase.BeginMoveObject.
+type BeginMoveObject struct {
+	Opts     metabase.BeginMoveObject
+	Result   metabase.BeginMoveObjectResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step BeginMoveObject) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.BeginMoveObject(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result)
+	require.Zero(t, diff)
+}
+
+// FinishMoveObject is for testing metabase.FinishMoveObject.
+type FinishMoveObject struct {
+	Opts     metabase.FinishMoveObject
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step FinishMoveObject) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	err := db.FinishMoveObject(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
+// BeginCopyObject is for testing metabase.BeginCopyObject.
+type BeginCopyObject struct {
+	Opts     metabase.BeginCopyObject
+	Result   metabase.BeginCopyObjectResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step BeginCopyObject) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.BeginCopyObject(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result)
+	require.Zero(t, diff)
+}
+
+// FinishCopyObject is for testing metabase.FinishCopyObject.
+type FinishCopyObject struct {
+	Opts     metabase.FinishCopyObject
+	Result   metabase.Object
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step FinishCopyObject) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) metabase.Object {
+	result, err := db.FinishCopyObject(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, cmpopts.EquateApproxTime(5*time.Second))
+	require.Zero(t, diff)
+	return result
+}
+
 // GetObjectExactVersion is for testing metabase.GetObjectExactVersion.
 type GetObjectExactVersion struct {
 	Opts     metabase.GetObjectExactVersion
@@ -435,6 +500,27 @@ func (step DeleteObjectExactVersion) Check(ctx *testcontext.Context, t testing.T
 	require.Zero(t, diff)
 }
 
+// CreateDeleteMarker is for testing metabase.CreateDeleteMarker.
+type CreateDeleteMarker struct {
+	Opts     metabase.CreateDeleteMarker
+	Result   metabase.Object
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step CreateDeleteMarker) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) metabase.Object {
+	result, err := db.CreateDeleteMarker(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	// stream id of the delete marker is randomly generated
+	diff := cmp.Diff(step.Result, result,
+		cmpopts.EquateApproxTime(5*time.Second),
+		cmpopts.IgnoreFields(metabase.Object{}, "ObjectStream.StreamID"))
+	require.Zero(t, diff)
+	return result
+}
+
 // DeletePendingObject is for testing metabase.DeletePendingObject.
 type DeletePendingObject struct {
 	Opts     metabase.DeletePendingObject
@@ -541,6 +627,22 @@ func (step DeleteExpiredObjects) Check(ctx *testcontext.Context, t testing.TB, d
 	checkError(t, err, step.ErrClass, step.ErrText)
 }
 
+// DeleteObjectStreams is for testing metabase.DeleteObjectStreams.
+type DeleteObjectStreams struct {
+	Opts    metabase.DeleteObjectStreams
+	Deleted []metabase.ObjectStream
+
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step DeleteObjectStreams) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	deleted, err := db.DeleteObjectStreams(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	require.Equal(t, step.Deleted, deleted)
+}
+
 // DeleteZombieObjects is for testing metabase.DeleteZombieObjects.
 type DeleteZombieObjects struct {
 	Opts metabase.DeleteZombieObjects
@@ -555,6 +657,50 @@ func (step DeleteZombieObjects) Check(ctx *testcontext.Context, t testing.TB, db
 	checkError(t, err, step.ErrClass, step.ErrText)
 }
 
+// SetObjectRetention is for testing metabase.SetObjectRetention.
+type SetObjectRetention struct {
+	Opts metabase.SetObjectRetention
+
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step SetObjectRetention) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	err := db.SetObjectRetention(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
+// SetObjectLegalHold is for testing metabase.SetObjectLegalHold.
+type SetObjectLegalHold struct {
+	Opts metabase.SetObjectLegalHold
+
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step SetObjectLegalHold) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	err := db.SetObjectLegalHold(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
+// HasLockedObjects is for testing metabase.HasLockedObjects.
+type HasLockedObjects struct {
+	Opts   metabase.HasLockedObjects
+	Result bool
+
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step HasLockedObjects) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.HasLockedObjects(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	require.Equal(t, step.Result, result)
+}
+
 // IterateCollector is for testing metabase.IterateCollector.
 type IterateCollector []metabase.ObjectEntry
 
@@ -647,6 +793,46 @@ func (step IterateObjectsWithStatus) Check(ctx *testcontext.Context, t testing.T
 	require.Zero(t, diff)
 }
 
+// IterateObjectsLatestVersion is for testing metabase.IterateObjectsLatestVersion.
+type IterateObjectsLatestVersion struct {
+	Opts metabase.IterateObjectsLatestVersion
+
+	Result   []metabase.ObjectEntry
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step IterateObjectsLatestVersion) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	var result IterateCollector
+
+	err := db.IterateObjectsLatestVersion(ctx, step.Opts, result.Add)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, []metabase.ObjectEntry(result), cmpopts.EquateApproxTime(5*time.Second))
+	require.Zero(t, diff)
+}
+
+// IterateObjectVersions is for testing metabase.IterateObjectVersions.
+type IterateObjectVersions struct {
+	Opts metabase.IterateObjectVersions
+
+	Result   []metabase.ObjectEntry
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step IterateObjectVersions) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	var result IterateCollector
+
+	err := db.IterateObjectVersions(ctx, step.Opts, result.Add)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, []metabase.ObjectEntry(result), cmpopts.EquateApproxTime(5*time.Second))
+	require.Zero(t, diff)
+}
+
 // IterateLoopObjects is for testing metabase.IterateLoopObjects.
 type IterateLoopObjects struct {
 	Opts metabase.IterateLoopObjects
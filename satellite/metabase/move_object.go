@@ -0,0 +1,319 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	pgxerrcode "github.com/jackc/pgerrcode"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// ErrObjectAlreadyExists is used to indicate that an object already exists at the target location.
+var ErrObjectAlreadyExists = errs.Class("metabase: object already exists")
+
+// EncryptedKeyAndNonce holds single segment position, encrypted key and nonce.
+type EncryptedKeyAndNonce struct {
+	Position          SegmentPosition
+	EncryptedKeyNonce []byte
+	EncryptedKey      []byte
+}
+
+// BeginMoveObjectResult holds data needed to finish a move object.
+type BeginMoveObjectResult struct {
+	StreamID uuid.UUID
+	Version  Version
+
+	EncryptedMetadataKeyNonce []byte
+	EncryptedMetadataKey      []byte
+
+	EncryptedKeysNonces  []EncryptedKeyAndNonce
+	EncryptionParameters storj.EncryptionParameters
+}
+
+// BeginMoveObject holds all data needed to begin a move object method.
+type BeginMoveObject struct {
+	Version Version
+	ObjectLocation
+}
+
+// BeginMoveObject collects all data needed to begin a move object procedure.
+//
+// The client needs the segment keys to re-encrypt them with the key derived
+// from the new object location.
+func (db *DB) BeginMoveObject(ctx context.Context, opts BeginMoveObject) (result BeginMoveObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return BeginMoveObjectResult{}, err
+	}
+
+	if opts.Version <= 0 {
+		return BeginMoveObjectResult{}, ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	}
+
+	object, err := db.GetObjectExactVersion(ctx, GetObjectExactVersion{
+		Version:        opts.Version,
+		ObjectLocation: opts.ObjectLocation,
+	})
+	if err != nil {
+		return BeginMoveObjectResult{}, err
+	}
+
+	keys, err := db.getSegmentKeys(ctx, object.StreamID)
+	if err != nil {
+		return BeginMoveObjectResult{}, err
+	}
+
+	return BeginMoveObjectResult{
+		StreamID:                  object.StreamID,
+		Version:                   object.Version,
+		EncryptedMetadataKeyNonce: object.EncryptedMetadataNonce,
+		EncryptedMetadataKey:      object.EncryptedMetadataEncryptedKey,
+		EncryptedKeysNonces:       keys,
+		EncryptionParameters:      object.Encryption,
+	}, nil
+}
+
+// getSegmentKeys returns the encrypted keys of all segments in a stream, ordered by position.
+func (db *DB) getSegmentKeys(ctx context.Context, streamID uuid.UUID) (keys []EncryptedKeyAndNonce, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			position, encrypted_key_nonce, encrypted_key
+		FROM segments
+		WHERE stream_id = $1
+		ORDER BY position ASC
+	`, streamID))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var key EncryptedKeyAndNonce
+			err := rows.Scan(&key.Position, &key.EncryptedKeyNonce, &key.EncryptedKey)
+			if err != nil {
+				return err
+			}
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to fetch object segments: %w", err)
+	}
+
+	return keys, nil
+}
+
+// FinishMoveObject holds all data needed to finish object move.
+type FinishMoveObject struct {
+	ObjectStream
+	NewBucket             string
+	NewSegmentKeys        []EncryptedKeyAndNonce
+	NewEncryptedObjectKey []byte
+	// Optional. Required if object has metadata.
+	NewEncryptedMetadataKeyNonce storj.Nonce
+	NewEncryptedMetadataKey      []byte
+
+	// NewBucketVersioned allows moving the object onto a committed object in a
+	// versioned bucket. The moved object becomes the latest version of the key.
+	NewBucketVersioned bool
+}
+
+// Verify verifies metabase.FinishMoveObject data.
+func (finishMove FinishMoveObject) Verify() error {
+	if err := finishMove.ObjectStream.Verify(); err != nil {
+		return err
+	}
+
+	switch {
+	case len(finishMove.NewBucket) == 0:
+		return ErrInvalidRequest.New("NewBucket is missing")
+	case len(finishMove.NewEncryptedObjectKey) == 0:
+		return ErrInvalidRequest.New("NewEncryptedObjectKey is missing")
+	}
+
+	return verifySegmentKeys(finishMove.NewSegmentKeys)
+}
+
+// verifySegmentKeys checks that the new segment keys are complete and unique.
+func verifySegmentKeys(keys []EncryptedKeyAndNonce) error {
+	positions := make(map[SegmentPosition]struct{}, len(keys))
+	for _, key := range keys {
+		if len(key.EncryptedKey) == 0 {
+			return ErrInvalidRequest.New("EncryptedKey missing for segment %v", key.Position)
+		}
+		if len(key.EncryptedKeyNonce) == 0 {
+			return ErrInvalidRequest.New("EncryptedKeyNonce missing for segment %v", key.Position)
+		}
+		if _, exists := positions[key.Position]; exists {
+			return ErrInvalidRequest.New("duplicated segment key for segment %v", key.Position)
+		}
+		positions[key.Position] = struct{}{}
+	}
+	return nil
+}
+
+// FinishMoveObject accepts new encryption keys for moved object and updates the corresponding object ObjectKey and segments EncryptedKey.
+func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		err = checkMoveOrCopyTarget(ctx, tx, ObjectLocation{
+			ProjectID:  opts.ProjectID,
+			BucketName: opts.NewBucket,
+			ObjectKey:  ObjectKey(opts.NewEncryptedObjectKey),
+		}, opts.NewBucketVersioned)
+		if err != nil {
+			return err
+		}
+
+		var segmentCount int32
+		err = tx.QueryRowContext(ctx, `
+			UPDATE objects SET
+				bucket_name = $1,
+				object_key = $2,
+				version = coalesce((
+					SELECT max(version) + 1
+					FROM objects
+					WHERE project_id = $5 AND bucket_name = $1 AND object_key = $2
+				), objects.version),
+				encrypted_metadata_encrypted_key = CASE WHEN objects.encrypted_metadata IS NOT NULL
+					THEN $3
+					ELSE objects.encrypted_metadata_encrypted_key
+				END,
+				encrypted_metadata_nonce = CASE WHEN objects.encrypted_metadata IS NOT NULL
+					THEN $4
+					ELSE objects.encrypted_metadata_nonce
+				END
+			WHERE
+				project_id   = $5 AND
+				bucket_name  = $6 AND
+				object_key   = $7 AND
+				version      = $8 AND
+				stream_id    = $9 AND
+				status       = `+committedStatus+` AND
+				`+objectNotLocked+`
+			RETURNING segment_count
+		`, []byte(opts.NewBucket), opts.NewEncryptedObjectKey,
+			opts.NewEncryptedMetadataKey, opts.NewEncryptedMetadataKeyNonce[:],
+			opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version, opts.StreamID).
+			Scan(&segmentCount)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// moving the object would remove it from its original location
+				locked, err := db.lockedObjectExists(ctx, opts.Location(), opts.Version)
+				if err != nil {
+					return err
+				}
+				if locked {
+					return ErrObjectLocked.New("")
+				}
+				return storj.ErrObjectNotFound.Wrap(Error.New("object not found"))
+			}
+			if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
+				return ErrObjectAlreadyExists.New("")
+			}
+			return Error.New("unable to update object: %w", err)
+		}
+
+		if int(segmentCount) != len(opts.NewSegmentKeys) {
+			return ErrInvalidRequest.New("wrong amount of segments keys received (received %d, need %d)", len(opts.NewSegmentKeys), segmentCount)
+		}
+
+		return updateSegmentKeys(ctx, tx, opts.StreamID, opts.NewSegmentKeys)
+	})
+	if err != nil {
+		return err
+	}
+
+	mon.Meter("finish_move_object").Mark(1)
+
+	return nil
+}
+
+// checkMoveOrCopyTarget checks whether an object may be moved or copied to the
+// location. As with uploads, a committed latest version blocks the target key
+// unless the bucket is versioned. Pending objects and delete markers never do.
+func checkMoveOrCopyTarget(ctx context.Context, tx tagsql.Tx, location ObjectLocation, versioned bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if versioned {
+		return nil
+	}
+
+	var status ObjectStatus
+	err = tx.QueryRowContext(ctx, `
+		SELECT status FROM objects
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			status       IN (`+committedStatus+`, `+deleteMarkerStatus+`)
+		ORDER BY version DESC
+		LIMIT 1
+	`, location.ProjectID, []byte(location.BucketName), []byte(location.ObjectKey)).Scan(&status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return Error.New("unable to check object existence: %w", err)
+	}
+	if status == Committed {
+		return ErrObjectAlreadyExists.New("")
+	}
+	return nil
+}
+
+// updateSegmentKeys replaces the encrypted keys of the segments in the stream.
+func updateSegmentKeys(ctx context.Context, tx tagsql.Tx, streamID uuid.UUID, keys []EncryptedKeyAndNonce) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	var positions []int64
+	var encryptedKeys [][]byte
+	var encryptedKeyNonces [][]byte
+	for _, key := range keys {
+		positions = append(positions, int64(key.Position.Encode()))
+		encryptedKeys = append(encryptedKeys, key.EncryptedKey)
+		encryptedKeyNonces = append(encryptedKeyNonces, key.EncryptedKeyNonce)
+	}
+
+	updateResult, err := tx.ExecContext(ctx, `
+		UPDATE segments SET
+			encrypted_key_nonce = P.encrypted_key_nonce,
+			encrypted_key = P.encrypted_key
+		FROM (SELECT unnest($2::INT8[]), unnest($3::BYTEA[]), unnest($4::BYTEA[])) as P(position, encrypted_key_nonce, encrypted_key)
+		WHERE
+			segments.stream_id = $1 AND
+			segments.position = P.position
+	`, streamID, pgutil.Int8Array(positions), pgutil.ByteaArray(encryptedKeyNonces), pgutil.ByteaArray(encryptedKeys))
+	if err != nil {
+		return Error.New("unable to update segments keys: %w", err)
+	}
+
+	affected, err := updateResult.RowsAffected()
+	if err != nil {
+		return Error.New("unable to get number of affected segments: %w", err)
+	}
+	if affected != int64(len(keys)) {
+		return ErrInvalidRequest.New("segment keys don't match object segments, expected %d got %d", len(keys), affected)
+	}
+
+	return nil
+}
@@ -0,0 +1,319 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestBeginMoveObject(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		for _, test := range metabasetest.InvalidObjectLocations(obj.Location()) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.BeginMoveObject{
+					Opts: metabase.BeginMoveObject{
+						Version:        1,
+						ObjectLocation: test.ObjectLocation,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("invalid version", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BeginMoveObject{
+				Opts: metabase.BeginMoveObject{
+					Version:        0,
+					ObjectLocation: obj.Location(),
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Version invalid: 0",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("object missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BeginMoveObject{
+				Opts: metabase.BeginMoveObject{
+					Version:        obj.Version,
+					ObjectLocation: obj.Location(),
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("begin move object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+			metabasetest.BeginMoveObject{
+				Opts: metabase.BeginMoveObject{
+					Version:        obj.Version,
+					ObjectLocation: obj.Location(),
+				},
+				Result: metabase.BeginMoveObjectResult{
+					StreamID: obj.StreamID,
+					Version:  obj.Version,
+					EncryptedKeysNonces: []metabase.EncryptedKeyAndNonce{
+						{
+							Position:          metabase.SegmentPosition{Index: 0},
+							EncryptedKeyNonce: []byte{4},
+							EncryptedKey:      []byte{3},
+						},
+						{
+							Position:          metabase.SegmentPosition{Index: 1},
+							EncryptedKeyNonce: []byte{4},
+							EncryptedKey:      []byte{3},
+						},
+					},
+					EncryptionParameters: object.Encryption,
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestFinishMoveObject(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		newBucketName := "New bucket name"
+
+		for _, test := range metabasetest.InvalidObjectStreams(obj) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.FinishMoveObject{
+					Opts: metabase.FinishMoveObject{
+						ObjectStream:          test.ObjectStream,
+						NewBucket:             newBucketName,
+						NewEncryptedObjectKey: []byte{1, 2, 3},
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("missing new bucket", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewEncryptedObjectKey: []byte{1, 2, 3},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewBucket is missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("missing new object key", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream: obj,
+					NewBucket:    newBucketName,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewEncryptedObjectKey is missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("duplicated segment keys", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			key := metabase.EncryptedKeyAndNonce{
+				Position:          metabase.SegmentPosition{Index: 0},
+				EncryptedKeyNonce: testrand.Nonce().Bytes(),
+				EncryptedKey:      testrand.Bytes(32),
+			}
+
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: []byte{1, 2, 3},
+					NewSegmentKeys:        []metabase.EncryptedKeyAndNonce{key, key},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "duplicated segment key for segment {0 0}",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("object missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: []byte{1, 2, 3},
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("wrong number of segment keys", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: []byte{1, 2, 3},
+					NewSegmentKeys: []metabase.EncryptedKeyAndNonce{
+						{
+							Position:          metabase.SegmentPosition{Index: 0},
+							EncryptedKeyNonce: testrand.Nonce().Bytes(),
+							EncryptedKey:      testrand.Bytes(32),
+						},
+					},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "wrong amount of segments keys received (received 1, need 2)",
+			}.Check(ctx, t, db)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+
+			metabasetest.Verify{
+				Objects:  []metabase.RawObject{metabase.RawObject(object)},
+				Segments: metabasetest.SegmentsToRaw(segments),
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("target already exists", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			target := metabasetest.RandObjectStream()
+			target.ProjectID = obj.ProjectID
+			target.BucketName = newBucketName
+			targetObject := metabasetest.CreateObject(ctx, t, db, target, 0)
+
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             target.BucketName,
+					NewEncryptedObjectKey: []byte(target.ObjectKey),
+				},
+				ErrClass: &metabase.ErrObjectAlreadyExists,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+					metabase.RawObject(targetObject),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("target exists in versioned bucket", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			target := metabasetest.RandObjectStream()
+			target.ProjectID = obj.ProjectID
+			target.BucketName = newBucketName
+			targetObject := metabasetest.CreateObject(ctx, t, db, target, 0)
+
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             target.BucketName,
+					NewEncryptedObjectKey: []byte(target.ObjectKey),
+					NewBucketVersioned:    true,
+				},
+			}.Check(ctx, t, db)
+
+			object.BucketName = target.BucketName
+			object.ObjectKey = target.ObjectKey
+			object.Version = target.Version + 1
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+					metabase.RawObject(targetObject),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("finish move object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+
+			newKeys := []metabase.EncryptedKeyAndNonce{}
+			for i := range segments {
+				key := metabase.EncryptedKeyAndNonce{
+					Position:          segments[i].Position,
+					EncryptedKeyNonce: testrand.Nonce().Bytes(),
+					EncryptedKey:      testrand.Bytes(32),
+				}
+				newKeys = append(newKeys, key)
+
+				segments[i].EncryptedKeyNonce = key.EncryptedKeyNonce
+				segments[i].EncryptedKey = key.EncryptedKey
+			}
+
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: []byte("new key"),
+					NewSegmentKeys:        newKeys,
+				},
+			}.Check(ctx, t, db)
+
+			object.BucketName = newBucketName
+			object.ObjectKey = "new key"
+
+			metabasetest.Verify{
+				Objects:  []metabase.RawObject{metabase.RawObject(object)},
+				Segments: metabasetest.SegmentsToRaw(segments),
+			}.Check(ctx, t, db)
+		})
+	})
+}
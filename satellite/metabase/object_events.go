@@ -0,0 +1,210 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// ObjectEvent is an event of an object change waiting in the outbox to be
+// delivered to a webhook.
+type ObjectEvent struct {
+	ID         uuid.UUID
+	ProjectID  uuid.UUID
+	BucketName string
+	WebhookID  string
+	URL        string
+	// Payload is the encoded body sent to the webhook.
+	Payload []byte
+
+	Attempts      int
+	CreatedAt     time.Time
+	NextAttemptAt time.Time
+}
+
+// NotifyObjects returns the events of the objects changed by an operation.
+//
+// It's called in the transaction which changes the objects, so the events are
+// stored exactly when the change is committed. An error fails the operation.
+type NotifyObjects func(objects []Object) ([]ObjectEvent, error)
+
+// events returns the events of the objects, when notify is set.
+func (notify NotifyObjects) events(objects []Object) ([]ObjectEvent, error) {
+	if notify == nil || len(objects) == 0 {
+		return nil, nil
+	}
+	events, err := notify(objects)
+	if err != nil {
+		return nil, Error.New("unable to create object events: %w", err)
+	}
+	return events, nil
+}
+
+// insertObjectEvents adds the events of the objects to the outbox in the transaction
+// which changed the objects.
+func insertObjectEvents(ctx context.Context, tx tagsql.Tx, notify NotifyObjects, objects []Object) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	events, err := notify.events(objects)
+	if err != nil || len(events) == 0 {
+		return err
+	}
+
+	query, args := insertObjectEventsQuery(events, 1, "")
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return Error.New("unable to insert object events: %w", err)
+	}
+	return nil
+}
+
+// insertObjectEventsQuery returns the query inserting the events, which arguments
+// start at the placeholder firstArg. The condition is added to the query when it's
+// not empty.
+func insertObjectEventsQuery(events []ObjectEvent, firstArg int, condition string) (string, []interface{}) {
+	ids := make([]uuid.UUID, len(events))
+	projectIDs := make([]uuid.UUID, len(events))
+	bucketNames := make([][]byte, len(events))
+	webhookIDs := make([]string, len(events))
+	urls := make([]string, len(events))
+	payloads := make([][]byte, len(events))
+	nextAttemptAts := make([]time.Time, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+		projectIDs[i] = event.ProjectID
+		bucketNames[i] = []byte(event.BucketName)
+		webhookIDs[i] = event.WebhookID
+		urls[i] = event.URL
+		payloads[i] = event.Payload
+		nextAttemptAts[i] = event.NextAttemptAt
+	}
+
+	query := `
+		INSERT INTO object_events (
+			id, project_id, bucket_name, webhook_id, url, payload, next_attempt_at
+		)
+		SELECT * FROM unnest(` + fmt.Sprintf(`
+			$%d::BYTEA[], $%d::BYTEA[], $%d::BYTEA[], $%d::TEXT[], $%d::TEXT[], $%d::BYTEA[], $%d::TIMESTAMPTZ[]`,
+		firstArg, firstArg+1, firstArg+2, firstArg+3, firstArg+4, firstArg+5, firstArg+6) + `
+		)`
+	if condition != "" {
+		query += ` WHERE ` + condition
+	}
+
+	return query, []interface{}{
+		pgutil.UUIDArray(ids), pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames),
+		pgutil.TextArray(webhookIDs), pgutil.TextArray(urls), pgutil.ByteaArray(payloads),
+		pgutil.TimestampTZArray(nextAttemptAts),
+	}
+}
+
+// ClaimObjectEvents returns up to limit events, which should be delivered at or
+// before now. The next attempt of the returned events is moved to now+lease, so
+// concurrent callers don't deliver the same events. The events stay in the outbox
+// until they are deleted, they are claimed again when the lease expires.
+func (db *DB) ClaimObjectEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) (events []ObjectEvent, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit <= 0 {
+		return nil, ErrInvalidRequest.New("limit must be positive")
+	}
+
+	var query string
+	switch db.impl {
+	case dbutil.Cockroach:
+		query = `
+			UPDATE object_events SET next_attempt_at = $2
+			WHERE next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			RETURNING id, project_id, bucket_name, webhook_id, url, payload, attempts, created_at, next_attempt_at
+		`
+	case dbutil.Postgres:
+		query = `
+			UPDATE object_events SET next_attempt_at = $2
+			WHERE id IN (
+				SELECT id FROM object_events
+				WHERE next_attempt_at <= $1
+				ORDER BY next_attempt_at
+				LIMIT $3
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, project_id, bucket_name, webhook_id, url, payload, attempts, created_at, next_attempt_at
+		`
+	default:
+		return nil, Error.New("unhandled database: %v", db.impl)
+	}
+
+	err = withRows(db.db.QueryContext(ctx, query, now, now.Add(lease), limit))(func(rows tagsql.Rows) error {
+		events, err = scanObjectEvents(rows)
+		return err
+	})
+	if err != nil {
+		return nil, Error.New("unable to claim object events: %w", err)
+	}
+	return events, nil
+}
+
+// RescheduleObjectEvent records a failed delivery attempt and when the event should be retried.
+func (db *DB) RescheduleObjectEvent(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		UPDATE object_events
+		SET attempts = $2, next_attempt_at = $3
+		WHERE id = $1
+	`, id, attempts, nextAttemptAt)
+	if err != nil {
+		return Error.New("unable to reschedule object event: %w", err)
+	}
+	return nil
+}
+
+// DeleteObjectEvent removes a delivered or dropped event from the outbox.
+func (db *DB) DeleteObjectEvent(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `DELETE FROM object_events WHERE id = $1`, id)
+	if err != nil {
+		return Error.New("unable to delete object event: %w", err)
+	}
+	return nil
+}
+
+// TestingAllObjectEvents returns all events in the outbox.
+func (db *DB) TestingAllObjectEvents(ctx context.Context) (events []ObjectEvent, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT id, project_id, bucket_name, webhook_id, url, payload, attempts, created_at, next_attempt_at
+		FROM object_events
+		ORDER BY created_at, id
+	`))(func(rows tagsql.Rows) error {
+		events, err = scanObjectEvents(rows)
+		return err
+	})
+	return events, Error.Wrap(err)
+}
+
+func scanObjectEvents(rows tagsql.Rows) (events []ObjectEvent, err error) {
+	for rows.Next() {
+		var event ObjectEvent
+		var bucketName []byte
+		err := rows.Scan(&event.ID, &event.ProjectID, &bucketName, &event.WebhookID, &event.URL,
+			&event.Payload, &event.Attempts, &event.CreatedAt, &event.NextAttemptAt)
+		if err != nil {
+			return nil, err
+		}
+		event.BucketName = string(bucketName)
+		events = append(events, event)
+	}
+	return events, nil
+}
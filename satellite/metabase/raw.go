@@ -11,6 +11,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/placement"
 )
 
 // RawObject defines the full object that is stored in the database. It should be rarely used directly.
@@ -39,6 +40,11 @@ type RawObject struct {
 	// This is as a safeguard against objects that failed to upload and the client has not indicated
 	// whether they want to continue uploading or delete the already uploaded data.
 	ZombieDeletionDeadline *time.Time
+
+	// RetainUntil defines the time until which the committed object cannot be deleted.
+	RetainUntil *time.Time
+	// LegalHold prevents the committed object from being deleted, regardless of RetainUntil.
+	LegalHold bool
 }
 
 // RawSegment defines the full segment that is stored in the database. It should be rarely used directly.
@@ -65,12 +71,21 @@ type RawSegment struct {
 
 	InlineData []byte
 	Pieces     Pieces
+
+	Placement placement.Placement
+}
+
+// RawCopy contains a copy that is stored in the database.
+type RawCopy struct {
+	StreamID         uuid.UUID
+	AncestorStreamID uuid.UUID
 }
 
 // RawState contains full state of a table.
 type RawState struct {
 	Objects  []RawObject
 	Segments []RawSegment
+	Copies   []RawCopy
 }
 
 // TestingGetState returns the state of the database.
@@ -87,6 +102,11 @@ func (db *DB) TestingGetState(ctx context.Context) (_ *RawState, err error) {
 		return nil, Error.New("GetState: %w", err)
 	}
 
+	state.Copies, err = db.testingGetAllCopies(ctx)
+	if err != nil {
+		return nil, Error.New("GetState: %w", err)
+	}
+
 	return state, nil
 }
 
@@ -95,6 +115,8 @@ func (db *DB) TestingDeleteAll(ctx context.Context) (err error) {
 	_, err = db.db.ExecContext(ctx, `
 		DELETE FROM objects;
 		DELETE FROM segments;
+		DELETE FROM segment_copies;
+		DELETE FROM object_events;
 		DELETE FROM node_aliases;
 		SELECT setval('node_alias_seq', 1, false);
 	`)
@@ -114,7 +136,8 @@ func (db *DB) testingGetAllObjects(ctx context.Context) (_ []RawObject, err erro
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
 			encryption,
-			zombie_deletion_deadline
+			zombie_deletion_deadline,
+			retain_until, legal_hold
 		FROM objects
 		ORDER BY project_id ASC, bucket_name ASC, object_key ASC, version ASC
 	`)
@@ -147,6 +170,8 @@ func (db *DB) testingGetAllObjects(ctx context.Context) (_ []RawObject, err erro
 
 			encryptionParameters{&obj.Encryption},
 			&obj.ZombieDeletionDeadline,
+
+			&obj.RetainUntil, &obj.LegalHold,
 		)
 		if err != nil {
 			return nil, Error.New("testingGetAllObjects scan failed: %w", err)
@@ -176,7 +201,8 @@ func (db *DB) testingGetAllSegments(ctx context.Context) (_ []RawSegment, err er
 			plain_offset, plain_size,
 			encrypted_etag,
 			redundancy,
-			inline_data, remote_alias_pieces
+			inline_data, remote_alias_pieces,
+			placement
 		FROM segments
 		ORDER BY stream_id ASC, position ASC
 	`)
@@ -208,6 +234,8 @@ func (db *DB) testingGetAllSegments(ctx context.Context) (_ []RawSegment, err er
 
 			&seg.InlineData,
 			&aliasPieces,
+
+			&seg.Placement,
 		)
 		if err != nil {
 			return nil, Error.New("testingGetAllSegments scan failed: %w", err)
@@ -229,3 +257,38 @@ func (db *DB) testingGetAllSegments(ctx context.Context) (_ []RawSegment, err er
 	}
 	return segs, nil
 }
+
+// testingGetAllCopies returns the state of the database.
+func (db *DB) testingGetAllCopies(ctx context.Context) (_ []RawCopy, err error) {
+	copies := []RawCopy{}
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT
+			stream_id, ancestor_stream_id
+		FROM segment_copies
+		ORDER BY stream_id ASC, ancestor_stream_id ASC
+	`)
+	if err != nil {
+		return nil, Error.New("testingGetAllCopies query: %w", err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+	for rows.Next() {
+		var copy RawCopy
+		err := rows.Scan(
+			&copy.StreamID,
+			&copy.AncestorStreamID,
+		)
+		if err != nil {
+			return nil, Error.New("testingGetAllCopies scan failed: %w", err)
+		}
+		copies = append(copies, copy)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, Error.New("testingGetAllCopies scan failed: %w", err)
+	}
+
+	if len(copies) == 0 {
+		return nil, nil
+	}
+	return copies, nil
+}
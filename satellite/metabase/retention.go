@@ -0,0 +1,235 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// ErrObjectLocked is used when an object is protected from deletion by a retention period or a legal hold.
+var ErrObjectLocked = errs.Class("metabase: object locked")
+
+// objectNotLocked matches objects which are neither under legal hold nor under an active retention period.
+const objectNotLocked = `(NOT legal_hold AND (retain_until IS NULL OR retain_until <= now()))`
+
+// IsLocked returns whether the object cannot be deleted at the specified time.
+func (obj *Object) IsLocked(now time.Time) bool {
+	return obj.LegalHold || (obj.RetainUntil != nil && obj.RetainUntil.After(now))
+}
+
+// SetObjectRetention contains arguments necessary for setting the retention period of an object.
+type SetObjectRetention struct {
+	ObjectLocation
+	Version Version
+
+	RetainUntil time.Time
+}
+
+// Verify verifies set object retention fields.
+func (opts *SetObjectRetention) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if opts.Version <= 0 {
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	}
+	if opts.RetainUntil.IsZero() {
+		return ErrInvalidRequest.New("RetainUntil missing")
+	}
+	return nil
+}
+
+// SetObjectRetention protects a committed object from deletion until the specified time.
+//
+// The retention period can be only extended, an attempt to shorten it fails with ErrObjectLocked.
+func (db *DB) SetObjectRetention(ctx context.Context, opts SetObjectRetention) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	var retainUntil *time.Time
+	err = db.db.QueryRowContext(ctx, `
+		WITH existing AS (
+			SELECT retain_until FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4 AND
+				status       = `+committedStatus+`
+		), updated AS (
+			UPDATE objects SET retain_until = $5
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4 AND
+				status       = `+committedStatus+` AND
+				(retain_until IS NULL OR retain_until <= $5)
+			RETURNING 1
+		)
+		SELECT retain_until FROM existing
+	`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version, opts.RetainUntil).
+		Scan(&retainUntil)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storj.ErrObjectNotFound.Wrap(Error.Wrap(err))
+		}
+		return Error.New("unable to set object retention: %w", err)
+	}
+
+	if retainUntil != nil && retainUntil.After(opts.RetainUntil) {
+		return ErrObjectLocked.New("retention period cannot be shortened")
+	}
+
+	mon.Meter("object_set_retention").Mark(1)
+
+	return nil
+}
+
+// SetObjectLegalHold contains arguments necessary for placing or removing a legal hold on an object.
+type SetObjectLegalHold struct {
+	ObjectLocation
+	Version Version
+
+	LegalHold bool
+}
+
+// Verify verifies set object legal hold fields.
+func (opts *SetObjectLegalHold) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if opts.Version <= 0 {
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	}
+	return nil
+}
+
+// SetObjectLegalHold places or removes a legal hold on a committed object.
+// An object under legal hold cannot be deleted, even when its retention period has passed.
+func (db *DB) SetObjectLegalHold(ctx context.Context, opts SetObjectLegalHold) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE objects SET legal_hold = $5
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			version      = $4 AND
+			status       = `+committedStatus+`
+	`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version, opts.LegalHold)
+	if err != nil {
+		return Error.New("unable to set object legal hold: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.New("unable to get number of affected objects: %w", err)
+	}
+	if affected == 0 {
+		return storj.ErrObjectNotFound.Wrap(Error.New("object not found"))
+	}
+
+	mon.Meter("object_set_legal_hold").Mark(1)
+
+	return nil
+}
+
+// HasLockedObjects contains arguments necessary for checking whether
+// a project or a bucket contains locked objects.
+type HasLockedObjects struct {
+	ProjectID uuid.UUID
+	// BucketName limits the check to a single bucket, when not empty.
+	BucketName string
+}
+
+// HasLockedObjects returns whether the project or bucket contains objects
+// that are under legal hold or an active retention period.
+func (db *DB) HasLockedObjects(ctx context.Context, opts HasLockedObjects) (locked bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.ProjectID.IsZero() {
+		return false, ErrInvalidRequest.New("ProjectID missing")
+	}
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM objects
+			WHERE
+				project_id = $1 AND
+				NOT ` + objectNotLocked + `
+		)
+	`
+	args := []interface{}{opts.ProjectID}
+	if opts.BucketName != "" {
+		query = `
+		SELECT EXISTS (
+			SELECT 1 FROM objects
+			WHERE
+				project_id  = $1 AND
+				bucket_name = $2 AND
+				NOT ` + objectNotLocked + `
+		)
+	`
+		args = append(args, []byte(opts.BucketName))
+	}
+
+	err = db.db.QueryRowContext(ctx, query, args...).Scan(&locked)
+	if err != nil {
+		return false, Error.New("unable to check locked objects: %w", err)
+	}
+	return locked, nil
+}
+
+// lockedObjectExists returns whether there's a locked committed object at the location.
+// When version is zero, any version of the object is checked.
+func (db *DB) lockedObjectExists(ctx context.Context, location ObjectLocation, version Version) (exists bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				($4 = 0 OR version = $4) AND
+				status       = `+committedStatus+` AND
+				NOT `+objectNotLocked+`
+		)
+	`, location.ProjectID, []byte(location.BucketName), []byte(location.ObjectKey), version).Scan(&exists)
+	if err != nil {
+		return false, Error.New("unable to check object lock: %w", err)
+	}
+	return exists, nil
+}
+
+// noRowsDeletedError returns the error for a delete of a single object which didn't remove anything.
+// It distinguishes between a missing object and an object protected by retention or legal hold.
+func (db *DB) noRowsDeletedError(ctx context.Context, location ObjectLocation, version Version) error {
+	locked, err := db.lockedObjectExists(ctx, location, version)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return ErrObjectLocked.New("")
+	}
+	return storj.ErrObjectNotFound.Wrap(Error.New("no rows deleted"))
+}
@@ -0,0 +1,438 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestSetObjectRetention(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		location := obj.Location()
+		retainUntil := time.Now().Add(time.Hour)
+
+		for _, test := range metabasetest.InvalidObjectLocations(location) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.SetObjectRetention{
+					Opts: metabase.SetObjectRetention{
+						ObjectLocation: test.ObjectLocation,
+						Version:        1,
+						RetainUntil:    retainUntil,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("invalid arguments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					RetainUntil:    retainUntil,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Version invalid: 0",
+			}.Check(ctx, t, db)
+
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        1,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "RetainUntil missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("object missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        1,
+					RetainUntil:    retainUntil,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: sql: no rows in result set",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("extend and shorten", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					RetainUntil:    retainUntil,
+				},
+			}.Check(ctx, t, db)
+
+			extended := retainUntil.Add(time.Hour)
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					RetainUntil:    extended,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					RetainUntil:    retainUntil,
+				},
+				ErrClass: &metabase.ErrObjectLocked,
+				ErrText:  "retention period cannot be shortened",
+			}.Check(ctx, t, db)
+
+			object.RetainUntil = &extended
+
+			metabasetest.GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					ObjectLocation: location,
+					Version:        obj.Version,
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestSetObjectLegalHold(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		location := obj.Location()
+
+		t.Run("object missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: location,
+					Version:        1,
+					LegalHold:      true,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: object not found",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("place and remove", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					LegalHold:      true,
+				},
+			}.Check(ctx, t, db)
+
+			held := object
+			held.LegalHold = true
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(held),
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					LegalHold:      false,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestDeleteLockedObject(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		location := obj.Location()
+		now := time.Now()
+
+		lock := func(t *testing.T, object metabase.Object) metabase.Object {
+			retainUntil := now.Add(time.Hour)
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: object.Location(),
+					Version:        object.Version,
+					RetainUntil:    retainUntil,
+				},
+			}.Check(ctx, t, db)
+			object.RetainUntil = &retainUntil
+			return object
+		}
+
+		t.Run("single object deletes", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := lock(t, metabasetest.CreateObject(ctx, t, db, obj, 0))
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: location,
+					Version:        obj.Version,
+				},
+				ErrClass: &metabase.ErrObjectLocked,
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectLatestVersion{
+				Opts: metabase.DeleteObjectLatestVersion{
+					ObjectLocation: location,
+				},
+				ErrClass: &metabase.ErrObjectLocked,
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectAnyStatusAllVersions{
+				Opts: metabase.DeleteObjectAnyStatusAllVersions{
+					ObjectLocation: location,
+				},
+				ErrClass: &metabase.ErrObjectLocked,
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsAllVersions{
+				Opts: metabase.DeleteObjectsAllVersions{
+					Locations: []metabase.ObjectLocation{location},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("latest version locked", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			previous := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			latestStream := obj
+			latestStream.Version = obj.Version + 1
+			latestStream.StreamID = testrand.UUID()
+			latest := lock(t, metabasetest.CreateObject(ctx, t, db, latestStream, 0))
+
+			metabasetest.DeleteObjectLatestVersion{
+				Opts: metabase.DeleteObjectLatestVersion{
+					ObjectLocation: location,
+				},
+				ErrClass: &metabase.ErrObjectLocked,
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: location,
+					Version:        previous.Version,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{previous},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(latest),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("legal hold", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					LegalHold:      true,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: location,
+					Version:        obj.Version,
+				},
+				ErrClass: &metabase.ErrObjectLocked,
+			}.Check(ctx, t, db)
+
+			metabasetest.SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					LegalHold:      false,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: location,
+					Version:        obj.Version,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{object},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("passed retention", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			retainUntil := now.Add(-time.Hour)
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					RetainUntil:    retainUntil,
+				},
+			}.Check(ctx, t, db)
+			object.RetainUntil = &retainUntil
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: location,
+					Version:        obj.Version,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{object},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("move", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := lock(t, metabasetest.CreateObject(ctx, t, db, obj, 0))
+
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             obj.BucketName,
+					NewEncryptedObjectKey: []byte("new key"),
+				},
+				ErrClass: &metabase.ErrObjectLocked,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("bucket and expired deletion", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := lock(t, metabasetest.CreateExpiredObject(ctx, t, db, obj, 1, now.Add(-time.Minute)))
+			segments, err := db.TestingAllSegments(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			metabasetest.HasLockedObjects{
+				Opts: metabase.HasLockedObjects{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+				},
+				Result: true,
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteExpiredObjects{
+				Opts: metabase.DeleteExpiredObjects{
+					ExpiredBefore: now,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteBucketObjects{
+				Opts: metabase.DeleteBucketObjects{
+					Bucket: obj.Location().Bucket(),
+				},
+				Deleted: 0,
+			}.Check(ctx, t, db)
+
+			rawSegments := make([]metabase.RawSegment, len(segments))
+			for i, segment := range segments {
+				rawSegments[i] = metabase.RawSegment(segment)
+			}
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+				Segments: rawSegments,
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("no locked objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.HasLockedObjects{
+				Opts: metabase.HasLockedObjects{
+					ProjectID: obj.ProjectID,
+				},
+				Result: false,
+			}.Check(ctx, t, db)
+
+			metabasetest.HasLockedObjects{
+				Opts:     metabase.HasLockedObjects{},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// streamSegment is a deleted segment together with the stream it belonged to.
+type streamSegment struct {
+	StreamID uuid.UUID
+	Segment  DeletedSegmentInfo
+}
+
+// deleteWithSegmentCopies runs the delete query and drops the copy relations of the
+// deleted objects in the same transaction, together with storing the events of the
+// deleted objects. It returns the deleted objects and the segments whose pieces
+// aren't referenced by any remaining copy.
+func (db *DB) deleteWithSegmentCopies(ctx context.Context, notify NotifyObjects, scan func(rows tagsql.Rows) ([]Object, []streamSegment, error), query string, args ...interface{}) (objects []Object, segments []DeletedSegmentInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		var deletedSegments []streamSegment
+		err = withRows(tx.QueryContext(ctx, query, args...))(func(rows tagsql.Rows) error {
+			objects, deletedSegments, err = scan(rows)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		segments, err = db.withoutSharedSegments(ctx, tx, objectStreamIDs(objects), deletedSegments)
+		if err != nil {
+			return err
+		}
+
+		return insertObjectEvents(ctx, tx, notify, objects)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return objects, segments, nil
+}
+
+// withoutSharedSegments drops the copy relations of the deleted streams and returns
+// only the segments whose pieces aren't referenced by any remaining copy. It must be
+// called in the transaction that deleted the streams.
+func (db *DB) withoutSharedSegments(ctx context.Context, tx tagsql.Tx, streamIDs []uuid.UUID, segments []streamSegment) (_ []DeletedSegmentInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(streamIDs) == 0 {
+		return nil, nil
+	}
+
+	shared := map[uuid.UUID]struct{}{}
+	err = withRows(tx.QueryContext(ctx, `
+		WITH deleted AS (
+			SELECT
+				d.stream_id,
+				COALESCE(segment_copies.ancestor_stream_id, d.stream_id) AS group_id
+			FROM unnest($1::BYTEA[]) AS d(stream_id)
+			LEFT JOIN segment_copies ON segment_copies.stream_id = d.stream_id
+		)
+		SELECT deleted.stream_id FROM deleted
+		WHERE
+			EXISTS (
+				SELECT 1 FROM segment_copies
+				WHERE
+					segment_copies.ancestor_stream_id = deleted.group_id AND
+					NOT segment_copies.stream_id = ANY($1::BYTEA[])
+			) OR (
+				deleted.group_id <> deleted.stream_id AND
+				EXISTS (SELECT 1 FROM segments WHERE segments.stream_id = deleted.group_id)
+			)
+	`, pgutil.UUIDArray(streamIDs)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var streamID uuid.UUID
+			if err := rows.Scan(&streamID); err != nil {
+				return err
+			}
+			shared[streamID] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to check segment copies: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM segment_copies WHERE stream_id = ANY($1::BYTEA[])
+	`, pgutil.UUIDArray(streamIDs))
+	if err != nil {
+		return nil, Error.New("unable to delete segment copies: %w", err)
+	}
+
+	result := make([]DeletedSegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		if _, ok := shared[segment.StreamID]; ok {
+			continue
+		}
+		result = append(result, segment.Segment)
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// updateSegmentCopiesPieces propagates a pieces update to all the copies that
+// share pieces with the specified segment. It must be called in the transaction
+// that updated the pieces of the segment.
+func updateSegmentCopiesPieces(ctx context.Context, tx tagsql.Tx, opts UpdateSegmentPieces, oldPieces, newPieces AliasPieces) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = tx.ExecContext(ctx, `
+		WITH copy_group AS (
+			SELECT COALESCE(
+				(SELECT ancestor_stream_id FROM segment_copies WHERE stream_id = $1),
+				$1
+			) AS id
+		)
+		UPDATE segments SET
+			remote_alias_pieces = $4,
+			redundancy          = $5
+		WHERE
+			position = $2 AND
+			remote_alias_pieces = $3 AND
+			stream_id <> $1 AND
+			stream_id IN (
+				SELECT stream_id FROM segment_copies WHERE ancestor_stream_id = (SELECT id FROM copy_group)
+				UNION
+				SELECT id FROM copy_group
+			)
+	`, opts.StreamID, opts.Position, oldPieces, newPieces, redundancyScheme{&opts.NewRedundancy})
+	if err != nil {
+		return Error.New("unable to update segment copies pieces: %w", err)
+	}
+	return nil
+}
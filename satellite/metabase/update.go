@@ -13,6 +13,8 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
 	"storj.io/storj/storage"
 )
 
@@ -74,36 +76,44 @@ func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces)
 		return Error.New("unable to convert pieces to aliases: %w", err)
 	}
 
-	var resultPieces AliasPieces
-	err = db.db.QueryRowContext(ctx, `
-		UPDATE segments SET
-			remote_alias_pieces = CASE
-				WHEN remote_alias_pieces = $3 THEN $4
-				ELSE remote_alias_pieces
-			END,
-			redundancy = CASE
-				WHEN remote_alias_pieces = $3 THEN $5
-				ELSE redundancy
-			END,
-			repaired_at = CASE
-				WHEN remote_alias_pieces = $3 AND $7 = true THEN $6
-				ELSE repaired_at
-			END
-		WHERE
-			stream_id     = $1 AND
-			position      = $2
-		RETURNING remote_alias_pieces
-		`, opts.StreamID, opts.Position, oldPieces, newPieces, redundancyScheme{&opts.NewRedundancy}, opts.NewRepairedAt, updateRepairAt).
-		Scan(&resultPieces)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ErrSegmentNotFound.New("segment missing")
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		var resultPieces AliasPieces
+		err = tx.QueryRowContext(ctx, `
+			UPDATE segments SET
+				remote_alias_pieces = CASE
+					WHEN remote_alias_pieces = $3 THEN $4
+					ELSE remote_alias_pieces
+				END,
+				redundancy = CASE
+					WHEN remote_alias_pieces = $3 THEN $5
+					ELSE redundancy
+				END,
+				repaired_at = CASE
+					WHEN remote_alias_pieces = $3 AND $7 = true THEN $6
+					ELSE repaired_at
+				END
+			WHERE
+				stream_id     = $1 AND
+				position      = $2
+			RETURNING remote_alias_pieces
+			`, opts.StreamID, opts.Position, oldPieces, newPieces, redundancyScheme{&opts.NewRedundancy}, opts.NewRepairedAt, updateRepairAt).
+			Scan(&resultPieces)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrSegmentNotFound.New("segment missing")
+			}
+			return Error.New("unable to update segment pieces: %w", err)
+		}
+
+		if !EqualAliasPieces(newPieces, resultPieces) {
+			return storage.ErrValueChanged.New("segment remote_alias_pieces field was changed")
 		}
-		return Error.New("unable to update segment pieces: %w", err)
-	}
 
-	if !EqualAliasPieces(newPieces, resultPieces) {
-		return storage.ErrValueChanged.New("segment remote_alias_pieces field was changed")
+		// the copies share the pieces, so they must not see a different state
+		return updateSegmentCopiesPieces(ctx, tx, opts, oldPieces, newPieces)
+	})
+	if err != nil {
+		return err
 	}
 
 	mon.Meter("segment_update").Mark(1)
@@ -0,0 +1,132 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/satellite/metainfo"
+)
+
+func TestBucketLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+		chore := sat.Core.BucketLifecycle.Chore
+		metabaseDB := sat.Metainfo.Metabase
+		service := sat.Metainfo.Service
+
+		chore.Loop.Pause()
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "bucket"))
+
+		newObject := func(key metabase.ObjectKey) metabase.ObjectStream {
+			return metabase.ObjectStream{
+				ProjectID:  projectID,
+				BucketName: "bucket",
+				ObjectKey:  key,
+				Version:    1,
+				StreamID:   testrand.UUID(),
+			}
+		}
+
+		metabasetest.CreateObject(ctx, t, metabaseDB, newObject("logs/a"), 0)
+		kept := metabasetest.CreateObject(ctx, t, metabaseDB, newObject("data/b"), 0)
+		metabasetest.CreatePendingObject(ctx, t, metabaseDB, newObject("logs/pending"), 0)
+
+		err := service.SetBucketLifecycle(ctx, []byte("bucket"), projectID, metainfo.BucketLifecycle{
+			Rules: []metainfo.LifecycleRule{
+				{ID: "logs", Prefix: []byte("logs/"), ExpireAfterDays: 30, AbortPendingAfterDays: 7},
+			},
+		})
+		require.NoError(t, err)
+
+		// rules are not applied before the time passes
+		chore.Loop.TriggerWait()
+
+		objects, err := metabaseDB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		chore.SetNow(func() time.Time {
+			return time.Now().Add(31 * 24 * time.Hour)
+		})
+		chore.Loop.TriggerWait()
+
+		objects, err = metabaseDB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, kept.ObjectStream, objects[0].ObjectStream)
+	})
+}
+
+func TestBucketLifecycleVersioned(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+		chore := sat.Core.BucketLifecycle.Chore
+		metabaseDB := sat.Metainfo.Metabase
+		service := sat.Metainfo.Service
+
+		chore.Loop.Pause()
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "bucket"))
+		require.NoError(t, service.EnableBucketVersioning(ctx, []byte("bucket"), projectID))
+
+		object := metabasetest.CreateObject(ctx, t, metabaseDB, metabase.ObjectStream{
+			ProjectID:  projectID,
+			BucketName: "bucket",
+			ObjectKey:  "object",
+			Version:    1,
+			StreamID:   testrand.UUID(),
+		}, 0)
+
+		err := service.SetBucketLifecycle(ctx, []byte("bucket"), projectID, metainfo.BucketLifecycle{
+			Rules: []metainfo.LifecycleRule{
+				{ID: "all", ExpireAfterDays: 30, NoncurrentExpireAfterDays: 10},
+			},
+		})
+		require.NoError(t, err)
+
+		chore.SetNow(func() time.Time {
+			return time.Now().Add(31 * 24 * time.Hour)
+		})
+
+		// the current version is hidden by a delete marker
+		chore.Loop.TriggerWait()
+
+		objects, err := metabaseDB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		require.Equal(t, object.ObjectStream, objects[0].ObjectStream)
+		require.Equal(t, metabase.DeleteMarker, objects[1].Status)
+
+		// the noncurrent version expires
+		chore.Loop.TriggerWait()
+
+		objects, err = metabaseDB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, metabase.DeleteMarker, objects[0].Status)
+
+		// the delete marker without previous versions is removed
+		chore.Loop.TriggerWait()
+
+		objects, err = metabaseDB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Empty(t, objects)
+	})
+}
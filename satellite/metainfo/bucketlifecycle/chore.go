@@ -0,0 +1,389 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketnotifications"
+)
+
+var (
+	// Error defines the bucketlifecycle chore errors class.
+	Error = errs.Class("bucket lifecycle")
+	mon   = monkit.Package()
+)
+
+// defaultListLimit is the batch size used when ListLimit isn't configured.
+const defaultListLimit = 1000
+
+// Config contains configurable values for the bucket lifecycle chore.
+type Config struct {
+	Interval           time.Duration `help:"how frequently bucket lifecycle rules should be evaluated" releaseDefault:"24h" devDefault:"1h" testDefault:"$TESTINTERVAL"`
+	Enabled            bool          `help:"set if bucket lifecycle rules are enforced or not" releaseDefault:"true" devDefault:"true"`
+	ListLimit          int           `help:"how many objects to query and delete in a batch" default:"1000"`
+	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+}
+
+// Chore implements the bucket lifecycle rules enforcement.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	metabase *metabase.DB
+	buckets  *metainfo.Service
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the bucketlifecycle chore.
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB, buckets *metainfo.Service) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		metabase: metabase,
+		buckets:  buckets,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the bucketlifecycle loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.applyRules)
+}
+
+// Close stops the bucketlifecycle chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *Chore) applyRules(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	chore.log.Debug("applying bucket lifecycle rules")
+
+	// log error instead of crashing core, the next iteration will try again
+	if err := chore.evaluate(ctx); err != nil {
+		chore.log.Error("applying bucket lifecycle rules failed", zap.Error(err))
+	}
+	return nil
+}
+
+func (chore *Chore) evaluate(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	startingTime, err := chore.metabase.Now(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	batchSize := chore.config.ListLimit
+	if batchSize <= 0 {
+		batchSize = defaultListLimit
+	}
+
+	evaluator := &evaluator{
+		chore:     chore,
+		now:       chore.nowFn(),
+		batchSize: batchSize,
+	}
+
+	err = chore.metabase.IterateLoopObjects(ctx, metabase.IterateLoopObjects{
+		BatchSize:          batchSize,
+		AsOfSystemTime:     startingTime,
+		AsOfSystemInterval: chore.config.AsOfSystemInterval,
+	}, func(ctx context.Context, it metabase.LoopObjectsIterator) error {
+		var entry metabase.LoopObjectEntry
+		for it.Next(ctx, &entry) {
+			if err := evaluator.add(ctx, entry); err != nil {
+				return err
+			}
+		}
+		return evaluator.finish(ctx)
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	chore.log.Info("bucket lifecycle rules applied",
+		zap.Int64("aborted pending objects", evaluator.abortedPending),
+		zap.Int64("expired objects", evaluator.expired),
+		zap.Int64("expired noncurrent versions", evaluator.expiredNoncurrent),
+		zap.Int64("removed delete markers", evaluator.removedMarkers),
+	)
+	mon.IntVal("lifecycle_aborted_pending_objects").Observe(evaluator.abortedPending)
+	mon.IntVal("lifecycle_expired_objects").Observe(evaluator.expired)
+	mon.IntVal("lifecycle_expired_noncurrent_versions").Observe(evaluator.expiredNoncurrent)
+	mon.IntVal("lifecycle_removed_delete_markers").Observe(evaluator.removedMarkers)
+
+	return nil
+}
+
+// evaluator collects all versions of an object from the object loop
+// and applies the lifecycle rules of its bucket to them.
+type evaluator struct {
+	chore     *Chore
+	now       time.Time
+	batchSize int
+
+	// bucket information is cached, because the loop goes through the objects ordered by bucket.
+	bucket       metabase.BucketLocation
+	bucketLoaded bool
+	lifecycle    metainfo.BucketLifecycle
+	versioning   metainfo.Versioning
+	// notifications of the bucket, deleted objects are notified in the deleting transaction.
+	notifications metainfo.BucketNotifications
+
+	// versions contains all versions of the current object ordered by version.
+	versions []metabase.LoopObjectEntry
+	deletes  []queuedDelete
+
+	abortedPending    int64
+	expired           int64
+	expiredNoncurrent int64
+	removedMarkers    int64
+}
+
+// add adds the next entry of the object loop.
+func (evaluator *evaluator) add(ctx context.Context, entry metabase.LoopObjectEntry) error {
+	if len(evaluator.versions) > 0 && evaluator.versions[0].Location() != entry.Location() {
+		if err := evaluator.process(ctx); err != nil {
+			return err
+		}
+	}
+	evaluator.versions = append(evaluator.versions, entry)
+	return nil
+}
+
+// finish processes the last object and deletes the remaining objects.
+func (evaluator *evaluator) finish(ctx context.Context) error {
+	if len(evaluator.versions) > 0 {
+		if err := evaluator.process(ctx); err != nil {
+			return err
+		}
+	}
+	return evaluator.flush(ctx)
+}
+
+// process applies the lifecycle rules to all versions of the current object.
+func (evaluator *evaluator) process(ctx context.Context) (err error) {
+	versions := evaluator.versions
+	defer func() { evaluator.versions = evaluator.versions[:0] }()
+
+	if err := evaluator.ensureBucket(ctx, versions[0].Location().Bucket()); err != nil {
+		return err
+	}
+	if evaluator.lifecycle.IsZero() {
+		return nil
+	}
+
+	key := versions[0].ObjectKey
+	expireAfter := days(evaluator.lifecycle.ExpireAfterDays(key))
+	abortPendingAfter := days(evaluator.lifecycle.AbortPendingAfterDays(key))
+	noncurrentExpireAfter := days(evaluator.lifecycle.NoncurrentExpireAfterDays(key))
+
+	var current *metabase.LoopObjectEntry
+	// becameNoncurrent is the creation time of the next newer version,
+	// i.e. the time when the version stopped being the current one.
+	var becameNoncurrent time.Time
+	noncurrentVersions := 0
+
+	for i := len(versions) - 1; i >= 0; i-- {
+		object := &versions[i]
+
+		if object.Status == metabase.Pending {
+			if abortPendingAfter > 0 && evaluator.passed(object.CreatedAt, abortPendingAfter) {
+				if err := evaluator.delete(ctx, object, &evaluator.abortedPending); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if current == nil {
+			current = object
+			becameNoncurrent = object.CreatedAt
+			continue
+		}
+
+		noncurrentVersions++
+		if noncurrentExpireAfter > 0 && evaluator.passed(becameNoncurrent, noncurrentExpireAfter) {
+			if err := evaluator.delete(ctx, object, &evaluator.expiredNoncurrent); err != nil {
+				return err
+			}
+		}
+		becameNoncurrent = object.CreatedAt
+	}
+
+	if current == nil {
+		return nil
+	}
+
+	switch current.Status {
+	case metabase.Committed:
+		if expireAfter <= 0 || !evaluator.passed(current.CreatedAt, expireAfter) {
+			return nil
+		}
+		if evaluator.versioning == metainfo.VersioningEnabled {
+			_, err := evaluator.chore.metabase.CreateDeleteMarker(ctx, metabase.CreateDeleteMarker{
+				ObjectLocation: current.Location(),
+				Notify:         metainfo.NewNotifyObjects(evaluator.notifications, bucketnotifications.EventObjectDeleted, metabase.DeleteMarker),
+			})
+			if err != nil {
+				return err
+			}
+			evaluator.expired++
+			return nil
+		}
+		return evaluator.delete(ctx, current, &evaluator.expired)
+	case metabase.DeleteMarker:
+		// a delete marker without any previous versions doesn't hide anything,
+		// it's removed once the noncurrent versions are gone.
+		if noncurrentVersions > 0 || (expireAfter <= 0 && noncurrentExpireAfter <= 0) {
+			return nil
+		}
+		return evaluator.delete(ctx, current, &evaluator.removedMarkers)
+	}
+	return nil
+}
+
+// ensureBucket loads the lifecycle and versioning of the bucket, when it differs from the cached one.
+func (evaluator *evaluator) ensureBucket(ctx context.Context, bucket metabase.BucketLocation) (err error) {
+	if evaluator.bucketLoaded && evaluator.bucket == bucket {
+		return nil
+	}
+
+	evaluator.bucket = bucket
+	evaluator.bucketLoaded = false
+	evaluator.lifecycle = metainfo.BucketLifecycle{}
+	evaluator.versioning = metainfo.Unversioned
+	evaluator.notifications = metainfo.BucketNotifications{}
+
+	lifecycle, err := evaluator.chore.buckets.GetBucketLifecycle(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+	if err != nil {
+		// objects may remain for a while after the bucket was deleted
+		if storj.ErrBucketNotFound.Has(err) {
+			evaluator.bucketLoaded = true
+			return nil
+		}
+		return err
+	}
+
+	if !lifecycle.IsZero() {
+		evaluator.versioning, err = evaluator.chore.buckets.GetBucketVersioning(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+		if err != nil {
+			return err
+		}
+		evaluator.notifications, err = evaluator.chore.buckets.GetBucketNotifications(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+		if err != nil {
+			return err
+		}
+	}
+
+	evaluator.lifecycle = lifecycle
+	evaluator.bucketLoaded = true
+	return nil
+}
+
+// queuedDelete is an object queued for deletion, the counter of the action
+// that deletes it and the events of its bucket.
+type queuedDelete struct {
+	object  metabase.Object
+	counter *int64
+	notify  metabase.NotifyObjects
+}
+
+// delete queues the object for deletion. The counter is incremented once the
+// object is actually deleted, locked objects are kept.
+func (evaluator *evaluator) delete(ctx context.Context, entry *metabase.LoopObjectEntry, counter *int64) error {
+	evaluator.deletes = append(evaluator.deletes, queuedDelete{
+		object: metabase.Object{
+			ObjectStream:       entry.ObjectStream,
+			Status:             entry.Status,
+			CreatedAt:          entry.CreatedAt,
+			ExpiresAt:          entry.ExpiresAt,
+			TotalEncryptedSize: entry.TotalEncryptedSize,
+		},
+		counter: counter,
+		notify:  metainfo.NewNotifyObjects(evaluator.notifications, bucketnotifications.EventObjectDeleted, metabase.Committed),
+	})
+	if len(evaluator.deletes) < evaluator.batchSize {
+		return nil
+	}
+	return evaluator.flush(ctx)
+}
+
+// flush deletes the queued objects.
+func (evaluator *evaluator) flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(evaluator.deletes) == 0 {
+		return nil
+	}
+
+	objects := make([]metabase.ObjectStream, len(evaluator.deletes))
+	queued := make(map[metabase.ObjectStream]queuedDelete, len(evaluator.deletes))
+	for i, del := range evaluator.deletes {
+		objects[i] = del.object.ObjectStream
+		queued[del.object.ObjectStream] = del
+	}
+	evaluator.deletes = evaluator.deletes[:0]
+
+	deleted, err := evaluator.chore.metabase.DeleteObjectStreams(ctx, metabase.DeleteObjectStreams{
+		Objects: objects,
+		// the queued deletes may belong to different buckets.
+		Notify: func(objects []metabase.Object) (events []metabase.ObjectEvent, err error) {
+			for _, object := range objects {
+				del := queued[object.ObjectStream]
+				if del.notify == nil {
+					continue
+				}
+				objectEvents, err := del.notify([]metabase.Object{del.object})
+				if err != nil {
+					return nil, err
+				}
+				events = append(events, objectEvents...)
+			}
+			return events, nil
+		},
+	})
+	for _, object := range deleted {
+		*queued[object].counter++
+	}
+	return err
+}
+
+// passed returns whether the duration passed since the specified time.
+func (evaluator *evaluator) passed(since time.Time, duration time.Duration) bool {
+	return since.Add(duration).Before(evaluator.now)
+}
+
+// days converts the number of days to a duration.
+func days(n int) time.Duration {
+	return time.Duration(n) * 24 * time.Hour
+}
@@ -0,0 +1,14 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package bucketlifecycle contains the chore which enforces bucket lifecycle rules.
+
+The chore goes through all objects in metabase, using the metabase object loop,
+and evaluates the lifecycle rules of the bucket for every object. Depending on
+the rules it aborts old pending uploads, expires current versions of objects
+and removes old noncurrent versions.
+
+Objects under retention or legal hold are never removed by the chore.
+*/
+package bucketlifecycle
@@ -0,0 +1,94 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketnotifications
+
+import (
+	"net"
+	"net/url"
+	"strings"
+	"syscall"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrAddressNotAllowed is returned when a webhook targets an address which isn't allowed.
+var ErrAddressNotAllowed = errs.Class("webhook address not allowed")
+
+// privateNetworks are the networks, which aren't publicly routable, in addition
+// to the loopback, link-local, multicast and unspecified addresses.
+var privateNetworks = mustParseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"fc00::/7",
+)
+
+// CheckURL verifies that the webhook url uses http or https and that its host
+// isn't localhost or a private, loopback or link-local address, unless private
+// addresses are allowed.
+//
+// Host names may resolve to any address, so the addresses are checked again when
+// connecting to the webhook.
+func CheckURL(rawURL string, allowPrivate bool) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrAddressNotAllowed.New("invalid url %q", rawURL)
+	}
+	if allowPrivate {
+		return nil
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrAddressNotAllowed.New("%q", host)
+	}
+	if ip := net.ParseIP(host); ip != nil && !IsPublicIP(ip) {
+		return ErrAddressNotAllowed.New("%q", host)
+	}
+	return nil
+}
+
+// IsPublicIP returns whether the address is publicly routable.
+func IsPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// controlPublicAddress is used as the control function of the dialer, it refuses
+// to connect to addresses, which aren't publicly routable.
+func controlPublicAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return ErrAddressNotAllowed.Wrap(err)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !IsPublicIP(ip) {
+		return ErrAddressNotAllowed.New("%q", host)
+	}
+	return nil
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
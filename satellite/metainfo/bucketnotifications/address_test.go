@@ -0,0 +1,57 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketnotifications_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/metainfo/bucketnotifications"
+)
+
+func TestCheckURL(t *testing.T) {
+	for _, tt := range []struct {
+		url     string
+		public  bool
+		private bool
+	}{
+		{url: "https://example.test/hook", public: true, private: true},
+		{url: "http://203.0.113.7:8080/hook", public: true, private: true},
+		{url: "https://[2001:db8::1]/hook", public: true, private: true},
+		{url: "http://127.0.0.1/hook", public: false, private: true},
+		{url: "http://localhost:8080/hook", public: false, private: true},
+		{url: "http://api.localhost./hook", public: false, private: true},
+		{url: "http://10.1.2.3/hook", public: false, private: true},
+		{url: "http://172.16.0.1/hook", public: false, private: true},
+		{url: "http://192.168.1.1/hook", public: false, private: true},
+		{url: "http://169.254.169.254/latest/meta-data", public: false, private: true},
+		{url: "http://[::1]/hook", public: false, private: true},
+		{url: "http://[fe80::1]/hook", public: false, private: true},
+		{url: "http://[fd00::1]/hook", public: false, private: true},
+		{url: "http://[::ffff:10.0.0.1]/hook", public: false, private: true},
+		{url: "http://0.0.0.0/hook", public: false, private: true},
+		{url: "ftp://example.test/hook", public: false, private: false},
+		{url: "https:///hook", public: false, private: false},
+		{url: "example.test/hook", public: false, private: false},
+	} {
+		err := bucketnotifications.CheckURL(tt.url, false)
+		require.Equal(t, tt.public, err == nil, tt.url)
+		if err != nil {
+			require.True(t, bucketnotifications.ErrAddressNotAllowed.Has(err), tt.url)
+		}
+
+		err = bucketnotifications.CheckURL(tt.url, true)
+		require.Equal(t, tt.private, err == nil, tt.url)
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	require.True(t, bucketnotifications.IsPublicIP(net.ParseIP("8.8.8.8")))
+	require.True(t, bucketnotifications.IsPublicIP(net.ParseIP("2606:4700::1111")))
+	require.False(t, bucketnotifications.IsPublicIP(net.ParseIP("100.64.0.1")))
+	require.False(t, bucketnotifications.IsPublicIP(net.ParseIP("224.0.0.1")))
+	require.False(t, bucketnotifications.IsPublicIP(net.ParseIP("::")))
+}
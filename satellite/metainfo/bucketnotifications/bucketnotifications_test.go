@@ -0,0 +1,228 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketnotifications_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketnotifications"
+)
+
+// webhook records the payloads posted to it.
+type webhook struct {
+	mu       sync.Mutex
+	status   int
+	payloads []bucketnotifications.Payload
+}
+
+func (hook *webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+
+	if hook.status != http.StatusOK {
+		w.WriteHeader(hook.status)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var payload bucketnotifications.Payload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	hook.payloads = append(hook.payloads, payload)
+}
+
+func (hook *webhook) setStatus(status int) {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	hook.status = status
+}
+
+func (hook *webhook) received() []bucketnotifications.Payload {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	return append([]bucketnotifications.Payload(nil), hook.payloads...)
+}
+
+func TestBucketNotifications(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+		chore := sat.Core.BucketNotifications.Chore
+
+		chore.Loop.Pause()
+
+		hook := &webhook{status: http.StatusOK}
+		server := httptest.NewServer(hook)
+		defer server.Close()
+
+		require.NoError(t, uplink.CreateBucket(ctx, sat, "bucket"))
+
+		err := sat.Metainfo.Service.SetBucketNotifications(ctx, []byte("bucket"), projectID, metainfo.BucketNotifications{
+			Webhooks: []metainfo.WebhookConfig{{
+				ID:  "all",
+				URL: server.URL,
+				Events: []string{
+					bucketnotifications.EventObjectCreated,
+					bucketnotifications.EventObjectDeleted,
+				},
+			}},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, uplink.Upload(ctx, sat, "bucket", "object", testrand.Bytes(5*memory.KiB)))
+
+		chore.Loop.TriggerWait()
+
+		received := hook.received()
+		require.Len(t, received, 1)
+		require.Equal(t, bucketnotifications.EventObjectCreated, received[0].Event)
+		require.Equal(t, "bucket", received[0].Bucket)
+		require.NotEmpty(t, received[0].Key)
+		require.NotZero(t, received[0].Size)
+		require.NotZero(t, received[0].Version)
+
+		require.NoError(t, uplink.DeleteObject(ctx, sat, "bucket", "object"))
+
+		chore.Loop.TriggerWait()
+
+		received = hook.received()
+		require.Len(t, received, 2)
+		require.Equal(t, bucketnotifications.EventObjectDeleted, received[1].Event)
+		require.Equal(t, received[0].Key, received[1].Key)
+		require.Equal(t, received[0].Version, received[1].Version)
+
+		// delivered events are removed from the outbox
+		events, err := sat.Metainfo.Metabase.TestingAllObjectEvents(ctx)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+}
+
+func TestBucketNotificationsExpiredObjects(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+		expiredChore := sat.Core.ExpiredDeletion.Chore
+
+		sat.Core.BucketNotifications.Chore.Loop.Pause()
+		expiredChore.Loop.Pause()
+
+		require.NoError(t, uplink.CreateBucket(ctx, sat, "bucket"))
+
+		err := sat.Metainfo.Service.SetBucketNotifications(ctx, []byte("bucket"), projectID, metainfo.BucketNotifications{
+			Webhooks: []metainfo.WebhookConfig{{
+				ID:     "deletes",
+				URL:    "https://example.test/hook",
+				Events: []string{bucketnotifications.EventObjectDeleted},
+			}},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, uplink.UploadWithExpiration(ctx, sat, "bucket", "object", testrand.Bytes(5*memory.KiB), time.Now().Add(time.Hour)))
+
+		events, err := sat.Metainfo.Metabase.TestingAllObjectEvents(ctx)
+		require.NoError(t, err)
+		require.Empty(t, events)
+
+		expiredChore.SetNow(func() time.Time {
+			return time.Now().Add(2 * time.Hour)
+		})
+		expiredChore.Loop.TriggerWait()
+
+		// the event is recorded together with the deletion
+		events, err = sat.Metainfo.Metabase.TestingAllObjectEvents(ctx)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, "deletes", events[0].WebhookID)
+		require.Equal(t, "bucket", events[0].BucketName)
+
+		var payload bucketnotifications.Payload
+		require.NoError(t, json.Unmarshal(events[0].Payload, &payload))
+		require.Equal(t, bucketnotifications.EventObjectDeleted, payload.Event)
+		require.NotZero(t, payload.Size)
+	})
+}
+
+func TestBucketNotificationsRetry(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+		chore := sat.Core.BucketNotifications.Chore
+		config := sat.Config.BucketNotifications
+		outbox := sat.Metainfo.Metabase
+
+		chore.Loop.Pause()
+
+		hook := &webhook{status: http.StatusServiceUnavailable}
+		server := httptest.NewServer(hook)
+		defer server.Close()
+
+		require.NoError(t, uplink.CreateBucket(ctx, sat, "bucket"))
+
+		err := sat.Metainfo.Service.SetBucketNotifications(ctx, []byte("bucket"), projectID, metainfo.BucketNotifications{
+			Webhooks: []metainfo.WebhookConfig{{
+				ID:     "inbox",
+				URL:    server.URL,
+				Events: []string{bucketnotifications.EventObjectCreated},
+			}},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, uplink.Upload(ctx, sat, "bucket", "object", testrand.Bytes(5*memory.KiB)))
+
+		// failed delivery is retried later
+		chore.Loop.TriggerWait()
+		require.Empty(t, hook.received())
+
+		due, err := outbox.ClaimObjectEvents(ctx, time.Now(), time.Minute, 10)
+		require.NoError(t, err)
+		require.Empty(t, due)
+
+		events, err := outbox.TestingAllObjectEvents(ctx)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, 1, events[0].Attempts)
+		require.WithinDuration(t, time.Now().Add(config.RetryDelay), events[0].NextAttemptAt, time.Minute)
+
+		hook.setStatus(http.StatusOK)
+		chore.SetNow(func() time.Time {
+			return time.Now().Add(config.RetryDelay)
+		})
+		chore.Loop.TriggerWait()
+
+		require.Len(t, hook.received(), 1)
+
+		events, err = outbox.TestingAllObjectEvents(ctx)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+}
@@ -0,0 +1,278 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketnotifications
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the bucketnotifications chore errors class.
+	Error = errs.Class("bucket notifications")
+	mon   = monkit.Package()
+)
+
+const (
+	// maxRetryDelay caps the exponential backoff between delivery attempts.
+	maxRetryDelay = 24 * time.Hour
+	// maxResponseSize is how much of the webhook response is read.
+	maxResponseSize = 64 * 1024
+)
+
+// Config contains configurable values for the bucket notifications chore.
+type Config struct {
+	Interval              time.Duration `help:"how frequently bucket notification events should be delivered" releaseDefault:"30s" devDefault:"10s" testDefault:"$TESTINTERVAL"`
+	Enabled               bool          `help:"set if bucket notification events are delivered to webhooks or not" releaseDefault:"true" devDefault:"true"`
+	BatchSize             int           `help:"how many events to deliver in a batch" default:"100"`
+	Concurrency           int           `help:"how many webhook hosts events are delivered to concurrently" default:"10"`
+	MaxAttempts           int           `help:"how many times the delivery of an event is attempted before it is dropped" default:"10"`
+	RetryDelay            time.Duration `help:"how long to wait before retrying a failed delivery, doubled after every attempt" default:"1m"`
+	Timeout               time.Duration `help:"timeout for a single webhook request" default:"5s"`
+	ClaimDuration         time.Duration `help:"how long claimed events aren't delivered by other instances" default:"10m"`
+	AllowPrivateAddresses bool          `help:"allow delivering events to webhooks on private, loopback and link-local addresses" default:"false" testDefault:"true"`
+}
+
+// Chore delivers bucket notification events to webhooks.
+//
+// architecture: Chore
+type Chore struct {
+	log    *zap.Logger
+	config Config
+	db     DB
+	client *http.Client
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the bucketnotifications chore.
+func NewChore(log *zap.Logger, config Config, db DB) *Chore {
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	if !config.AllowPrivateAddresses {
+		dialer.Control = controlPublicAddress
+	}
+
+	return &Chore{
+		log:    log,
+		config: config,
+		db:     db,
+		client: &http.Client{
+			Timeout: config.Timeout,
+			// proxies would connect to the webhook on our behalf, bypassing the address checks.
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: config.Timeout,
+				MaxIdleConnsPerHost: 1,
+				IdleConnTimeout:     config.Interval,
+			},
+			// redirects are failed deliveries, the webhook must respond itself.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the bucketnotifications loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.deliverEvents)
+}
+
+// Close stops the bucketnotifications chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	chore.client.CloseIdleConnections()
+	return nil
+}
+
+// SetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *Chore) deliverEvents(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	batchSize := chore.config.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	for {
+		events, err := chore.db.ClaimObjectEvents(ctx, chore.nowFn(), chore.config.ClaimDuration, batchSize)
+		if err != nil {
+			// log error instead of crashing core, the next iteration will try again
+			chore.log.Error("claiming bucket notification events failed", zap.Error(err))
+			return nil
+		}
+
+		chore.deliverBatch(ctx, events)
+
+		if len(events) < batchSize {
+			return nil
+		}
+	}
+}
+
+// deliverBatch delivers the events of every webhook host concurrently. The events
+// of a single host are delivered in order.
+func (chore *Chore) deliverBatch(ctx context.Context, events []metabase.ObjectEvent) {
+	defer mon.Task()(&ctx)(nil)
+
+	var hosts []string
+	byHost := map[string][]metabase.ObjectEvent{}
+	for _, event := range events {
+		host := webhookHost(event.URL)
+		if _, ok := byHost[host]; !ok {
+			hosts = append(hosts, host)
+		}
+		byHost[host] = append(byHost[host], event)
+	}
+
+	concurrency := chore.config.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	limiter := sync2.NewLimiter(concurrency)
+	for _, host := range hosts {
+		hostEvents := byHost[host]
+		limiter.Go(ctx, func() {
+			chore.deliverToHost(ctx, hostEvents)
+		})
+	}
+	limiter.Wait()
+}
+
+// deliverToHost delivers the events of a single webhook host. When the host
+// fails, the remaining events are postponed without counting an attempt, so an
+// unresponsive host doesn't hold up the batch.
+func (chore *Chore) deliverToHost(ctx context.Context, events []metabase.ObjectEvent) {
+	for i, event := range events {
+		delivered, err := chore.deliver(ctx, event)
+		if err != nil {
+			chore.log.Error("updating bucket notification event failed",
+				zap.Stringer("Event ID", event.ID), zap.Error(err))
+		}
+		if delivered {
+			continue
+		}
+
+		for _, postponed := range events[i+1:] {
+			err := chore.db.RescheduleObjectEvent(ctx, postponed.ID, postponed.Attempts,
+				chore.nowFn().Add(chore.retryDelay(event.Attempts+1)))
+			if err != nil {
+				chore.log.Error("updating bucket notification event failed",
+					zap.Stringer("Event ID", postponed.ID), zap.Error(err))
+			}
+		}
+		return
+	}
+}
+
+// deliver sends the event to its webhook and removes it from the outbox, or
+// schedules the next attempt when the webhook isn't reachable. It returns whether
+// the webhook received the event.
+func (chore *Chore) deliver(ctx context.Context, event metabase.ObjectEvent) (delivered bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sendErr := chore.send(ctx, event)
+	if sendErr == nil {
+		mon.Meter("bucket_notification_delivered").Mark(1)
+		return true, chore.db.DeleteObjectEvent(ctx, event.ID)
+	}
+
+	attempts := event.Attempts + 1
+	if attempts >= chore.config.MaxAttempts {
+		mon.Meter("bucket_notification_dropped").Mark(1)
+		chore.log.Warn("dropping bucket notification event after too many attempts",
+			zap.Stringer("Event ID", event.ID),
+			zap.Stringer("Project ID", event.ProjectID),
+			zap.String("Webhook ID", event.WebhookID),
+			zap.Int("Attempts", attempts),
+			zap.Error(sendErr),
+		)
+		return false, chore.db.DeleteObjectEvent(ctx, event.ID)
+	}
+
+	chore.log.Debug("delivering bucket notification event failed",
+		zap.Stringer("Event ID", event.ID),
+		zap.String("Webhook ID", event.WebhookID),
+		zap.Int("Attempts", attempts),
+		zap.Error(sendErr),
+	)
+	return false, chore.db.RescheduleObjectEvent(ctx, event.ID, attempts, chore.nowFn().Add(chore.retryDelay(attempts)))
+}
+
+// send posts the event payload to the webhook.
+func (chore *Chore) send(ctx context.Context, event metabase.ObjectEvent) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, event.URL, bytes.NewReader(event.Payload))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Storj-Event-Id", event.ID.String())
+
+	resp, err := chore.client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxResponseSize))
+		err = errs.Combine(err, resp.Body.Close())
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// retryDelay returns how long to wait after the specified number of failed attempts.
+func (chore *Chore) retryDelay(attempts int) time.Duration {
+	delay := chore.config.RetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+// webhookHost returns the host the url points to, events of the same host are
+// delivered sequentially.
+func webhookHost(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return strings.ToLower(u.Host)
+}
@@ -0,0 +1,22 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package bucketnotifications contains the chore which delivers object events to
+the webhooks configured on the buckets.
+
+The events are stored in the outbox table of the metabase, in the same
+transaction which creates or deletes the objects, so an event is recorded
+exactly when the change is committed. This is the case for the metainfo
+endpoint as well as for the lifecycle and expiration chores.
+
+The chore claims the due events, so that several satellite instances don't
+deliver the same events, and posts them to the webhooks concurrently, one
+goroutine per webhook host. Failed deliveries are retried with an exponential
+backoff, until the maximum number of attempts is reached. Events may be
+delivered more than once, webhooks can deduplicate them by the event id.
+
+Webhooks can't target private, loopback or link-local addresses, which is
+checked when the webhook is configured and again when connecting to it.
+*/
+package bucketnotifications
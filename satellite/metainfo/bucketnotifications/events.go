@@ -0,0 +1,44 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketnotifications
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+const (
+	// EventObjectCreated is emitted when an object is committed.
+	EventObjectCreated = "object-created"
+	// EventObjectDeleted is emitted when an object is deleted, or hidden by a delete marker.
+	EventObjectDeleted = "object-deleted"
+)
+
+// Payload is the body of the request sent to a webhook.
+type Payload struct {
+	Event  string `json:"event"`
+	Bucket string `json:"bucket"`
+	// Key is the encrypted object key.
+	Key     []byte    `json:"key"`
+	Size    int64     `json:"size"`
+	Version int64     `json:"version"`
+	Time    time.Time `json:"time"`
+}
+
+// DB is the outbox of object events, which is stored in the metabase, so that the
+// events are recorded in the same transaction as the object changes.
+//
+// architecture: Database
+type DB interface {
+	// ClaimObjectEvents returns up to limit events, which should be delivered at or
+	// before now, and postpones their next attempt by lease.
+	ClaimObjectEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]metabase.ObjectEvent, error)
+	// RescheduleObjectEvent records a failed delivery attempt and when the event should be retried.
+	RescheduleObjectEvent(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time) error
+	// DeleteObjectEvent removes an event from the outbox.
+	DeleteObjectEvent(ctx context.Context, id uuid.UUID) error
+}
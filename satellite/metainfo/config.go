@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
 )
@@ -90,6 +91,53 @@ func (rs *RSConfig) Set(s string) error {
 	return nil
 }
 
+// RedundancyScheme returns the redundancy scheme of the configuration.
+func (rs *RSConfig) RedundancyScheme() storj.RedundancyScheme {
+	return storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      rs.ErasureShareSize.Int32(),
+		RequiredShares: int16(rs.Min),
+		RepairShares:   int16(rs.Repair),
+		OptimalShares:  int16(rs.Success),
+		TotalShares:    int16(rs.Total),
+	}
+}
+
+// RSConfigs is a list of redundancy scheme configurations.
+//
+// Can be used as a flag.
+type RSConfigs []RSConfig
+
+// Type implements pflag.Value.
+func (RSConfigs) Type() string { return "metainfo.RSConfigs" }
+
+// String is required for pflag.Value.
+func (configs *RSConfigs) String() string {
+	var values []string
+	for i := range *configs {
+		values = append(values, (*configs)[i].String())
+	}
+	return strings.Join(values, ",")
+}
+
+// Set sets the value from a comma separated list of k/m/o/n-size values.
+func (configs *RSConfigs) Set(s string) error {
+	var values RSConfigs
+	for _, value := range strings.Split(s, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		var rs RSConfig
+		if err := rs.Set(value); err != nil {
+			return err
+		}
+		values = append(values, rs)
+	}
+	*configs = values
+	return nil
+}
+
 // RateLimiterConfig is a configuration struct for endpoint rate limiting.
 type RateLimiterConfig struct {
 	Enabled         bool          `help:"whether rate limiting is enabled." releaseDefault:"true" devDefault:"true"`
@@ -98,6 +146,15 @@ type RateLimiterConfig struct {
 	CacheExpiration time.Duration `help:"how long to cache the projects limiter." releaseDefault:"10m" devDefault:"10s"`
 }
 
+// BucketNotificationsConfig is a configuration struct for recording object events of buckets.
+type BucketNotificationsConfig struct {
+	Enabled         bool          `help:"whether object events are recorded for buckets with webhooks configured." default:"true"`
+	CacheCapacity   int           `help:"number of bucket notification configurations to cache." releaseDefault:"10000" devDefault:"10" testDefault:"100"`
+	CacheExpiration time.Duration `help:"how long to cache bucket notification configurations." releaseDefault:"1m" devDefault:"10s"`
+
+	AllowPrivateAddresses bool `help:"whether webhooks may target private, loopback or link-local addresses." default:"false" testDefault:"true"`
+}
+
 // ProjectLimitConfig is a configuration struct for default project limits.
 type ProjectLimitConfig struct {
 	MaxBuckets int `help:"max bucket count for a project." default:"100" testDefault:"10"`
@@ -113,8 +170,11 @@ type Config struct {
 	MaxCommitInterval    time.Duration        `default:"48h" testDefault:"1h" help:"maximum time allowed to pass between creating and committing a segment"`
 	Overlay              bool                 `default:"true" help:"toggle flag if overlay is enabled"`
 	RS                   RSConfig             `releaseDefault:"29/35/80/110-256B" devDefault:"4/6/8/10-256B" help:"redundancy scheme configuration in the format k/m/o/n-sharesize"`
+	AllowedRS            RSConfigs            `default:"" help:"comma separated list of additional redundancy schemes buckets can use, in the format k/m/o/n-sharesize"`
 	SegmentLoop          segmentloop.Config   `help:"segment loop configuration"`
 	RateLimiter          RateLimiterConfig    `help:"rate limiter configuration"`
 	ProjectLimits        ProjectLimitConfig   `help:"project limit configuration"`
 	PieceDeletion        piecedeletion.Config `help:"piece deletion configuration"`
+
+	BucketNotifications BucketNotificationsConfig `help:"bucket notifications configuration"`
 }
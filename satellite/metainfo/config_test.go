@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/storj/satellite/metainfo"
 )
 
@@ -96,3 +97,32 @@ func TestRSConfigValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestRedundancyProfiles(t *testing.T) {
+	var config metainfo.Config
+	require.NoError(t, config.RS.Set("4/6/8/10-256B"))
+	require.NoError(t, config.AllowedRS.Set("4/5/6/7-256B, 4/8/16/20-1KiB"))
+	require.Len(t, config.AllowedRS, 2)
+	require.Equal(t, "4/5/6/7-256 B,4/8/16/20-1.0 KiB", config.AllowedRS.String())
+
+	profiles := metainfo.NewRedundancyProfiles(config)
+	cheaper := config.AllowedRS[0].RedundancyScheme()
+	hotter := config.AllowedRS[1].RedundancyScheme()
+
+	require.NoError(t, profiles.Validate(storj.RedundancyScheme{}))
+	require.NoError(t, profiles.Validate(profiles.Default))
+	require.NoError(t, profiles.Validate(cheaper))
+
+	unknown := cheaper
+	unknown.TotalShares++
+	require.True(t, metainfo.ErrInvalidRedundancy.Has(profiles.Validate(unknown)))
+
+	require.Equal(t, profiles.Default, profiles.ForBucket(storj.RedundancyScheme{}))
+	require.Equal(t, profiles.Default, profiles.ForBucket(unknown))
+	require.Equal(t, cheaper, profiles.ForBucket(cheaper))
+
+	require.Equal(t, 1.0, profiles.StorageFactor(storj.RedundancyScheme{}))
+	require.Equal(t, 0.75, profiles.StorageFactor(unknown))
+	require.Equal(t, 0.75, profiles.StorageFactor(cheaper))
+	require.Equal(t, 2.0, profiles.StorageFactor(hotter))
+}
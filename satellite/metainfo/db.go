@@ -9,9 +9,19 @@ import (
 	"storj.io/common/macaroon"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/placement"
 )
 
+// BucketUploadSettings contains the settings of a bucket that apply to new uploads.
+type BucketUploadSettings struct {
+	Versioning Versioning
+	// Redundancy is zero when the bucket uses the default redundancy scheme.
+	Redundancy storj.RedundancyScheme
+	Placement  placement.Placement
+}
+
 // BucketsDB is the interface for the database to interact with buckets.
 //
 // architecture: Database
@@ -32,4 +42,26 @@ type BucketsDB interface {
 	ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storj.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error)
 	// CountBuckets returns the number of buckets a project currently has
 	CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error)
+	// GetBucketVersioning returns the versioning state of a bucket.
+	GetBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) (Versioning, error)
+	// UpdateBucketVersioning updates the versioning state of a bucket.
+	UpdateBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID, versioning Versioning) error
+	// GetBucketLifecycle returns the lifecycle configuration of a bucket.
+	GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (BucketLifecycle, error)
+	// UpdateBucketLifecycle replaces the lifecycle configuration of a bucket.
+	UpdateBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, lifecycle BucketLifecycle) error
+	// GetBucketUploadSettings returns the settings of a bucket that apply to new uploads.
+	GetBucketUploadSettings(ctx context.Context, bucketName []byte, projectID uuid.UUID) (BucketUploadSettings, error)
+	// GetBucketPlacement returns the placement constraint of a bucket.
+	GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (placement.Placement, error)
+	// UpdateBucketPlacement updates the placement constraint of a bucket.
+	UpdateBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID, placement placement.Placement) error
+	// GetBucketLimits returns the storage and bandwidth limits of a bucket.
+	GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (accounting.BucketLimits, error)
+	// UpdateBucketLimits updates the storage and bandwidth limits of a bucket.
+	UpdateBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits accounting.BucketLimits) error
+	// GetBucketNotifications returns the notification configuration of a bucket.
+	GetBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID) (BucketNotifications, error)
+	// UpdateBucketNotifications replaces the notification configuration of a bucket.
+	UpdateBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID, notifications BucketNotifications) error
 }
@@ -15,6 +15,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
@@ -84,6 +85,89 @@ func TestBasicBucketOperations(t *testing.T) {
 	})
 }
 
+func TestBucketLifecycle(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		consoleDB := db.Console()
+		project, err := consoleDB.Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := db.Buckets()
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		lifecycle, err := bucketsDB.GetBucketLifecycle(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, lifecycle.IsZero())
+
+		expected := metainfo.BucketLifecycle{
+			Rules: []metainfo.LifecycleRule{
+				{ID: "logs", Prefix: []byte("logs/"), ExpireAfterDays: 30},
+				{ID: "uploads", AbortPendingAfterDays: 7, NoncurrentExpireAfterDays: 90},
+			},
+		}
+		err = bucketsDB.UpdateBucketLifecycle(ctx, []byte("testbucket"), project.ID, expected)
+		require.NoError(t, err)
+
+		lifecycle, err = bucketsDB.GetBucketLifecycle(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, lifecycle)
+
+		err = bucketsDB.UpdateBucketLifecycle(ctx, []byte("testbucket"), project.ID, metainfo.BucketLifecycle{})
+		require.NoError(t, err)
+
+		lifecycle, err = bucketsDB.GetBucketLifecycle(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, lifecycle.IsZero())
+
+		_, err = bucketsDB.GetBucketLifecycle(ctx, []byte("missing"), project.ID)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+
+		err = bucketsDB.UpdateBucketLifecycle(ctx, []byte("missing"), project.ID, expected)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+	})
+}
+
+func TestBucketNotifications(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		consoleDB := db.Console()
+		project, err := consoleDB.Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := db.Buckets()
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		notifications, err := bucketsDB.GetBucketNotifications(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, notifications.IsZero())
+
+		expected := metainfo.BucketNotifications{
+			Webhooks: []metainfo.WebhookConfig{
+				{ID: "uploads", URL: "https://example.test/hook", Events: []string{"object-created"}, Prefix: []byte("inbox/")},
+			},
+		}
+		err = bucketsDB.UpdateBucketNotifications(ctx, []byte("testbucket"), project.ID, expected)
+		require.NoError(t, err)
+
+		notifications, err = bucketsDB.GetBucketNotifications(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, notifications)
+
+		err = bucketsDB.UpdateBucketNotifications(ctx, []byte("testbucket"), project.ID, metainfo.BucketNotifications{})
+		require.NoError(t, err)
+
+		notifications, err = bucketsDB.GetBucketNotifications(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, notifications.IsZero())
+
+		_, err = bucketsDB.GetBucketNotifications(ctx, []byte("missing"), project.ID)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+
+		err = bucketsDB.UpdateBucketNotifications(ctx, []byte("missing"), project.ID, expected)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+	})
+}
+
 func TestListBucketsAllAllowed(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -13,6 +13,8 @@ import (
 
 	"storj.io/common/sync2"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketnotifications"
 )
 
 var (
@@ -35,17 +37,19 @@ type Chore struct {
 	log      *zap.Logger
 	config   Config
 	metabase *metabase.DB
+	buckets  *metainfo.Service
 
 	nowFn func() time.Time
 	Loop  *sync2.Cycle
 }
 
 // NewChore creates a new instance of the expireddeletion chore.
-func NewChore(log *zap.Logger, config Config, metabase *metabase.DB) *Chore {
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB, buckets *metainfo.Service) *Chore {
 	return &Chore{
 		log:      log,
 		config:   config,
 		metabase: metabase,
+		buckets:  buckets,
 
 		nowFn: time.Now,
 		Loop:  sync2.NewCycle(config.Interval),
@@ -83,6 +87,7 @@ func (chore *Chore) deleteExpiredObjects(ctx context.Context) (err error) {
 	err = chore.metabase.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
 		ExpiredBefore: chore.nowFn(),
 		BatchSize:     chore.config.ListLimit,
+		Notify:        chore.buckets.NotifyObjects(ctx, bucketnotifications.EventObjectDeleted, metabase.Committed),
 	})
 	if err != nil {
 		chore.log.Error("deleting expired objects failed", zap.Error(err))
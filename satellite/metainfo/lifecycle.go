@@ -0,0 +1,221 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrInvalidLifecycle is used when a bucket lifecycle configuration is not valid.
+var ErrInvalidLifecycle = errs.Class("invalid bucket lifecycle")
+
+// MaxLifecycleRules is the maximum number of rules in a bucket lifecycle configuration.
+const MaxLifecycleRules = 100
+
+// LifecycleRule describes automatic removal of objects under a key prefix.
+//
+// A zero number of days means that the corresponding action is disabled.
+type LifecycleRule struct {
+	ID string `json:"id"`
+	// Prefix limits the rule to objects whose (encrypted) object key starts with it.
+	Prefix []byte `json:"prefix,omitempty"`
+
+	// ExpireAfterDays removes the current version of an object, after it was created.
+	// In versioned buckets a delete marker is created instead.
+	ExpireAfterDays int `json:"expire_after_days,omitempty"`
+	// AbortPendingAfterDays removes pending objects, after the upload was started.
+	AbortPendingAfterDays int `json:"abort_pending_after_days,omitempty"`
+	// NoncurrentExpireAfterDays removes previous versions of an object, after they became noncurrent.
+	NoncurrentExpireAfterDays int `json:"noncurrent_expire_after_days,omitempty"`
+}
+
+// Matches returns whether the rule applies to the object key.
+func (rule *LifecycleRule) Matches(key metabase.ObjectKey) bool {
+	return bytes.HasPrefix([]byte(key), rule.Prefix)
+}
+
+// BucketLifecycle is the lifecycle configuration of a bucket.
+type BucketLifecycle struct {
+	Rules []LifecycleRule `json:"rules"`
+}
+
+// Verify verifies bucket lifecycle rules.
+func (lifecycle *BucketLifecycle) Verify() error {
+	if len(lifecycle.Rules) > MaxLifecycleRules {
+		return ErrInvalidLifecycle.New("too many rules: %d, maximum is %d", len(lifecycle.Rules), MaxLifecycleRules)
+	}
+
+	ids := map[string]struct{}{}
+	for _, rule := range lifecycle.Rules {
+		if rule.ID == "" {
+			return ErrInvalidLifecycle.New("rule id missing")
+		}
+		if _, exists := ids[rule.ID]; exists {
+			return ErrInvalidLifecycle.New("duplicate rule id %q", rule.ID)
+		}
+		ids[rule.ID] = struct{}{}
+
+		if rule.ExpireAfterDays < 0 || rule.AbortPendingAfterDays < 0 || rule.NoncurrentExpireAfterDays < 0 {
+			return ErrInvalidLifecycle.New("rule %q: number of days cannot be negative", rule.ID)
+		}
+		if rule.ExpireAfterDays == 0 && rule.AbortPendingAfterDays == 0 && rule.NoncurrentExpireAfterDays == 0 {
+			return ErrInvalidLifecycle.New("rule %q: no action specified", rule.ID)
+		}
+	}
+	return nil
+}
+
+// IsZero returns whether the bucket has no lifecycle rules.
+func (lifecycle *BucketLifecycle) IsZero() bool {
+	return len(lifecycle.Rules) == 0
+}
+
+// ExpireAfterDays returns the number of days after which the current version
+// of the object expires. Zero means that the object doesn't expire.
+func (lifecycle *BucketLifecycle) ExpireAfterDays(key metabase.ObjectKey) int {
+	return lifecycle.minDays(key, func(rule *LifecycleRule) int { return rule.ExpireAfterDays })
+}
+
+// AbortPendingAfterDays returns the number of days after which the pending
+// object is removed. Zero means that the pending object is kept.
+func (lifecycle *BucketLifecycle) AbortPendingAfterDays(key metabase.ObjectKey) int {
+	return lifecycle.minDays(key, func(rule *LifecycleRule) int { return rule.AbortPendingAfterDays })
+}
+
+// NoncurrentExpireAfterDays returns the number of days after which noncurrent
+// versions of the object are removed. Zero means that they are kept.
+func (lifecycle *BucketLifecycle) NoncurrentExpireAfterDays(key metabase.ObjectKey) int {
+	return lifecycle.minDays(key, func(rule *LifecycleRule) int { return rule.NoncurrentExpireAfterDays })
+}
+
+// minDays returns the smallest non-zero number of days of the rules matching the key.
+// When multiple rules apply to the same object, the most aggressive one wins.
+func (lifecycle *BucketLifecycle) minDays(key metabase.ObjectKey, days func(rule *LifecycleRule) int) int {
+	min := 0
+	for i := range lifecycle.Rules {
+		rule := &lifecycle.Rules[i]
+		if !rule.Matches(key) {
+			continue
+		}
+		if d := days(rule); d > 0 && (min == 0 || d < min) {
+			min = d
+		}
+	}
+	return min
+}
+
+// GetBucketLifecycle returns the lifecycle configuration of a bucket.
+func (s *Service) GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.GetBucketLifecycle(ctx, bucketName, projectID)
+}
+
+// SetBucketLifecycle replaces the lifecycle configuration of a bucket.
+func (s *Service) SetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, lifecycle BucketLifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := lifecycle.Verify(); err != nil {
+		return err
+	}
+	return s.bucketsDB.UpdateBucketLifecycle(ctx, bucketName, projectID, lifecycle)
+}
+
+// DeleteBucketLifecycle removes all lifecycle rules of a bucket.
+func (s *Service) DeleteBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.UpdateBucketLifecycle(ctx, bucketName, projectID, BucketLifecycle{})
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket.
+func (endpoint *Endpoint) GetBucketLifecycle(ctx context.Context, req *internalpb.BucketGetLifecycleRequest) (resp *internalpb.BucketGetLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycle, err := endpoint.metainfo.GetBucketLifecycle(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	resp = &internalpb.BucketGetLifecycleResponse{}
+	for _, rule := range lifecycle.Rules {
+		resp.Rules = append(resp.Rules, &internalpb.LifecycleRule{
+			Id:                        rule.ID,
+			EncryptedPrefix:           rule.Prefix,
+			ExpireAfterDays:           int32(rule.ExpireAfterDays),
+			AbortPendingAfterDays:     int32(rule.AbortPendingAfterDays),
+			NoncurrentExpireAfterDays: int32(rule.NoncurrentExpireAfterDays),
+		})
+	}
+	return resp, nil
+}
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket. The rules remove
+// objects, so the request needs both write and delete permissions.
+func (endpoint *Endpoint) SetBucketLifecycle(ctx context.Context, req *internalpb.BucketSetLifecycleRequest) (resp *internalpb.BucketSetLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionWrite,
+				Bucket: req.Name,
+				Time:   now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionDelete,
+				Bucket: req.Name,
+				Time:   now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycle := BucketLifecycle{}
+	for _, rule := range req.Rules {
+		lifecycle.Rules = append(lifecycle.Rules, LifecycleRule{
+			ID:                        rule.Id,
+			Prefix:                    rule.EncryptedPrefix,
+			ExpireAfterDays:           int(rule.ExpireAfterDays),
+			AbortPendingAfterDays:     int(rule.AbortPendingAfterDays),
+			NoncurrentExpireAfterDays: int(rule.NoncurrentExpireAfterDays),
+		})
+	}
+
+	err = endpoint.metainfo.SetBucketLifecycle(ctx, req.Name, keyInfo.ProjectID, lifecycle)
+	if err != nil {
+		if ErrInvalidLifecycle.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	endpoint.log.Info("Bucket Set Lifecycle", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "set_lifecycle"), zap.String("type", "bucket"))
+	mon.Meter("req_set_bucket_lifecycle").Mark(1)
+
+	return &internalpb.BucketSetLifecycleResponse{}, nil
+}
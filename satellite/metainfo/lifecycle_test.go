@@ -0,0 +1,105 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/metainfo"
+)
+
+func TestBucketLifecycleVerify(t *testing.T) {
+	tests := []struct {
+		description string
+		lifecycle   metainfo.BucketLifecycle
+		expectError bool
+	}{
+		{
+			description: "no rules",
+			lifecycle:   metainfo.BucketLifecycle{},
+		},
+		{
+			description: "valid rules",
+			lifecycle: metainfo.BucketLifecycle{Rules: []metainfo.LifecycleRule{
+				{ID: "a", Prefix: []byte("logs/"), ExpireAfterDays: 1},
+				{ID: "b", AbortPendingAfterDays: 1, NoncurrentExpireAfterDays: 2},
+			}},
+		},
+		{
+			description: "missing id",
+			lifecycle: metainfo.BucketLifecycle{Rules: []metainfo.LifecycleRule{
+				{ExpireAfterDays: 1},
+			}},
+			expectError: true,
+		},
+		{
+			description: "duplicate id",
+			lifecycle: metainfo.BucketLifecycle{Rules: []metainfo.LifecycleRule{
+				{ID: "a", ExpireAfterDays: 1},
+				{ID: "a", ExpireAfterDays: 2},
+			}},
+			expectError: true,
+		},
+		{
+			description: "negative days",
+			lifecycle: metainfo.BucketLifecycle{Rules: []metainfo.LifecycleRule{
+				{ID: "a", ExpireAfterDays: -1},
+			}},
+			expectError: true,
+		},
+		{
+			description: "no action",
+			lifecycle: metainfo.BucketLifecycle{Rules: []metainfo.LifecycleRule{
+				{ID: "a", Prefix: []byte("logs/")},
+			}},
+			expectError: true,
+		},
+		{
+			description: "too many rules",
+			lifecycle: func() metainfo.BucketLifecycle {
+				var lifecycle metainfo.BucketLifecycle
+				for i := 0; i <= metainfo.MaxLifecycleRules; i++ {
+					lifecycle.Rules = append(lifecycle.Rules, metainfo.LifecycleRule{
+						ID: strconv.Itoa(i), ExpireAfterDays: 1,
+					})
+				}
+				return lifecycle
+			}(),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		err := tt.lifecycle.Verify()
+		if tt.expectError {
+			require.True(t, metainfo.ErrInvalidLifecycle.Has(err), tt.description)
+		} else {
+			require.NoError(t, err, tt.description)
+		}
+	}
+}
+
+func TestBucketLifecycleDays(t *testing.T) {
+	lifecycle := metainfo.BucketLifecycle{Rules: []metainfo.LifecycleRule{
+		{ID: "all", AbortPendingAfterDays: 7, NoncurrentExpireAfterDays: 30},
+		{ID: "logs", Prefix: []byte("logs/"), ExpireAfterDays: 10, NoncurrentExpireAfterDays: 1},
+		{ID: "old-logs", Prefix: []byte("logs/old/"), ExpireAfterDays: 2},
+	}}
+
+	require.Equal(t, 0, lifecycle.ExpireAfterDays("data/a"))
+	require.Equal(t, 10, lifecycle.ExpireAfterDays("logs/a"))
+	require.Equal(t, 2, lifecycle.ExpireAfterDays("logs/old/a"))
+
+	require.Equal(t, 7, lifecycle.AbortPendingAfterDays("data/a"))
+	require.Equal(t, 7, lifecycle.AbortPendingAfterDays("logs/a"))
+
+	require.Equal(t, 30, lifecycle.NoncurrentExpireAfterDays("data/a"))
+	require.Equal(t, 1, lifecycle.NoncurrentExpireAfterDays("logs/old/a"))
+
+	empty := metainfo.BucketLifecycle{}
+	require.Equal(t, 0, empty.ExpireAfterDays("logs/a"))
+}
@@ -28,10 +28,12 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo/bucketnotifications"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
 	"storj.io/storj/satellite/metainfo/pointerverification"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/placement"
 	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/rewards"
 	"storj.io/uplink/private/eestream"
@@ -77,11 +79,15 @@ type Endpoint struct {
 	apiKeys              APIKeys
 	satellite            signing.Signer
 	limiterCache         *lrucache.ExpiringLRU
+	apiKeyLimiterCache   *lrucache.ExpiringLRU
 	encInlineSegmentSize int64 // max inline segment size + encryption overhead
 	revocations          revocation.DB
 	defaultRS            *pb.RedundancyScheme
+	redundancy           RedundancyProfiles
 	config               Config
 	versionCollector     *versionCollector
+
+	notificationsCache *lrucache.ExpiringLRU
 }
 
 // NewEndpoint creates new metainfo endpoint instance.
@@ -89,7 +95,8 @@ func NewEndpoint(log *zap.Logger, metainfo *Service, deletePieces *piecedeletion
 	orders *orders.Service, cache *overlay.Service, attributions attribution.DB,
 	partners *rewards.PartnersService, peerIdentities overlay.PeerIdentities,
 	apiKeys APIKeys, projectUsage *accounting.Service, projects console.Projects,
-	satellite signing.Signer, revocations revocation.DB, config Config) (*Endpoint, error) {
+	satellite signing.Signer, revocations revocation.DB,
+	config Config) (*Endpoint, error) {
 	// TODO do something with too many params
 
 	encInlineSegmentSize, err := encryption.CalcEncryptedSize(config.MaxInlineSegmentSize.Int64(), storj.EncryptionParameters{
@@ -100,14 +107,7 @@ func NewEndpoint(log *zap.Logger, metainfo *Service, deletePieces *piecedeletion
 		return nil, err
 	}
 
-	defaultRSScheme := &pb.RedundancyScheme{
-		Type:             pb.RedundancyScheme_RS,
-		MinReq:           int32(config.RS.Min),
-		RepairThreshold:  int32(config.RS.Repair),
-		SuccessThreshold: int32(config.RS.Success),
-		Total:            int32(config.RS.Total),
-		ErasureShareSize: config.RS.ErasureShareSize.Int32(),
-	}
+	redundancy := NewRedundancyProfiles(config)
 
 	return &Endpoint{
 		log:                 log,
@@ -126,11 +126,20 @@ func NewEndpoint(log *zap.Logger, metainfo *Service, deletePieces *piecedeletion
 			Capacity:   config.RateLimiter.CacheCapacity,
 			Expiration: config.RateLimiter.CacheExpiration,
 		}),
+		apiKeyLimiterCache: lrucache.New(lrucache.Options{
+			Capacity:   config.RateLimiter.CacheCapacity,
+			Expiration: config.RateLimiter.CacheExpiration,
+		}),
 		encInlineSegmentSize: encInlineSegmentSize,
 		revocations:          revocations,
-		defaultRS:            defaultRSScheme,
+		defaultRS:            redundancyToProto(redundancy.Default),
+		redundancy:           redundancy,
 		config:               config,
 		versionCollector:     newVersionCollector(),
+		notificationsCache: lrucache.New(lrucache.Options{
+			Capacity:   config.BucketNotifications.CacheCapacity,
+			Expiration: config.BucketNotifications.CacheExpiration,
+		}),
 	}, nil
 }
 
@@ -193,8 +202,7 @@ func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetReques
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	// override RS to fit satellite settings
-	convBucket, err := convertBucketToProto(bucket, endpoint.defaultRS)
+	convBucket, err := convertBucketToProto(bucket, endpoint.redundancyForBucket(bucket.DefaultRedundancyScheme))
 	if err != nil {
 		return resp, err
 	}
@@ -262,6 +270,13 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	// uplinks used to send their own default redundancy scheme, which was ignored,
+	// so a scheme that isn't allowed makes the bucket use the default instead of failing.
+	if err := endpoint.redundancy.Validate(bucketReq.DefaultRedundancyScheme); err != nil {
+		endpoint.log.Debug("ignoring bucket redundancy scheme", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Error(err))
+		bucketReq.DefaultRedundancyScheme = storj.RedundancyScheme{}
+	}
+
 	bucket, err := endpoint.metainfo.CreateBucket(ctx, bucketReq)
 	if err != nil {
 		endpoint.log.Error("error while creating bucket", zap.String("bucketName", bucketReq.Name), zap.Error(err))
@@ -273,8 +288,7 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		return nil, err
 	}
 
-	// override RS to fit satellite settings
-	convBucket, err := convertBucketToProto(bucket, endpoint.defaultRS)
+	convBucket, err := convertBucketToProto(bucket, endpoint.redundancyForBucket(bucket.DefaultRedundancyScheme))
 	if err != nil {
 		endpoint.log.Error("error while converting bucket to proto", zap.String("bucketName", bucket.Name), zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
@@ -296,11 +310,35 @@ func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDelete
 
 	now := time.Now()
 
-	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
-		Op:     macaroon.ActionDelete,
-		Bucket: req.Name,
-		Time:   now,
-	})
+	var canRead, canList bool
+
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionDelete,
+				Bucket: req.Name,
+				Time:   now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionRead,
+				Bucket: req.Name,
+				Time:   now,
+			},
+			actionPermitted: &canRead,
+			optional:        true,
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionList,
+				Bucket: req.Name,
+				Time:   now,
+			},
+			actionPermitted: &canList,
+			optional:        true,
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -310,20 +348,6 @@ func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDelete
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
-		Op:     macaroon.ActionRead,
-		Bucket: req.Name,
-		Time:   now,
-	})
-	canRead := err == nil
-
-	_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
-		Op:     macaroon.ActionList,
-		Bucket: req.Name,
-		Time:   now,
-	})
-	canList := err == nil
-
 	var (
 		bucket     storj.Bucket
 		convBucket *pb.Bucket
@@ -338,7 +362,7 @@ func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDelete
 			return nil, err
 		}
 
-		convBucket, err = convertBucketToProto(bucket, endpoint.defaultRS)
+		convBucket, err = convertBucketToProto(bucket, endpoint.redundancyForBucket(bucket.DefaultRedundancyScheme))
 		if err != nil {
 			return nil, err
 		}
@@ -385,6 +409,13 @@ func (endpoint *Endpoint) deleteBucketNotEmpty(ctx context.Context, projectID uu
 	err = endpoint.metainfo.DeleteBucket(ctx, bucketName, projectID)
 	if err != nil {
 		if ErrBucketNotEmpty.Has(err) {
+			locked, lockErr := endpoint.metainfo.metabaseDB.HasLockedObjects(ctx, metabase.HasLockedObjects{
+				ProjectID:  projectID,
+				BucketName: string(bucketName),
+			})
+			if lockErr == nil && locked {
+				return nil, deletedCount, rpcstatus.Error(rpcstatus.FailedPrecondition, "cannot delete the bucket because it contains locked objects")
+			}
 			return nil, deletedCount, rpcstatus.Error(rpcstatus.FailedPrecondition, "cannot delete the bucket because it's being used by another process")
 		}
 		if storj.ErrBucketNotFound.Has(err) {
@@ -490,7 +521,11 @@ func convertProtoToBucket(req *pb.BucketCreateRequest, projectID uuid.UUID) (buc
 		return storj.Bucket{}, err
 	}
 
-	defaultRS := req.GetDefaultRedundancyScheme()
+	// buckets without a redundancy scheme use the satellite default
+	var defaultRS storj.RedundancyScheme
+	if req.GetDefaultRedundancyScheme().GetTotal() != 0 {
+		defaultRS = redundancyFromProto(req.GetDefaultRedundancyScheme())
+	}
 	defaultEP := req.GetDefaultEncryptionParameters()
 
 	// TODO: resolve partner id
@@ -504,20 +539,13 @@ func convertProtoToBucket(req *pb.BucketCreateRequest, projectID uuid.UUID) (buc
 	}
 
 	return storj.Bucket{
-		ID:                  bucketID,
-		Name:                string(req.GetName()),
-		ProjectID:           projectID,
-		PartnerID:           partnerID,
-		PathCipher:          storj.CipherSuite(req.GetPathCipher()),
-		DefaultSegmentsSize: req.GetDefaultSegmentSize(),
-		DefaultRedundancyScheme: storj.RedundancyScheme{
-			Algorithm:      storj.RedundancyAlgorithm(defaultRS.GetType()),
-			ShareSize:      defaultRS.GetErasureShareSize(),
-			RequiredShares: int16(defaultRS.GetMinReq()),
-			RepairShares:   int16(defaultRS.GetRepairThreshold()),
-			OptimalShares:  int16(defaultRS.GetSuccessThreshold()),
-			TotalShares:    int16(defaultRS.GetTotal()),
-		},
+		ID:                      bucketID,
+		Name:                    string(req.GetName()),
+		ProjectID:               projectID,
+		PartnerID:               partnerID,
+		PathCipher:              storj.CipherSuite(req.GetPathCipher()),
+		DefaultSegmentsSize:     req.GetDefaultSegmentSize(),
+		DefaultRedundancyScheme: defaultRS,
 		DefaultEncryptionParameters: storj.EncryptionParameters{
 			CipherSuite: storj.CipherSuite(defaultEP.CipherSuite),
 			BlockSize:   int32(defaultEP.BlockSize),
@@ -570,12 +598,30 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		endpoint.log.Warn("unable to collect uplink version", zap.Error(err))
 	}
 
-	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
-		Op:            macaroon.ActionWrite,
-		Bucket:        req.Bucket,
-		EncryptedPath: req.EncryptedPath,
-		Time:          time.Now(),
-	})
+	now := time.Now()
+
+	var canDelete bool
+
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionWrite,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedPath,
+				Time:          now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionDelete,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedPath,
+				Time:          now,
+			},
+			actionPermitted: &canDelete,
+			optional:        true,
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -590,50 +636,59 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 	}
 
 	// TODO this needs to be optimized to avoid DB call on each request
-	exists, err := endpoint.metainfo.HasBucket(ctx, req.Bucket, keyInfo.ProjectID)
+	settings, err := endpoint.metainfo.GetBucketUploadSettings(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, "bucket not found: non-existing-bucket")
+		}
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
-	} else if !exists {
-		return nil, rpcstatus.Error(rpcstatus.NotFound, "bucket not found: non-existing-bucket")
 	}
 
-	_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
-		Op:            macaroon.ActionDelete,
-		Bucket:        req.Bucket,
-		EncryptedPath: req.EncryptedPath,
-		Time:          time.Now(),
-	})
-	canDelete := err == nil
+	var overwrite bool
+	if settings.Versioning != VersioningEnabled {
+		_, err = endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+			ObjectLocation: metabase.ObjectLocation{
+				ProjectID:  keyInfo.ProjectID,
+				BucketName: string(req.Bucket),
+				ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+			},
+		})
+		overwrite = err == nil
+	}
 
-	if canDelete {
+	// an overwrite replaces the existing object, so it doesn't add to the object count.
+	if !overwrite {
+		if err := endpoint.checkExceedsObjectUsage(ctx, keyInfo.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case settings.Versioning == VersioningEnabled:
+		// a new version doesn't overwrite the previous ones, so no delete permission is needed
+	case canDelete:
 		_, err = endpoint.DeleteObjectAnyStatus(ctx, metabase.ObjectLocation{
 			ProjectID:  keyInfo.ProjectID,
 			BucketName: string(req.Bucket),
 			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
 		})
 		if err != nil && !storj.ErrObjectNotFound.Has(err) {
+			if metabase.ErrObjectLocked.Has(err) {
+				return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "object is locked and cannot be overwritten")
+			}
 			return nil, err
 		}
-	} else {
-		_, err = endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
-			ObjectLocation: metabase.ObjectLocation{
-				ProjectID:  keyInfo.ProjectID,
-				BucketName: string(req.Bucket),
-				ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-			},
-		})
-		if err == nil {
-			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
-		}
+	case overwrite:
+		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
 	}
 
 	if err := endpoint.ensureAttribution(ctx, req.Header, keyInfo, req.Bucket); err != nil {
 		return nil, err
 	}
 
-	// use only satellite values for Redundancy Scheme
-	pbRS := endpoint.defaultRS
+	pbRS := endpoint.redundancyForBucket(settings.Redundancy)
+
 	streamID, err := uuid.New()
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -654,22 +709,18 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		expiresAt = &req.ExpiresAt
 	}
 
-	object, err := endpoint.metainfo.metabaseDB.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
-		ObjectStream: metabase.ObjectStream{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-			StreamID:   streamID,
-			Version:    metabase.Version(1),
-		},
-		ExpiresAt:  expiresAt,
-		Encryption: encryptionParameters,
-	})
+	object, err := endpoint.beginObject(ctx, metabase.ObjectStream{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+		StreamID:   streamID,
+	}, settings.Versioning, expiresAt, encryptionParameters)
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
+	// the placement is looked up once per object and passed to BeginSegment in the stream ID
 	satStreamID, err := endpoint.packStreamID(ctx, &internalpb.StreamID{
 		Bucket:               req.Bucket,
 		EncryptedPath:        req.EncryptedPath,
@@ -680,6 +731,8 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		StreamId:             streamID[:],
 		MultipartObject:      object.FixedSegmentSize <= 0,
 		EncryptionParameters: req.EncryptionParameters,
+		Placement:            int32(settings.Placement),
+		PlacementKnown:       true,
 	})
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -698,6 +751,39 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 	}, nil
 }
 
+// beginObject adds a pending object. In versioned buckets the object gets the next
+// available version, otherwise it always uses the first version.
+func (endpoint *Endpoint) beginObject(ctx context.Context, stream metabase.ObjectStream, versioning Versioning, expiresAt *time.Time, encryption storj.EncryptionParameters) (_ metabase.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if versioning != VersioningEnabled {
+		stream.Version = metabase.Version(1)
+		return endpoint.metainfo.metabaseDB.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
+			ObjectStream: stream,
+			ExpiresAt:    expiresAt,
+			Encryption:   encryption,
+		})
+	}
+
+	stream.Version = metabase.NextVersion
+	stream.Version, err = endpoint.metainfo.metabaseDB.BeginObjectNextVersion(ctx, metabase.BeginObjectNextVersion{
+		ObjectStream: stream,
+		ExpiresAt:    expiresAt,
+		Encryption:   encryption,
+	})
+	if err != nil {
+		return metabase.Object{}, err
+	}
+
+	return metabase.Object{
+		ObjectStream: stream,
+		CreatedAt:    time.Now(),
+		ExpiresAt:    expiresAt,
+		Status:       metabase.Pending,
+		Encryption:   encryption,
+	}, nil
+}
+
 // CommitObject commits an object when all its segments have already been committed.
 func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommitRequest) (resp *pb.ObjectCommitResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -748,25 +834,45 @@ func (endpoint *Endpoint) commitObject(ctx context.Context, req *pb.ObjectCommit
 		encryption.BlockSize = streamMeta.EncryptionBlockSize
 	}
 
+	notify, err := endpoint.notifyObjects(ctx, metabase.BucketLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(streamID.Bucket),
+	}, bucketnotifications.EventObjectCreated, metabase.Committed)
+	if err != nil {
+		endpoint.log.Error("unable to get bucket notifications", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to get bucket notifications")
+	}
+
 	_, err = endpoint.metainfo.metabaseDB.CommitObject(ctx, metabase.CommitObject{
 		ObjectStream: metabase.ObjectStream{
 			ProjectID:  keyInfo.ProjectID,
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedPath),
 			StreamID:   id,
-			Version:    metabase.Version(1),
+			Version:    metabase.Version(streamID.Version),
 		},
 		EncryptedMetadata:             req.EncryptedMetadata,
 		EncryptedMetadataNonce:        req.EncryptedMetadataNonce[:],
 		EncryptedMetadataEncryptedKey: req.EncryptedMetadataEncryptedKey,
 
 		Encryption: encryption,
+		Notify:     notify,
 	})
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
+	if err := endpoint.projectUsage.AddProjectObjectUsage(ctx, keyInfo.ProjectID, 1); err != nil {
+		// log it and continue. it's most likely our own fault that we couldn't
+		// track it, and the only thing that will be affected is our per-project
+		// object limits.
+		endpoint.log.Error("Could not track new project's object usage",
+			zap.Stringer("Project ID", keyInfo.ProjectID),
+			zap.Error(err),
+		)
+	}
+
 	return &pb.ObjectCommitResponse{}, nil
 }
 
@@ -794,13 +900,23 @@ func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetReques
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	mbObject, err := endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
-		ObjectLocation: metabase.ObjectLocation{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-		},
-	})
+	location := metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+	}
+
+	var mbObject metabase.Object
+	if req.Version > 0 {
+		mbObject, err = endpoint.metainfo.metabaseDB.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+			ObjectLocation: location,
+			Version:        metabase.Version(req.Version),
+		})
+	} else {
+		mbObject, err = endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+			ObjectLocation: location,
+		})
+	}
 	if err != nil {
 		if storj.ErrObjectNotFound.Has(err) {
 			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
@@ -884,6 +1000,11 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.Bucket)}
+	if err := endpoint.checkExceedsBucketBandwidthUsage(ctx, bucket); err != nil {
+		return nil, err
+	}
+
 	// get the object information
 
 	object, err := endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
@@ -949,13 +1070,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		downloadSizes := endpoint.calculateDownloadSizes(streamRange, segment, object.Encryption)
 
 		// Update the current bandwidth cache value incrementing the SegmentSize.
-		err = endpoint.projectUsage.UpdateProjectBandwidthUsage(ctx, keyInfo.ProjectID, downloadSizes.encryptedSize)
-		if err != nil {
-			// log it and continue. it's most likely our own fault that we couldn't
-			// track it, and the only thing that will be affected is our per-project
-			// bandwidth limits.
-			endpoint.log.Error("Could not track the new project's bandwidth usage", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Error(err))
-		}
+		endpoint.addBandwidthUsage(ctx, bucket, downloadSizes.encryptedSize)
 
 		encryptedKeyNonce, err := storj.NonceFromBytes(segment.EncryptedKeyNonce)
 		if err != nil {
@@ -1214,13 +1329,15 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 	}
 
 	// TODO this needs to be optimized to avoid DB call on each request
-	exists, err := endpoint.metainfo.HasBucket(ctx, req.Bucket, keyInfo.ProjectID)
+	bucketRS, err := endpoint.metainfo.GetBucketRedundancy(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, "bucket not found: non-existing-bucket")
+		}
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
-	} else if !exists {
-		return nil, rpcstatus.Error(rpcstatus.NotFound, "bucket not found: non-existing-bucket")
 	}
+	pbRS := endpoint.redundancyForBucket(bucketRS)
 
 	limit := int(req.Limit)
 	if limit < 0 {
@@ -1253,33 +1370,48 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 	}
 
 	resp = &pb.ObjectListResponse{}
-	// TODO: Replace with IterateObjectsLatestVersion when ready
-	err = endpoint.metainfo.metabaseDB.IterateObjectsAllVersionsWithStatus(ctx,
-		metabase.IterateObjectsWithStatus{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			Prefix:     prefix,
-			Cursor: metabase.IterateCursor{
-				Key:     metabase.ObjectKey(cursor),
-				Version: 1, // TODO: set to a the version from the protobuf request when it supports this
-			},
-			Recursive:       req.Recursive,
-			BatchSize:       limit + 1,
-			Status:          status,
-			IncludeMetadata: includeMetadata,
-		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
-			entry := metabase.ObjectEntry{}
-			for len(resp.Items) < limit && it.Next(ctx, &entry) {
-				item, err := endpoint.objectEntryToProtoListItem(ctx, req.Bucket, entry, prefix, includeMetadata)
-				if err != nil {
-					return err
-				}
-				resp.Items = append(resp.Items, item)
+	collect := func(ctx context.Context, it metabase.ObjectsIterator) error {
+		entry := metabase.ObjectEntry{}
+		for len(resp.Items) < limit && it.Next(ctx, &entry) {
+			item, err := endpoint.objectEntryToProtoListItem(ctx, req.Bucket, entry, prefix, includeMetadata, pbRS)
+			if err != nil {
+				return err
 			}
-			resp.More = it.Next(ctx, &entry)
-			return nil
-		},
-	)
+			resp.Items = append(resp.Items, item)
+		}
+		resp.More = it.Next(ctx, &entry)
+		return nil
+	}
+
+	if status == metabase.Committed {
+		err = endpoint.metainfo.metabaseDB.IterateObjectsLatestVersion(ctx,
+			metabase.IterateObjectsLatestVersion{
+				ProjectID:  keyInfo.ProjectID,
+				BucketName: string(req.Bucket),
+				Prefix:     prefix,
+				Cursor: metabase.IterateCursor{
+					Key: metabase.ObjectKey(cursor),
+				},
+				Recursive:       req.Recursive,
+				BatchSize:       limit + 1,
+				IncludeMetadata: includeMetadata,
+			}, collect)
+	} else {
+		err = endpoint.metainfo.metabaseDB.IterateObjectsAllVersionsWithStatus(ctx,
+			metabase.IterateObjectsWithStatus{
+				ProjectID:  keyInfo.ProjectID,
+				BucketName: string(req.Bucket),
+				Prefix:     prefix,
+				Cursor: metabase.IterateCursor{
+					Key:     metabase.ObjectKey(cursor),
+					Version: 1, // TODO: set to a the version from the protobuf request when it supports this
+				},
+				Recursive:       req.Recursive,
+				BatchSize:       limit + 1,
+				Status:          status,
+				IncludeMetadata: includeMetadata,
+			}, collect)
+	}
 	if err != nil {
 		if metabase.ErrInvalidRequest.Has(err) {
 			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -1319,13 +1451,15 @@ func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.
 	}
 
 	// TODO this needs to be optimized to avoid DB call on each request
-	exists, err := endpoint.metainfo.HasBucket(ctx, req.Bucket, keyInfo.ProjectID)
+	bucketRS, err := endpoint.metainfo.GetBucketRedundancy(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, "bucket not found: non-existing-bucket")
+		}
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
-	} else if !exists {
-		return nil, rpcstatus.Error(rpcstatus.NotFound, "bucket not found: non-existing-bucket")
 	}
+	pbRS := endpoint.redundancyForBucket(bucketRS)
 
 	cursor := metabase.StreamIDCursor{}
 	if req.StreamIdCursor != nil {
@@ -1360,7 +1494,7 @@ func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.
 		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
 			entry := metabase.ObjectEntry{}
 			for len(resp.Items) < limit && it.Next(ctx, &entry) {
-				item, err := endpoint.objectEntryToProtoListItem(ctx, req.Bucket, entry, "", true)
+				item, err := endpoint.objectEntryToProtoListItem(ctx, req.Bucket, entry, "", true, pbRS)
 				if err != nil {
 					return err
 				}
@@ -1397,12 +1531,38 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 
 	now := time.Now()
 
-	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
-		Op:            macaroon.ActionDelete,
-		Bucket:        req.Bucket,
-		EncryptedPath: req.EncryptedPath,
-		Time:          now,
-	})
+	var canRead, canList bool
+
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionDelete,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedPath,
+				Time:          now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionRead,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedPath,
+				Time:          now,
+			},
+			actionPermitted: &canRead,
+			optional:        true,
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionList,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedPath,
+				Time:          now,
+			},
+			actionPermitted: &canList,
+			optional:        true,
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1412,22 +1572,6 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
-		Op:            macaroon.ActionRead,
-		Bucket:        req.Bucket,
-		EncryptedPath: req.EncryptedPath,
-		Time:          now,
-	})
-	canRead := err == nil
-
-	_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
-		Op:            macaroon.ActionList,
-		Bucket:        req.Bucket,
-		EncryptedPath: req.EncryptedPath,
-		Time:          now,
-	})
-	canList := err == nil
-
 	var deletedObjects []*pb.Object
 
 	if req.GetStatus() == int32(metabase.Pending) {
@@ -1445,19 +1589,40 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 						ProjectID:  keyInfo.ProjectID,
 						BucketName: string(req.Bucket),
 						ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-						Version:    metabase.Version(req.GetVersion()),
+						Version:    metabase.Version(pbStreamID.Version),
 						StreamID:   streamID,
 					})
 			}
 		}
+	} else if req.GetVersion() > 0 {
+		deletedObjects, err = endpoint.DeleteObjectExactVersion(ctx, metabase.ObjectLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+		}, metabase.Version(req.GetVersion()))
 	} else {
-		deletedObjects, err = endpoint.DeleteCommittedObject(ctx, keyInfo.ProjectID, string(req.Bucket), metabase.ObjectKey(req.EncryptedPath))
+		var versioning Versioning
+		versioning, err = endpoint.metainfo.GetBucketVersioning(ctx, req.Bucket, keyInfo.ProjectID)
+		if err == nil {
+			if versioning == VersioningEnabled {
+				deletedObjects, err = endpoint.CreateDeleteMarker(ctx, metabase.ObjectLocation{
+					ProjectID:  keyInfo.ProjectID,
+					BucketName: string(req.Bucket),
+					ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+				})
+			} else {
+				deletedObjects, err = endpoint.DeleteCommittedObject(ctx, keyInfo.ProjectID, string(req.Bucket), metabase.ObjectKey(req.EncryptedPath))
+			}
+		}
 	}
 	if err != nil {
 		if !canRead && !canList {
 			// No error info is returned if neither Read, nor List permission is granted
 			return &pb.ObjectBeginDeleteResponse{}, nil
 		}
+		if metabase.ErrObjectLocked.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "object is locked and cannot be deleted")
+		}
 		return nil, err
 	}
 
@@ -1660,6 +1825,15 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		return nil, err
 	}
 
+	if err := endpoint.checkExceedsSegmentUsage(ctx, keyInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
+	if err := endpoint.checkExceedsBucketStorageUsage(ctx, bucket); err != nil {
+		return nil, err
+	}
+
 	redundancy, err := eestream.NewRedundancyStrategyFromProto(streamID.Redundancy)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -1667,8 +1841,22 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 
 	maxPieceSize := eestream.CalcPieceSize(req.MaxOrderLimit, redundancy)
 
+	// stream IDs of listed pending objects don't carry the placement
+	placement := placement.Placement(streamID.Placement)
+	if !streamID.PlacementKnown {
+		placement, err = endpoint.metainfo.GetBucketPlacement(ctx, streamID.Bucket, keyInfo.ProjectID)
+		if err != nil {
+			if storj.ErrBucketNotFound.Has(err) {
+				return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+			}
+			endpoint.log.Error("unable to get bucket placement", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+	}
+
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: redundancy.TotalCount(),
+		Placement:      placement,
 	}
 	nodes, err := endpoint.overlay.FindStorageNodesForUpload(ctx, request)
 	if err != nil {
@@ -1676,7 +1864,6 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
 	rootPieceID, addressedLimits, piecePrivateKey, err := endpoint.orders.CreatePutOrderLimits(ctx, bucket, nodes, streamID.ExpirationDate, maxPieceSize)
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -1702,7 +1889,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedPath),
 			StreamID:   id,
-			Version:    1,
+			Version:    metabase.Version(streamID.Version),
 		},
 		Position: metabase.SegmentPosition{
 			Part:  uint32(req.Position.PartNumber),
@@ -1723,6 +1910,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		OriginalOrderLimits: addressedLimits,
 		RootPieceId:         rootPieceID,
 		CreationDate:        time.Now(),
+		Placement:           int32(placement),
 	})
 
 	endpoint.log.Info("Segment Upload", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "put"), zap.String("type", "remote"))
@@ -1732,7 +1920,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		SegmentId:        segmentID,
 		AddressedLimits:  addressedLimits,
 		PrivateKey:       piecePrivateKey,
-		RedundancyScheme: endpoint.defaultRS,
+		RedundancyScheme: streamID.Redundancy,
 	}, nil
 }
 
@@ -1783,14 +1971,7 @@ func (endpoint *Endpoint) commitSegment(ctx context.Context, req *pb.SegmentComm
 		)
 	}
 
-	rs := storj.RedundancyScheme{
-		Algorithm:      storj.RedundancyAlgorithm(endpoint.defaultRS.Type),
-		RequiredShares: int16(endpoint.defaultRS.MinReq),
-		RepairShares:   int16(endpoint.defaultRS.RepairThreshold),
-		OptimalShares:  int16(endpoint.defaultRS.SuccessThreshold),
-		TotalShares:    int16(endpoint.defaultRS.Total),
-		ShareSize:      endpoint.defaultRS.ErasureShareSize,
-	}
+	rs := redundancyFromProto(streamID.Redundancy)
 
 	err = endpoint.pointerVerification.VerifySizes(ctx, rs, req.SizeEncryptedData, req.UploadResult)
 	if err != nil {
@@ -1860,7 +2041,7 @@ func (endpoint *Endpoint) commitSegment(ctx context.Context, req *pb.SegmentComm
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedPath),
 			StreamID:   id,
-			Version:    1,
+			Version:    metabase.Version(streamID.Version),
 		},
 		ExpiresAt:         expiresAt,
 		EncryptedKey:      req.EncryptedKey,
@@ -1878,6 +2059,7 @@ func (endpoint *Endpoint) commitSegment(ctx context.Context, req *pb.SegmentComm
 		RootPieceID: segmentID.RootPieceId,
 		Redundancy:  rs,
 		Pieces:      pieces,
+		Placement:   placement.Placement(segmentID.Placement),
 	}
 
 	err = endpoint.validateRemoteSegment(ctx, mbCommitSegment, originalLimits)
@@ -1889,6 +2071,11 @@ func (endpoint *Endpoint) commitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, nil, err
 	}
 
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
+	if err := endpoint.checkExceedsBucketStorageUsage(ctx, bucket); err != nil {
+		return nil, nil, err
+	}
+
 	segmentSize := req.SizeEncryptedData
 	totalStored := calculateSpaceUsed(segmentSize, len(pieces), rs)
 
@@ -1906,15 +2093,7 @@ func (endpoint *Endpoint) commitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, nil, rpcstatus.Error(rpcstatus.InvalidArgument, "mismatched segment size and piece usage")
 	}
 
-	if err := endpoint.projectUsage.AddProjectStorageUsage(ctx, keyInfo.ProjectID, segmentSize); err != nil {
-		// log it and continue. it's most likely our own fault that we couldn't
-		// track it, and the only thing that will be affected is our per-project
-		// storage limits.
-		endpoint.log.Error("Could not track new project's storage usage",
-			zap.Stringer("Project ID", keyInfo.ProjectID),
-			zap.Error(err),
-		)
-	}
+	endpoint.addStorageUsage(ctx, bucket, segmentSize)
 
 	err = endpoint.metainfo.metabaseDB.CommitSegment(ctx, mbCommitSegment)
 	if err != nil {
@@ -1925,6 +2104,8 @@ func (endpoint *Endpoint) commitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
+	endpoint.addSegmentUsage(ctx, keyInfo.ProjectID)
+
 	return nil, &pb.SegmentCommitResponse{
 		SuccessfulPieces: int32(len(pieces)),
 	}, nil
@@ -1975,16 +2156,17 @@ func (endpoint *Endpoint) makeInlineSegment(ctx context.Context, req *pb.Segment
 		return nil, nil, err
 	}
 
-	if err := endpoint.projectUsage.AddProjectStorageUsage(ctx, keyInfo.ProjectID, inlineUsed); err != nil {
-		// log it and continue. it's most likely our own fault that we couldn't
-		// track it, and the only thing that will be affected is our per-project
-		// bandwidth and storage limits.
-		endpoint.log.Error("Could not track new project's storage usage",
-			zap.Stringer("Project ID", keyInfo.ProjectID),
-			zap.Error(err),
-		)
+	if err := endpoint.checkExceedsSegmentUsage(ctx, keyInfo.ProjectID); err != nil {
+		return nil, nil, err
+	}
+
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
+	if err := endpoint.checkExceedsBucketStorageUsage(ctx, bucket); err != nil {
+		return nil, nil, err
 	}
 
+	endpoint.addStorageUsage(ctx, bucket, inlineUsed)
+
 	id, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -2002,7 +2184,7 @@ func (endpoint *Endpoint) makeInlineSegment(ctx context.Context, req *pb.Segment
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedPath),
 			StreamID:   id,
-			Version:    1,
+			Version:    metabase.Version(streamID.Version),
 		},
 		ExpiresAt:         expiresAt,
 		EncryptedKey:      req.EncryptedKey,
@@ -2026,7 +2208,8 @@ func (endpoint *Endpoint) makeInlineSegment(ctx context.Context, req *pb.Segment
 		return nil, nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
+	endpoint.addSegmentUsage(ctx, keyInfo.ProjectID)
+
 	err = endpoint.orders.UpdatePutInlineOrder(ctx, bucket, inlineUsed)
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -2158,6 +2341,10 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
+	if err := endpoint.checkExceedsBucketBandwidthUsage(ctx, bucket); err != nil {
+		return nil, err
+	}
+
 	id, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -2192,16 +2379,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 	}
 
 	// Update the current bandwidth cache value incrementing the SegmentSize.
-	err = endpoint.projectUsage.UpdateProjectBandwidthUsage(ctx, keyInfo.ProjectID, int64(segment.EncryptedSize))
-	if err != nil {
-		// log it and continue. it's most likely our own fault that we couldn't
-		// track it, and the only thing that will be affected is our per-project
-		// bandwidth limits.
-		endpoint.log.Error("Could not track the new project's bandwidth usage",
-			zap.Stringer("Project ID", keyInfo.ProjectID),
-			zap.Error(err),
-		)
-	}
+	endpoint.addBandwidthUsage(ctx, bucket, int64(segment.EncryptedSize))
 
 	encryptedKeyNonce, err := storj.NonceFromBytes(segment.EncryptedKeyNonce)
 	if err != nil {
@@ -2450,11 +2628,32 @@ func (endpoint *Endpoint) DeleteCommittedObject(
 		ObjectKey:  object,
 	}
 
-	result, err := endpoint.metainfo.metabaseDB.DeleteObjectsAllVersions(ctx, metabase.DeleteObjectsAllVersions{Locations: []metabase.ObjectLocation{req}})
+	notify, err := endpoint.notifyObjects(ctx, req.Bucket(), bucketnotifications.EventObjectDeleted, metabase.Committed)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := endpoint.metainfo.metabaseDB.DeleteObjectsAllVersions(ctx, metabase.DeleteObjectsAllVersions{
+		Locations: []metabase.ObjectLocation{req},
+		Notify:    notify,
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	if len(result.Objects) == 0 {
+		// locked objects are skipped when deleting multiple objects
+		object, err := endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+			ObjectLocation: req,
+		})
+		if err != nil && !storj.ErrObjectNotFound.Has(err) {
+			return nil, err
+		}
+		if err == nil && object.IsLocked(time.Now()) {
+			return nil, metabase.ErrObjectLocked.New("")
+		}
+	}
+
 	deletedObjects, err = endpoint.deleteObjectsPieces(ctx, result)
 	if err != nil {
 		endpoint.log.Error("failed to delete pointers",
@@ -2469,6 +2668,63 @@ func (endpoint *Endpoint) DeleteCommittedObject(
 	return deletedObjects, nil
 }
 
+// DeleteObjectExactVersion deletes a single version of the object. The version
+// may be a delete marker, in which case the previous version becomes visible again.
+func (endpoint *Endpoint) DeleteObjectExactVersion(ctx context.Context, location metabase.ObjectLocation, version metabase.Version) (deletedObjects []*pb.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	notify, err := endpoint.notifyObjects(ctx, location.Bucket(), bucketnotifications.EventObjectDeleted, metabase.Committed)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := endpoint.metainfo.metabaseDB.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+		ObjectLocation: location,
+		Version:        version,
+		Notify:         notify,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoint.deleteObjectsPieces(ctx, result)
+}
+
+// CreateDeleteMarker hides the latest version of the object in a versioned bucket.
+// The data isn't deleted and previous versions are still accessible.
+func (endpoint *Endpoint) CreateDeleteMarker(ctx context.Context, location metabase.ObjectLocation) (deletedObjects []*pb.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object, err := endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+		ObjectLocation: location,
+	})
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	notify, err := endpoint.notifyObjects(ctx, location.Bucket(), bucketnotifications.EventObjectDeleted, metabase.DeleteMarker)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = endpoint.metainfo.metabaseDB.CreateDeleteMarker(ctx, metabase.CreateDeleteMarker{
+		ObjectLocation: location,
+		Notify:         notify,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deletedObject, err := endpoint.objectToProto(ctx, object, endpoint.defaultRS)
+	if err != nil {
+		return nil, err
+	}
+	return []*pb.Object{deletedObject}, nil
+}
+
 // DeleteObjectAnyStatus deletes all the pieces of the storage nodes that belongs
 // to the specified object.
 //
@@ -2485,6 +2741,10 @@ func (endpoint *Endpoint) DeleteObjectAnyStatus(ctx context.Context, location me
 		return nil, err
 	}
 
+	// the object is deleted before it's overwritten, so the new commit mustn't
+	// count it or its segments a second time against the project limits.
+	endpoint.removeObjectUsage(ctx, location.ProjectID, committedObjects(result.Objects))
+
 	deletedObjects, err = endpoint.deleteObjectsPieces(ctx, result)
 	if err != nil {
 		endpoint.log.Error("failed to delete pointers",
@@ -2641,7 +2901,7 @@ func (endpoint *Endpoint) objectToProto(ctx context.Context, object metabase.Obj
 	return result, nil
 }
 
-func (endpoint *Endpoint) objectEntryToProtoListItem(ctx context.Context, bucket []byte, entry metabase.ObjectEntry, prefixToPrependInSatStreamID metabase.ObjectKey, includeMetadata bool) (item *pb.ObjectListItem, err error) {
+func (endpoint *Endpoint) objectEntryToProtoListItem(ctx context.Context, bucket []byte, entry metabase.ObjectEntry, prefixToPrependInSatStreamID metabase.ObjectKey, includeMetadata bool, rs *pb.RedundancyScheme) (item *pb.ObjectListItem, err error) {
 	expires := time.Time{}
 	if entry.ExpiresAt != nil {
 		expires = *entry.ExpiresAt
@@ -2708,9 +2968,9 @@ func (endpoint *Endpoint) objectEntryToProtoListItem(ctx context.Context, bucket
 			ExpirationDate:  item.ExpiresAt,
 			StreamId:        entry.StreamID[:],
 			MultipartObject: entry.FixedSegmentSize <= 0,
-			// TODO: defaultRS may change while the upload is pending.
+			// TODO: the redundancy of the bucket may change while the upload is pending.
 			// Ideally, we should remove redundancy from satStreamID.
-			Redundancy: endpoint.defaultRS,
+			Redundancy: rs,
 			EncryptionParameters: &pb.EncryptionParameters{
 				CipherSuite: pb.CipherSuite(entry.Encryption.CipherSuite),
 				BlockSize:   int64(entry.Encryption.BlockSize),
@@ -2786,6 +3046,176 @@ func (endpoint *Endpoint) checkExceedsStorageUsage(ctx context.Context, projectI
 	return nil
 }
 
+func (endpoint *Endpoint) checkExceedsSegmentUsage(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limit, err := endpoint.projectUsage.ExceedsSegmentUsage(ctx, projectID)
+	if err != nil {
+		endpoint.log.Error(
+			"Retrieving project segment totals failed; segment limit won't be enforced",
+			zap.Error(err),
+		)
+	} else if exceeded {
+		endpoint.log.Error("Segment limit exceeded",
+			zap.Int64("Limit", limit),
+			zap.Stringer("Project ID", projectID),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Segments Limit")
+	}
+
+	return nil
+}
+
+func (endpoint *Endpoint) checkExceedsObjectUsage(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limit, err := endpoint.projectUsage.ExceedsObjectUsage(ctx, projectID)
+	if err != nil {
+		endpoint.log.Error(
+			"Retrieving project object totals failed; object limit won't be enforced",
+			zap.Error(err),
+		)
+	} else if exceeded {
+		endpoint.log.Error("Object limit exceeded",
+			zap.Int64("Limit", limit),
+			zap.Stringer("Project ID", projectID),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Objects Limit")
+	}
+
+	return nil
+}
+
+// addSegmentUsage lets the live accounting know that the project has a new segment.
+func (endpoint *Endpoint) addSegmentUsage(ctx context.Context, projectID uuid.UUID) {
+	if err := endpoint.projectUsage.AddProjectSegmentUsage(ctx, projectID, 1); err != nil {
+		// log it and continue. it's most likely our own fault that we couldn't
+		// track it, and the only thing that will be affected is our per-project
+		// segment limits.
+		endpoint.log.Error("Could not track new project's segment usage",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+	}
+}
+
+// removeObjectUsage lets the live accounting know that the committed objects
+// of the project and their segments were deleted.
+func (endpoint *Endpoint) removeObjectUsage(ctx context.Context, projectID uuid.UUID, objects []metabase.Object) {
+	if len(objects) == 0 {
+		return
+	}
+
+	var segments int64
+	for _, object := range objects {
+		segments += int64(object.SegmentCount)
+	}
+
+	if err := endpoint.projectUsage.AddProjectObjectUsage(ctx, projectID, -int64(len(objects))); err != nil {
+		endpoint.log.Error("Could not track deleted project's object usage",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+	}
+	if segments == 0 {
+		return
+	}
+	if err := endpoint.projectUsage.AddProjectSegmentUsage(ctx, projectID, -segments); err != nil {
+		endpoint.log.Error("Could not track deleted project's segment usage",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+	}
+}
+
+// checkExceedsBucketStorageUsage returns an error when the bucket has reached
+// its storage limit.
+func (endpoint *Endpoint) checkExceedsBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limit, err := endpoint.projectUsage.ExceedsBucketStorageUsage(ctx, bucket)
+	if err != nil {
+		endpoint.log.Error(
+			"Retrieving bucket storage totals failed; bucket storage limit won't be enforced",
+			zap.Error(err),
+		)
+	} else if exceeded {
+		endpoint.log.Error("Bucket storage limit exceeded",
+			zap.Stringer("Limit", limit),
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.String("Bucket", bucket.BucketName),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Bucket Exceeded Usage Limit")
+	}
+
+	return nil
+}
+
+// checkExceedsBucketBandwidthUsage returns an error when the bucket has reached
+// its monthly bandwidth limit.
+func (endpoint *Endpoint) checkExceedsBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limit, err := endpoint.projectUsage.ExceedsBucketBandwidthUsage(ctx, bucket)
+	if err != nil {
+		endpoint.log.Error(
+			"Retrieving bucket bandwidth total failed; bucket bandwidth limit won't be enforced",
+			zap.Error(err),
+		)
+	} else if exceeded {
+		endpoint.log.Error("Monthly bucket bandwidth limit exceeded",
+			zap.Stringer("Limit", limit),
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.String("Bucket", bucket.BucketName),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Bucket Exceeded Usage Limit")
+	}
+
+	return nil
+}
+
+// addStorageUsage lets the live accounting know that the project and the
+// bucket have used more storage.
+func (endpoint *Endpoint) addStorageUsage(ctx context.Context, bucket metabase.BucketLocation, spaceUsed int64) {
+	if err := endpoint.projectUsage.AddProjectStorageUsage(ctx, bucket.ProjectID, spaceUsed); err != nil {
+		// log it and continue. it's most likely our own fault that we couldn't
+		// track it, and the only thing that will be affected is our per-project
+		// storage limits.
+		endpoint.log.Error("Could not track new project's storage usage",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.Error(err),
+		)
+	}
+	if err := endpoint.projectUsage.AddBucketStorageUsage(ctx, bucket, spaceUsed); err != nil {
+		endpoint.log.Error("Could not track new bucket's storage usage",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.String("Bucket", bucket.BucketName),
+			zap.Error(err),
+		)
+	}
+}
+
+// addBandwidthUsage lets the live accounting know that the project and the
+// bucket have used more bandwidth.
+func (endpoint *Endpoint) addBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64) {
+	if err := endpoint.projectUsage.UpdateProjectBandwidthUsage(ctx, bucket.ProjectID, increment); err != nil {
+		// log it and continue. it's most likely our own fault that we couldn't
+		// track it, and the only thing that will be affected is our per-project
+		// bandwidth limits.
+		endpoint.log.Error("Could not track the new project's bandwidth usage",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.Error(err),
+		)
+	}
+	if err := endpoint.projectUsage.UpdateBucketBandwidthUsage(ctx, bucket, increment); err != nil {
+		endpoint.log.Error("Could not track the new bucket's bandwidth usage",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.String("Bucket", bucket.BucketName),
+			zap.Error(err),
+		)
+	}
+}
+
 // CreatePath creates a segment key.
 func CreatePath(ctx context.Context, projectID uuid.UUID, segmentIndex uint32, bucket, path []byte) (_ metabase.SegmentLocation, err error) {
 	// TODO rename to CreateLocation
@@ -27,11 +27,14 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/placement"
 	"storj.io/uplink"
 	"storj.io/uplink/private/metaclient"
 	"storj.io/uplink/private/object"
@@ -1376,6 +1379,122 @@ func TestRateLimit_ProjectRateLimitOverrideCachedExpired(t *testing.T) {
 	})
 }
 
+func TestRateLimit_APIKeyRateLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		rateLimit := 2
+		limitedKey := createLimitedAPIKey(ctx, t, satellite, projectID, console.APIKeyLimits{RateLimit: &rateLimit})
+
+		limited, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, limitedKey)
+		require.NoError(t, err)
+		defer ctx.Check(limited.Close)
+
+		unlimited, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, planet.Uplinks[0].APIKey[satellite.ID()])
+		require.NoError(t, err)
+		defer ctx.Check(unlimited.Close)
+
+		var group errs2.Group
+		for i := 0; i <= rateLimit; i++ {
+			group.Go(func() error {
+				_, err := limited.ListBuckets(ctx, metaclient.ListBucketsParams{})
+				return err
+			})
+		}
+		groupErrs := group.Wait()
+		require.Len(t, groupErrs, 1)
+		require.True(t, errs2.IsRPC(groupErrs[0], rpcstatus.ResourceExhausted))
+
+		// the other keys of the project are not limited
+		for i := 0; i <= rateLimit; i++ {
+			_, err := unlimited.ListBuckets(ctx, metaclient.ListBucketsParams{})
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestRateLimit_BelowOne(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.RateLimiter.Rate = 0.5
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		// testplanet is doing one additional request to get access, wait
+		// until the limiter allows the next request
+		time.Sleep(2 * time.Second)
+
+		// a limit below one request per second still allows a single request
+		_, err := planet.Uplinks[0].ListBuckets(ctx, planet.Satellites[0])
+		require.NoError(t, err)
+	})
+}
+
+func TestRequestQuota_APIKey(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		quota := int64(3)
+		limitedKey := createLimitedAPIKey(ctx, t, satellite, projectID, console.APIKeyLimits{RequestQuota: &quota})
+
+		restrictedKey, err := limitedKey.Restrict(macaroon.Caveat{DisallowLists: true})
+		require.NoError(t, err)
+
+		restricted, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, restrictedKey)
+		require.NoError(t, err)
+		defer ctx.Check(restricted.Close)
+
+		// requests denied by the permissions of the key don't use up the quota
+		for i := int64(0); i <= quota; i++ {
+			_, err := restricted.ListBuckets(ctx, metaclient.ListBucketsParams{})
+			require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+		}
+
+		client, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, limitedKey)
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		for i := int64(0); i < quota; i++ {
+			_, err := client.ListBuckets(ctx, metaclient.ListBucketsParams{})
+			require.NoError(t, err)
+		}
+
+		_, err = client.ListBuckets(ctx, metaclient.ListBucketsParams{})
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+
+		_, err = planet.Uplinks[0].ListBuckets(ctx, satellite)
+		require.NoError(t, err)
+	})
+}
+
+func createLimitedAPIKey(ctx *testcontext.Context, t *testing.T, satellite *testplanet.Satellite, projectID uuid.UUID, limits console.APIKeyLimits) *macaroon.APIKey {
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+
+	key, err := macaroon.NewAPIKey(secret)
+	require.NoError(t, err)
+
+	_, err = satellite.DB.Console().APIKeys().Create(ctx, key.Head(), console.APIKeyInfo{
+		Name:         "limited",
+		ProjectID:    projectID,
+		Secret:       secret,
+		RateLimit:    limits.RateLimit,
+		RequestQuota: limits.RequestQuota,
+	})
+	require.NoError(t, err)
+
+	return key
+}
+
 func TestBucketEmptinessBeforeDelete(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
@@ -1964,3 +2083,256 @@ func TestObjectSegmentExpiresAt(t *testing.T) {
 		}
 	})
 }
+
+func TestVersionedBucket(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "versioned"))
+		require.NoError(t, satellite.Metainfo.Service.EnableBucketVersioning(ctx, []byte("versioned"), projectID))
+
+		versioning, err := satellite.Metainfo.Service.GetBucketVersioning(ctx, []byte("versioned"), projectID)
+		require.NoError(t, err)
+		require.Equal(t, metainfo.VersioningEnabled, versioning)
+
+		initialData := testrand.Bytes(10 * memory.KiB)
+		overrideData := testrand.Bytes(11 * memory.KiB)
+
+		err = planet.Uplinks[0].Upload(ctx, satellite, "versioned", "object", initialData)
+		require.NoError(t, err)
+		err = planet.Uplinks[0].Upload(ctx, satellite, "versioned", "object", overrideData)
+		require.NoError(t, err)
+
+		data, err := planet.Uplinks[0].Download(ctx, satellite, "versioned", "object")
+		require.NoError(t, err)
+		require.Equal(t, overrideData, data)
+
+		objects, err := satellite.Metainfo.Metabase.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		// deleting creates a delete marker and keeps previous versions
+		err = planet.Uplinks[0].DeleteObject(ctx, satellite, "versioned", "object")
+		require.NoError(t, err)
+
+		_, err = planet.Uplinks[0].Download(ctx, satellite, "versioned", "object")
+		require.True(t, errors.Is(err, uplink.ErrObjectNotFound))
+
+		items, err := planet.Uplinks[0].ListObjects(ctx, satellite, "versioned")
+		require.NoError(t, err)
+		require.Len(t, items, 0)
+
+		objects, err = satellite.Metainfo.Metabase.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		// deleting the delete marker restores the previous version
+		location := metabase.ObjectLocation{
+			ProjectID:  projectID,
+			BucketName: "versioned",
+			ObjectKey:  objects[0].ObjectKey,
+		}
+		_, err = satellite.Metainfo.Endpoint.DeleteObjectExactVersion(ctx, location, metabase.Version(3))
+		require.NoError(t, err)
+
+		data, err = planet.Uplinks[0].Download(ctx, satellite, "versioned", "object")
+		require.NoError(t, err)
+		require.Equal(t, overrideData, data)
+
+		// previous version is still accessible
+		_, err = satellite.Metainfo.Endpoint.DeleteObjectExactVersion(ctx, location, metabase.Version(2))
+		require.NoError(t, err)
+
+		data, err = planet.Uplinks[0].Download(ctx, satellite, "versioned", "object")
+		require.NoError(t, err)
+		require.Equal(t, initialData, data)
+	})
+}
+
+func TestLockedObject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		data := testrand.Bytes(10 * memory.KiB)
+		err := planet.Uplinks[0].Upload(ctx, satellite, "bucket", "object", data)
+		require.NoError(t, err)
+
+		objects, err := satellite.Metainfo.Metabase.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		err = satellite.Metainfo.Metabase.SetObjectRetention(ctx, metabase.SetObjectRetention{
+			ObjectLocation: objects[0].Location(),
+			Version:        objects[0].Version,
+			RetainUntil:    time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].DeleteObject(ctx, satellite, "bucket", "object")
+		require.Error(t, err)
+
+		err = planet.Uplinks[0].Upload(ctx, satellite, "bucket", "object", testrand.Bytes(memory.KiB))
+		require.Error(t, err)
+
+		project, err := planet.Uplinks[0].GetProject(ctx, satellite)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		_, err = project.DeleteBucketWithObjects(ctx, "bucket")
+		require.Error(t, err)
+
+		downloaded, err := planet.Uplinks[0].Download(ctx, satellite, "bucket", "object")
+		require.NoError(t, err)
+		require.Equal(t, data, downloaded)
+	})
+}
+
+func TestBucketPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "placed"))
+
+		constraint, err := satellite.Metainfo.Service.GetBucketPlacement(ctx, []byte("placed"), projectID)
+		require.NoError(t, err)
+		require.Equal(t, placement.EveryCountry, constraint)
+
+		err = satellite.Metainfo.Service.SetBucketPlacement(ctx, []byte("placed"), projectID, placement.Placement(100))
+		require.True(t, metainfo.ErrInvalidPlacement.Has(err))
+
+		require.NoError(t, satellite.Metainfo.Service.SetBucketPlacement(ctx, []byte("placed"), projectID, placement.EU))
+
+		constraint, err = satellite.Metainfo.Service.GetBucketPlacement(ctx, []byte("placed"), projectID)
+		require.NoError(t, err)
+		require.Equal(t, placement.EU, constraint)
+
+		settings, err := satellite.Metainfo.Service.GetBucketUploadSettings(ctx, []byte("placed"), projectID)
+		require.NoError(t, err)
+		require.Equal(t, metainfo.Unversioned, settings.Versioning)
+		require.Equal(t, placement.EU, settings.Placement)
+
+		// the location of the test nodes is unknown, so none of them can be used
+		err = planet.Uplinks[0].Upload(ctx, satellite, "placed", "object", testrand.Bytes(10*memory.KiB))
+		require.Error(t, err)
+
+		require.NoError(t, satellite.Metainfo.Service.SetBucketPlacement(ctx, []byte("placed"), projectID, placement.EveryCountry))
+
+		err = planet.Uplinks[0].Upload(ctx, satellite, "placed", "object", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+	})
+}
+
+func TestSegmentPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.GeoIP.MockCountries = []string{"DE"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		for _, node := range planet.StorageNodes {
+			require.NoError(t, node.Contact.Service.PingSatellites(ctx, 0))
+		}
+		require.NoError(t, sat.Overlay.Service.UploadSelectionCache.Refresh(ctx))
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "placed"))
+		require.NoError(t, sat.Metainfo.Service.SetBucketPlacement(ctx, []byte("placed"), projectID, placement.EU))
+
+		err := planet.Uplinks[0].Upload(ctx, sat, "placed", "object", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		// the placement is stored with the segment, so that repair can keep it
+		segments, err := sat.Metainfo.Metabase.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Equal(t, placement.EU, segments[0].Placement)
+	})
+}
+
+func TestBucketRedundancy(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.AllowedRS = metainfo.RSConfigs{
+					{ErasureShareSize: 256 * memory.B, Min: 2, Repair: 3, Success: 4, Total: 5},
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		client, err := planet.Uplinks[0].DialMetainfo(ctx, sat, apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		archive := sat.Config.Metainfo.AllowedRS[0].RedundancyScheme()
+
+		_, err = client.CreateBucket(ctx, metaclient.CreateBucketParams{
+			Name:                    []byte("archive"),
+			DefaultRedundancyScheme: archive,
+		})
+		require.NoError(t, err)
+
+		// schemes which aren't allowed are ignored, like before buckets had their own scheme
+		unknown := archive
+		unknown.TotalShares++
+		_, err = client.CreateBucket(ctx, metaclient.CreateBucketParams{
+			Name:                    []byte("unknown"),
+			DefaultRedundancyScheme: unknown,
+		})
+		require.NoError(t, err)
+
+		rs, err := sat.Metainfo.Service.GetBucketRedundancy(ctx, []byte("unknown"), projectID)
+		require.NoError(t, err)
+		require.True(t, rs.IsZero())
+
+		rs, err = sat.Metainfo.Service.GetBucketRedundancy(ctx, []byte("archive"), projectID)
+		require.NoError(t, err)
+		require.Equal(t, archive, rs)
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "default"))
+
+		rs, err = sat.Metainfo.Service.GetBucketRedundancy(ctx, []byte("default"), projectID)
+		require.NoError(t, err)
+		require.True(t, rs.IsZero())
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "archive", "object", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "default", "object", testrand.Bytes(10*memory.KiB)))
+
+		segments, err := sat.Metainfo.Metabase.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+
+		objects, err := sat.Metainfo.Metabase.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		for _, segment := range segments {
+			for _, object := range objects {
+				if object.StreamID != segment.StreamID {
+					continue
+				}
+				if object.BucketName == "archive" {
+					require.Equal(t, archive, segment.Redundancy)
+				} else {
+					require.Equal(t, sat.Config.Metainfo.RS.RedundancyScheme(), segment.Redundancy)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,465 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo/bucketnotifications"
+)
+
+// BeginMoveObject begins moving an object to a different key or bucket.
+//
+// The response contains the encrypted keys of the object, which the client
+// re-encrypts for the new location and sends with FinishMoveObject.
+func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *internalpb.ObjectBeginMoveRequest) (resp *internalpb.ObjectBeginMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+	if err != nil {
+		endpoint.log.Warn("unable to collect uplink version", zap.Error(err))
+	}
+
+	now := time.Now()
+
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionRead,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedObjectKey,
+				Time:          now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionDelete,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedObjectKey,
+				Time:          now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionWrite,
+				Bucket:        req.NewBucket,
+				EncryptedPath: req.NewEncryptedObjectKey,
+				Time:          now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := endpoint.beginMoveOrCopy(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedObjectKey, req.NewBucket, endpoint.metainfo.metabaseDB.BeginMoveObject)
+	if err != nil {
+		return nil, err
+	}
+
+	return &internalpb.ObjectBeginMoveResponse{
+		StreamId:                  result.streamID,
+		EncryptedMetadataKeyNonce: result.EncryptedMetadataKeyNonce,
+		EncryptedMetadataKey:      result.EncryptedMetadataKey,
+		SegmentKeys:               result.segmentKeys,
+		EncryptionParameters:      result.encryptionParameters,
+	}, nil
+}
+
+// FinishMoveObject finishes moving an object, using the keys re-encrypted for the new location.
+func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *internalpb.ObjectFinishMoveRequest) (resp *internalpb.ObjectFinishMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+	if err != nil {
+		endpoint.log.Warn("unable to collect uplink version", zap.Error(err))
+	}
+
+	keyInfo, stream, err := endpoint.validateFinishMoveOrCopy(ctx, req.Header, req.StreamId, macaroon.ActionDelete, req.NewBucket, req.NewEncryptedObjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	versioning, err := endpoint.metainfo.GetBucketVersioning(ctx, req.NewBucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	newMetadataKeyNonce, newSegmentKeys, err := convertFinishMoveOrCopyKeys(req.NewEncryptedMetadataKeyNonce, req.NewSegmentKeys)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.metainfo.metabaseDB.FinishMoveObject(ctx, metabase.FinishMoveObject{
+		ObjectStream:                 stream,
+		NewBucket:                    string(req.NewBucket),
+		NewSegmentKeys:               newSegmentKeys,
+		NewEncryptedObjectKey:        req.NewEncryptedObjectKey,
+		NewEncryptedMetadataKeyNonce: newMetadataKeyNonce,
+		NewEncryptedMetadataKey:      req.NewEncryptedMetadataKey,
+		NewBucketVersioned:           versioning == VersioningEnabled,
+	})
+	if err != nil {
+		return nil, endpoint.convertMoveOrCopyError(err)
+	}
+
+	endpoint.log.Info("Object Move", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "move"), zap.String("type", "object"))
+	mon.Meter("req_move_object").Mark(1)
+
+	return &internalpb.ObjectFinishMoveResponse{}, nil
+}
+
+// BeginCopyObject begins copying an object to a different key or bucket.
+//
+// The response contains the encrypted keys of the object, which the client
+// re-encrypts for the new location and sends with FinishCopyObject.
+func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *internalpb.ObjectBeginCopyRequest) (resp *internalpb.ObjectBeginCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+	if err != nil {
+		endpoint.log.Warn("unable to collect uplink version", zap.Error(err))
+	}
+
+	now := time.Now()
+
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionRead,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedObjectKey,
+				Time:          now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionWrite,
+				Bucket:        req.NewBucket,
+				EncryptedPath: req.NewEncryptedObjectKey,
+				Time:          now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the copy adds an object and its segments to the project
+	if err := endpoint.checkExceedsObjectUsage(ctx, keyInfo.ProjectID); err != nil {
+		return nil, err
+	}
+	if err := endpoint.checkExceedsSegmentUsage(ctx, keyInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
+	beginCopy := func(ctx context.Context, opts metabase.BeginMoveObject) (metabase.BeginMoveObjectResult, error) {
+		result, err := endpoint.metainfo.metabaseDB.BeginCopyObject(ctx, metabase.BeginCopyObject(opts))
+		return metabase.BeginMoveObjectResult(result), err
+	}
+
+	result, err := endpoint.beginMoveOrCopy(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedObjectKey, req.NewBucket, beginCopy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &internalpb.ObjectBeginCopyResponse{
+		StreamId:                  result.streamID,
+		EncryptedMetadataKeyNonce: result.EncryptedMetadataKeyNonce,
+		EncryptedMetadataKey:      result.EncryptedMetadataKey,
+		SegmentKeys:               result.segmentKeys,
+		EncryptionParameters:      result.encryptionParameters,
+	}, nil
+}
+
+// FinishCopyObject finishes copying an object, using the keys re-encrypted for the new location.
+func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *internalpb.ObjectFinishCopyRequest) (resp *internalpb.ObjectFinishCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+	if err != nil {
+		endpoint.log.Warn("unable to collect uplink version", zap.Error(err))
+	}
+
+	keyInfo, stream, err := endpoint.validateFinishMoveOrCopy(ctx, req.Header, req.StreamId, macaroon.ActionRead, req.NewBucket, req.NewEncryptedObjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	versioning, err := endpoint.metainfo.GetBucketVersioning(ctx, req.NewBucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	newMetadataKeyNonce, newSegmentKeys, err := convertFinishMoveOrCopyKeys(req.NewEncryptedMetadataKeyNonce, req.NewSegmentKeys)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	newStreamID, err := uuid.New()
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	notify, err := endpoint.notifyObjects(ctx, metabase.BucketLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.NewBucket),
+	}, bucketnotifications.EventObjectCreated, metabase.Committed)
+	if err != nil {
+		endpoint.log.Error("unable to get bucket notifications", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to get bucket notifications")
+	}
+
+	object, err := endpoint.metainfo.metabaseDB.FinishCopyObject(ctx, metabase.FinishCopyObject{
+		ObjectStream:                 stream,
+		NewStreamID:                  newStreamID,
+		NewBucket:                    string(req.NewBucket),
+		NewEncryptedObjectKey:        req.NewEncryptedObjectKey,
+		NewSegmentKeys:               newSegmentKeys,
+		NewEncryptedMetadataKeyNonce: newMetadataKeyNonce,
+		NewEncryptedMetadataKey:      req.NewEncryptedMetadataKey,
+		NewBucketVersioned:           versioning == VersioningEnabled,
+		Notify:                       notify,
+	})
+	if err != nil {
+		return nil, endpoint.convertMoveOrCopyError(err)
+	}
+
+	endpoint.addCopyUsage(ctx, keyInfo.ProjectID, object)
+
+	protoObject, err := endpoint.objectToProto(ctx, object, endpoint.defaultRS)
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	endpoint.log.Info("Object Copy", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "copy"), zap.String("type", "object"))
+	mon.Meter("req_copy_object").Mark(1)
+
+	return &internalpb.ObjectFinishCopyResponse{
+		Object: protoObject,
+	}, nil
+}
+
+// addCopyUsage lets the live accounting know that the project has a new object
+// with the segments of the copied object.
+func (endpoint *Endpoint) addCopyUsage(ctx context.Context, projectID uuid.UUID, object metabase.Object) {
+	if err := endpoint.projectUsage.AddProjectObjectUsage(ctx, projectID, 1); err != nil {
+		endpoint.log.Error("Could not track new project's object usage",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+	}
+	if err := endpoint.projectUsage.AddProjectSegmentUsage(ctx, projectID, int64(object.SegmentCount)); err != nil {
+		endpoint.log.Error("Could not track new project's segment usage",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+	}
+}
+
+// beginMoveOrCopyResult contains the encrypted keys of an object, converted for the response.
+type beginMoveOrCopyResult struct {
+	metabase.BeginMoveObjectResult
+
+	streamID             storj.StreamID
+	segmentKeys          []*internalpb.EncryptedKeyAndNonce
+	encryptionParameters *pb.EncryptionParameters
+}
+
+// beginMoveOrCopy collects the encrypted keys of the latest committed version of an object.
+func (endpoint *Endpoint) beginMoveOrCopy(ctx context.Context, projectID uuid.UUID, bucket, encryptedObjectKey, newBucket []byte,
+	begin func(context.Context, metabase.BeginMoveObject) (metabase.BeginMoveObjectResult, error)) (_ beginMoveOrCopyResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, bucket := range [][]byte{bucket, newBucket} {
+		if err := endpoint.validateBucket(ctx, bucket); err != nil {
+			return beginMoveOrCopyResult{}, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+	}
+
+	source, err := endpoint.metainfo.GetBucketUploadSettings(ctx, bucket, projectID)
+	if err != nil {
+		return beginMoveOrCopyResult{}, endpoint.convertBucketError(err)
+	}
+	target, err := endpoint.metainfo.GetBucketUploadSettings(ctx, newBucket, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return beginMoveOrCopyResult{}, rpcstatus.Error(rpcstatus.NotFound, "target bucket not found: "+string(newBucket))
+		}
+		return beginMoveOrCopyResult{}, endpoint.convertBucketError(err)
+	}
+
+	// the segments keep their pieces, so they can only go to a bucket that
+	// stores them on the same nodes with the same redundancy.
+	if source.Placement != target.Placement {
+		return beginMoveOrCopyResult{}, rpcstatus.Error(rpcstatus.FailedPrecondition, "source and target buckets have different placements")
+	}
+	if endpoint.redundancy.ForBucket(source.Redundancy) != endpoint.redundancy.ForBucket(target.Redundancy) {
+		return beginMoveOrCopyResult{}, rpcstatus.Error(rpcstatus.FailedPrecondition, "source and target buckets have different redundancy schemes")
+	}
+
+	location := metabase.ObjectLocation{
+		ProjectID:  projectID,
+		BucketName: string(bucket),
+		ObjectKey:  metabase.ObjectKey(encryptedObjectKey),
+	}
+
+	object, err := endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+		ObjectLocation: location,
+	})
+	if err != nil {
+		return beginMoveOrCopyResult{}, endpoint.convertMoveOrCopyError(err)
+	}
+
+	result, err := begin(ctx, metabase.BeginMoveObject{
+		Version:        object.Version,
+		ObjectLocation: location,
+	})
+	if err != nil {
+		return beginMoveOrCopyResult{}, endpoint.convertMoveOrCopyError(err)
+	}
+
+	streamID, err := endpoint.packStreamID(ctx, &internalpb.StreamID{
+		Bucket:        bucket,
+		EncryptedPath: encryptedObjectKey,
+		Version:       int32(result.Version),
+		CreationDate:  time.Now(),
+		StreamId:      result.StreamID[:],
+	})
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return beginMoveOrCopyResult{}, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	segmentKeys := make([]*internalpb.EncryptedKeyAndNonce, len(result.EncryptedKeysNonces))
+	for i, key := range result.EncryptedKeysNonces {
+		segmentKeys[i] = &internalpb.EncryptedKeyAndNonce{
+			Position: &pb.SegmentPosition{
+				PartNumber: int32(key.Position.Part),
+				Index:      int32(key.Position.Index),
+			},
+			EncryptedKeyNonce: key.EncryptedKeyNonce,
+			EncryptedKey:      key.EncryptedKey,
+		}
+	}
+
+	return beginMoveOrCopyResult{
+		BeginMoveObjectResult: result,
+
+		streamID:    streamID,
+		segmentKeys: segmentKeys,
+		encryptionParameters: &pb.EncryptionParameters{
+			CipherSuite: pb.CipherSuite(result.EncryptionParameters.CipherSuite),
+			BlockSize:   int64(result.EncryptionParameters.BlockSize),
+		},
+	}, nil
+}
+
+// validateFinishMoveOrCopy validates the permissions of a finish move or copy request
+// and returns the source object stream from the stream ID.
+func (endpoint *Endpoint) validateFinishMoveOrCopy(ctx context.Context, header *pb.RequestHeader, streamID []byte, sourceOp macaroon.ActionType,
+	newBucket, newEncryptedObjectKey []byte) (_ *console.APIKeyInfo, _ metabase.ObjectStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satStreamID, err := endpoint.unmarshalSatStreamID(ctx, streamID)
+	if err != nil {
+		return nil, metabase.ObjectStream{}, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	now := time.Now()
+
+	keyInfo, err := endpoint.validateAuthN(ctx, header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            sourceOp,
+				Bucket:        satStreamID.Bucket,
+				EncryptedPath: satStreamID.EncryptedPath,
+				Time:          now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionWrite,
+				Bucket:        newBucket,
+				EncryptedPath: newEncryptedObjectKey,
+				Time:          now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, metabase.ObjectStream{}, err
+	}
+
+	if err := endpoint.validateBucket(ctx, newBucket); err != nil {
+		return nil, metabase.ObjectStream{}, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	id, err := uuid.FromBytes(satStreamID.StreamId)
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, metabase.ObjectStream{}, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return keyInfo, metabase.ObjectStream{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(satStreamID.Bucket),
+		ObjectKey:  metabase.ObjectKey(satStreamID.EncryptedPath),
+		Version:    metabase.Version(satStreamID.Version),
+		StreamID:   id,
+	}, nil
+}
+
+// convertFinishMoveOrCopyKeys converts the re-encrypted keys of a finish move or copy request.
+func convertFinishMoveOrCopyKeys(metadataKeyNonce []byte, segmentKeys []*internalpb.EncryptedKeyAndNonce) (_ storj.Nonce, _ []metabase.EncryptedKeyAndNonce, err error) {
+	var nonce storj.Nonce
+	if len(metadataKeyNonce) > 0 {
+		nonce, err = storj.NonceFromBytes(metadataKeyNonce)
+		if err != nil {
+			return storj.Nonce{}, nil, err
+		}
+	}
+
+	keys := make([]metabase.EncryptedKeyAndNonce, len(segmentKeys))
+	for i, key := range segmentKeys {
+		keys[i] = metabase.EncryptedKeyAndNonce{
+			Position: metabase.SegmentPosition{
+				Part:  uint32(key.Position.GetPartNumber()),
+				Index: uint32(key.Position.GetIndex()),
+			},
+			EncryptedKeyNonce: key.EncryptedKeyNonce,
+			EncryptedKey:      key.EncryptedKey,
+		}
+	}
+	return nonce, keys, nil
+}
+
+// convertMoveOrCopyError converts a metabase error of a move or copy to an rpc error.
+func (endpoint *Endpoint) convertMoveOrCopyError(err error) error {
+	switch {
+	case storj.ErrObjectNotFound.Has(err):
+		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	case metabase.ErrObjectAlreadyExists.Has(err):
+		return rpcstatus.Error(rpcstatus.AlreadyExists, "target object already exists")
+	case metabase.ErrObjectLocked.Has(err):
+		return rpcstatus.Error(rpcstatus.PermissionDenied, "object is locked and cannot be moved")
+	case metabase.ErrInvalidRequest.Has(err):
+		return rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	default:
+		endpoint.log.Error("internal", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo/bucketnotifications"
+)
+
+// ErrInvalidNotifications is used when a bucket notification configuration is not valid.
+var ErrInvalidNotifications = errs.Class("invalid bucket notifications")
+
+// MaxWebhooks is the maximum number of webhooks in a bucket notification configuration.
+const MaxWebhooks = 10
+
+// WebhookConfig describes a webhook receiving object events of a bucket.
+type WebhookConfig struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	// Events lists the events sent to the webhook, e.g. object-created and object-deleted.
+	Events []string `json:"events"`
+	// Prefix limits the webhook to objects whose (encrypted) object key starts with it.
+	Prefix []byte `json:"prefix,omitempty"`
+}
+
+// Matches returns whether the webhook is interested in the event of the object.
+func (webhook *WebhookConfig) Matches(event string, key metabase.ObjectKey) bool {
+	if !bytes.HasPrefix([]byte(key), webhook.Prefix) {
+		return false
+	}
+	for _, e := range webhook.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// BucketNotifications is the notification configuration of a bucket.
+type BucketNotifications struct {
+	Webhooks []WebhookConfig `json:"webhooks"`
+}
+
+// Verify verifies the bucket notification configuration.
+func (notifications *BucketNotifications) Verify() error {
+	if len(notifications.Webhooks) > MaxWebhooks {
+		return ErrInvalidNotifications.New("too many webhooks: %d, maximum is %d", len(notifications.Webhooks), MaxWebhooks)
+	}
+
+	ids := map[string]struct{}{}
+	for _, webhook := range notifications.Webhooks {
+		if webhook.ID == "" {
+			return ErrInvalidNotifications.New("webhook id missing")
+		}
+		if _, exists := ids[webhook.ID]; exists {
+			return ErrInvalidNotifications.New("duplicate webhook id %q", webhook.ID)
+		}
+		ids[webhook.ID] = struct{}{}
+
+		u, err := url.Parse(webhook.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrInvalidNotifications.New("webhook %q: invalid url %q", webhook.ID, webhook.URL)
+		}
+
+		if len(webhook.Events) == 0 {
+			return ErrInvalidNotifications.New("webhook %q: no events specified", webhook.ID)
+		}
+		for _, event := range webhook.Events {
+			if event != bucketnotifications.EventObjectCreated && event != bucketnotifications.EventObjectDeleted {
+				return ErrInvalidNotifications.New("webhook %q: unknown event %q", webhook.ID, event)
+			}
+		}
+	}
+	return nil
+}
+
+// IsZero returns whether the bucket has no webhooks.
+func (notifications *BucketNotifications) IsZero() bool {
+	return len(notifications.Webhooks) == 0
+}
+
+// GetBucketNotifications returns the notification configuration of a bucket.
+func (s *Service) GetBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ BucketNotifications, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.GetBucketNotifications(ctx, bucketName, projectID)
+}
+
+// SetBucketNotifications replaces the notification configuration of a bucket.
+func (s *Service) SetBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID, notifications BucketNotifications) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := notifications.Verify(); err != nil {
+		return err
+	}
+	return s.bucketsDB.UpdateBucketNotifications(ctx, bucketName, projectID, notifications)
+}
+
+// DeleteBucketNotifications removes all webhooks of a bucket.
+func (s *Service) DeleteBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.UpdateBucketNotifications(ctx, bucketName, projectID, BucketNotifications{})
+}
+
+// bucketNotifications returns the cached notification configuration of a bucket.
+// A bucket which doesn't exist has no webhooks.
+func (endpoint *Endpoint) bucketNotifications(ctx context.Context, bucket metabase.BucketLocation) (BucketNotifications, error) {
+	value, err := endpoint.notificationsCache.Get(string(bucket.Prefix()), func() (interface{}, error) {
+		notifications, err := endpoint.metainfo.GetBucketNotifications(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+		if err != nil && !storj.ErrBucketNotFound.Has(err) {
+			return nil, err
+		}
+		return notifications, nil
+	})
+	if err != nil {
+		return BucketNotifications{}, err
+	}
+	notifications, _ := value.(BucketNotifications)
+	return notifications, nil
+}
+
+// notifyObjects returns the function creating the events of the objects of the
+// bucket with the specified status, or nil when the bucket has no webhooks.
+//
+// It's passed to the metabase, which stores the events in the transaction changing
+// the objects. The operation fails when the configuration can't be loaded, so no
+// event is lost.
+func (endpoint *Endpoint) notifyObjects(ctx context.Context, bucket metabase.BucketLocation, event string, status metabase.ObjectStatus) (_ metabase.NotifyObjects, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !endpoint.config.BucketNotifications.Enabled {
+		return nil, nil
+	}
+
+	notifications, err := endpoint.bucketNotifications(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return NewNotifyObjects(notifications, event, status), nil
+}
+
+// NotifyObjects returns the function creating the events of the objects with the
+// specified status, for the buckets of the objects. The notification configurations
+// are loaded once per bucket.
+//
+// It's used by the chores, which delete objects of many buckets.
+func (s *Service) NotifyObjects(ctx context.Context, event string, status metabase.ObjectStatus) metabase.NotifyObjects {
+	configurations := map[metabase.BucketLocation]metabase.NotifyObjects{}
+	return func(objects []metabase.Object) (events []metabase.ObjectEvent, err error) {
+		for _, object := range objects {
+			bucket := object.Location().Bucket()
+			notify, ok := configurations[bucket]
+			if !ok {
+				notifications, err := s.GetBucketNotifications(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+				if err != nil && !storj.ErrBucketNotFound.Has(err) {
+					return nil, err
+				}
+				notify = NewNotifyObjects(notifications, event, status)
+				configurations[bucket] = notify
+			}
+			if notify == nil {
+				continue
+			}
+
+			objectEvents, err := notify([]metabase.Object{object})
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, objectEvents...)
+		}
+		return events, nil
+	}
+}
+
+// NewNotifyObjects returns the function creating the events of the objects with
+// the specified status, for every webhook interested in them. It returns nil when
+// there are no webhooks.
+func NewNotifyObjects(notifications BucketNotifications, event string, status metabase.ObjectStatus) metabase.NotifyObjects {
+	if notifications.IsZero() {
+		return nil
+	}
+
+	return func(objects []metabase.Object) (events []metabase.ObjectEvent, err error) {
+		now := time.Now()
+		for _, object := range objects {
+			if object.Status != status {
+				continue
+			}
+
+			var payload []byte
+			for _, webhook := range notifications.Webhooks {
+				if !webhook.Matches(event, object.ObjectKey) {
+					continue
+				}
+
+				if payload == nil {
+					payload, err = json.Marshal(bucketnotifications.Payload{
+						Event:   event,
+						Bucket:  object.BucketName,
+						Key:     []byte(object.ObjectKey),
+						Size:    object.TotalEncryptedSize,
+						Version: int64(object.Version),
+						Time:    now,
+					})
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				id, err := uuid.New()
+				if err != nil {
+					return nil, err
+				}
+				events = append(events, metabase.ObjectEvent{
+					ID:            id,
+					ProjectID:     object.ProjectID,
+					BucketName:    object.BucketName,
+					WebhookID:     webhook.ID,
+					URL:           webhook.URL,
+					Payload:       payload,
+					NextAttemptAt: now,
+				})
+			}
+		}
+		return events, nil
+	}
+}
+
+// committedObjects returns the committed objects, skipping pending objects and delete markers.
+func committedObjects(objects []metabase.Object) []metabase.Object {
+	var committed []metabase.Object
+	for _, object := range objects {
+		if object.Status == metabase.Committed {
+			committed = append(committed, object)
+		}
+	}
+	return committed
+}
+
+// GetBucketNotifications returns the webhooks of a bucket.
+func (endpoint *Endpoint) GetBucketNotifications(ctx context.Context, req *internalpb.BucketGetNotificationsRequest) (resp *internalpb.BucketGetNotificationsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, err := endpoint.metainfo.GetBucketNotifications(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	resp = &internalpb.BucketGetNotificationsResponse{}
+	for _, webhook := range notifications.Webhooks {
+		resp.Webhooks = append(resp.Webhooks, &internalpb.Webhook{
+			Id:              webhook.ID,
+			Url:             webhook.URL,
+			Events:          webhook.Events,
+			EncryptedPrefix: webhook.Prefix,
+		})
+	}
+	return resp, nil
+}
+
+// SetBucketNotifications replaces the webhooks of a bucket. The webhooks can't
+// target private addresses, unless it's allowed by the configuration.
+func (endpoint *Endpoint) SetBucketNotifications(ctx context.Context, req *internalpb.BucketSetNotificationsRequest) (resp *internalpb.BucketSetNotificationsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := BucketNotifications{}
+	for _, webhook := range req.Webhooks {
+		if err := bucketnotifications.CheckURL(webhook.Url, endpoint.config.BucketNotifications.AllowPrivateAddresses); err != nil {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		notifications.Webhooks = append(notifications.Webhooks, WebhookConfig{
+			ID:     webhook.Id,
+			URL:    webhook.Url,
+			Events: webhook.Events,
+			Prefix: webhook.EncryptedPrefix,
+		})
+	}
+
+	err = endpoint.metainfo.SetBucketNotifications(ctx, req.Name, keyInfo.ProjectID, notifications)
+	if err != nil {
+		if ErrInvalidNotifications.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.Name)}
+	endpoint.notificationsCache.Delete(string(bucket.Prefix()))
+
+	endpoint.log.Info("Bucket Set Notifications", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "set_notifications"), zap.String("type", "bucket"))
+	mon.Meter("req_set_bucket_notifications").Mark(1)
+
+	return &internalpb.BucketSetNotificationsResponse{}, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketnotifications"
+)
+
+func TestBucketNotificationsVerify(t *testing.T) {
+	created := []string{bucketnotifications.EventObjectCreated}
+
+	tests := []struct {
+		description   string
+		notifications metainfo.BucketNotifications
+		expectError   bool
+	}{
+		{
+			description:   "no webhooks",
+			notifications: metainfo.BucketNotifications{},
+		},
+		{
+			description: "valid webhooks",
+			notifications: metainfo.BucketNotifications{Webhooks: []metainfo.WebhookConfig{
+				{ID: "a", URL: "https://example.test/hook", Events: created, Prefix: []byte("inbox/")},
+				{ID: "b", URL: "http://example.test:8080", Events: []string{
+					bucketnotifications.EventObjectCreated, bucketnotifications.EventObjectDeleted,
+				}},
+			}},
+		},
+		{
+			description: "missing id",
+			notifications: metainfo.BucketNotifications{Webhooks: []metainfo.WebhookConfig{
+				{URL: "https://example.test/hook", Events: created},
+			}},
+			expectError: true,
+		},
+		{
+			description: "duplicate id",
+			notifications: metainfo.BucketNotifications{Webhooks: []metainfo.WebhookConfig{
+				{ID: "a", URL: "https://example.test/a", Events: created},
+				{ID: "a", URL: "https://example.test/b", Events: created},
+			}},
+			expectError: true,
+		},
+		{
+			description: "invalid url scheme",
+			notifications: metainfo.BucketNotifications{Webhooks: []metainfo.WebhookConfig{
+				{ID: "a", URL: "ftp://example.test", Events: created},
+			}},
+			expectError: true,
+		},
+		{
+			description: "missing url host",
+			notifications: metainfo.BucketNotifications{Webhooks: []metainfo.WebhookConfig{
+				{ID: "a", URL: "https://", Events: created},
+			}},
+			expectError: true,
+		},
+		{
+			description: "no events",
+			notifications: metainfo.BucketNotifications{Webhooks: []metainfo.WebhookConfig{
+				{ID: "a", URL: "https://example.test/hook"},
+			}},
+			expectError: true,
+		},
+		{
+			description: "unknown event",
+			notifications: metainfo.BucketNotifications{Webhooks: []metainfo.WebhookConfig{
+				{ID: "a", URL: "https://example.test/hook", Events: []string{"object-moved"}},
+			}},
+			expectError: true,
+		},
+		{
+			description: "too many webhooks",
+			notifications: func() metainfo.BucketNotifications {
+				var notifications metainfo.BucketNotifications
+				for i := 0; i <= metainfo.MaxWebhooks; i++ {
+					notifications.Webhooks = append(notifications.Webhooks, metainfo.WebhookConfig{
+						ID: strconv.Itoa(i), URL: "https://example.test/hook", Events: created,
+					})
+				}
+				return notifications
+			}(),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		err := tt.notifications.Verify()
+		if tt.expectError {
+			require.True(t, metainfo.ErrInvalidNotifications.Has(err), tt.description)
+		} else {
+			require.NoError(t, err, tt.description)
+		}
+	}
+}
+
+func TestWebhookMatches(t *testing.T) {
+	webhook := metainfo.WebhookConfig{
+		ID:     "inbox",
+		URL:    "https://example.test/hook",
+		Events: []string{bucketnotifications.EventObjectCreated},
+		Prefix: []byte("inbox/"),
+	}
+
+	require.True(t, webhook.Matches(bucketnotifications.EventObjectCreated, "inbox/a"))
+	require.False(t, webhook.Matches(bucketnotifications.EventObjectDeleted, "inbox/a"))
+	require.False(t, webhook.Matches(bucketnotifications.EventObjectCreated, "outbox/a"))
+
+	webhook.Prefix = nil
+	require.True(t, webhook.Matches(bucketnotifications.EventObjectCreated, "outbox/a"))
+}
@@ -0,0 +1,32 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/placement"
+)
+
+// ErrInvalidPlacement is used when a bucket placement is not valid.
+var ErrInvalidPlacement = errs.Class("invalid bucket placement")
+
+// GetBucketPlacement returns the placement constraint of a bucket.
+func (s *Service) GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ placement.Placement, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.GetBucketPlacement(ctx, bucketName, projectID)
+}
+
+// SetBucketPlacement updates the placement constraint of a bucket.
+// The placement is used only when uploading new segments, existing segments are not moved.
+func (s *Service) SetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID, placement placement.Placement) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !placement.Valid() {
+		return ErrInvalidPlacement.New("unknown placement %d", int(placement))
+	}
+	return s.bucketsDB.UpdateBucketPlacement(ctx, bucketName, projectID, placement)
+}
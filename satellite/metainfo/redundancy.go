@@ -0,0 +1,124 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// ErrInvalidRedundancy is used when a bucket redundancy scheme is not allowed.
+var ErrInvalidRedundancy = errs.Class("invalid bucket redundancy scheme")
+
+// RedundancyProfiles contains the redundancy schemes buckets can use.
+//
+// A bucket without a redundancy scheme uses the default one.
+type RedundancyProfiles struct {
+	Default storj.RedundancyScheme
+	Allowed []storj.RedundancyScheme
+}
+
+// NewRedundancyProfiles creates redundancy profiles from the metainfo configuration.
+func NewRedundancyProfiles(config Config) RedundancyProfiles {
+	profiles := RedundancyProfiles{
+		Default: config.RS.RedundancyScheme(),
+	}
+	for i := range config.AllowedRS {
+		profiles.Allowed = append(profiles.Allowed, config.AllowedRS[i].RedundancyScheme())
+	}
+	return profiles
+}
+
+// Validate checks whether buckets are allowed to use the redundancy scheme.
+func (profiles RedundancyProfiles) Validate(rs storj.RedundancyScheme) error {
+	if rs.IsZero() || profiles.allowed(rs) {
+		return nil
+	}
+	return ErrInvalidRedundancy.New("%d/%d/%d/%d-%d is not allowed",
+		rs.RequiredShares, rs.RepairShares, rs.OptimalShares, rs.TotalShares, rs.ShareSize)
+}
+
+// ForBucket returns the redundancy scheme used for uploading to a bucket with
+// the specified redundancy scheme. Buckets without a redundancy scheme, or with
+// one that isn't allowed anymore, use the default.
+func (profiles RedundancyProfiles) ForBucket(rs storj.RedundancyScheme) storj.RedundancyScheme {
+	if rs.IsZero() || !profiles.allowed(rs) {
+		return profiles.Default
+	}
+	return rs
+}
+
+// StorageFactor returns the ratio between the data stored on the storage nodes for a segment
+// with the specified redundancy scheme and for a segment using the default redundancy scheme.
+// Inline segments have no redundancy scheme, they are counted like the default.
+func (profiles RedundancyProfiles) StorageFactor(rs storj.RedundancyScheme) float64 {
+	if rs.IsZero() || rs == profiles.Default {
+		return 1
+	}
+	return expansionFactor(rs) / expansionFactor(profiles.Default)
+}
+
+func (profiles RedundancyProfiles) allowed(rs storj.RedundancyScheme) bool {
+	if rs == profiles.Default {
+		return true
+	}
+	for _, allowed := range profiles.Allowed {
+		if rs == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// expansionFactor returns how much the data is expanded when it's stored on the
+// optimal number of storage nodes.
+func expansionFactor(rs storj.RedundancyScheme) float64 {
+	return float64(rs.OptimalShares) / float64(rs.RequiredShares)
+}
+
+// redundancyToProto converts the redundancy scheme to protobuf.
+func redundancyToProto(rs storj.RedundancyScheme) *pb.RedundancyScheme {
+	return &pb.RedundancyScheme{
+		Type:             pb.RedundancyScheme_SchemeType(rs.Algorithm),
+		ErasureShareSize: rs.ShareSize,
+		MinReq:           int32(rs.RequiredShares),
+		RepairThreshold:  int32(rs.RepairShares),
+		SuccessThreshold: int32(rs.OptimalShares),
+		Total:            int32(rs.TotalShares),
+	}
+}
+
+// redundancyFromProto converts the protobuf redundancy scheme.
+func redundancyFromProto(rs *pb.RedundancyScheme) storj.RedundancyScheme {
+	return storj.RedundancyScheme{
+		Algorithm:      storj.RedundancyAlgorithm(rs.GetType()),
+		ShareSize:      rs.GetErasureShareSize(),
+		RequiredShares: int16(rs.GetMinReq()),
+		RepairShares:   int16(rs.GetRepairThreshold()),
+		OptimalShares:  int16(rs.GetSuccessThreshold()),
+		TotalShares:    int16(rs.GetTotal()),
+	}
+}
+
+// GetBucketRedundancy returns the redundancy scheme stored for a bucket. It's zero
+// when the bucket uses the default redundancy scheme.
+func (s *Service) GetBucketRedundancy(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ storj.RedundancyScheme, err error) {
+	defer mon.Task()(&ctx)(&err)
+	bucket, err := s.bucketsDB.GetBucket(ctx, bucketName, projectID)
+	if err != nil {
+		return storj.RedundancyScheme{}, err
+	}
+	return bucket.DefaultRedundancyScheme, nil
+}
+
+// redundancyForBucket returns the redundancy scheme used for uploading to a bucket
+// with the specified redundancy scheme.
+func (endpoint *Endpoint) redundancyForBucket(rs storj.RedundancyScheme) *pb.RedundancyScheme {
+	return redundancyToProto(endpoint.redundancy.ForBucket(rs))
+}
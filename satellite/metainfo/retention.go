@@ -0,0 +1,168 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metabase"
+)
+
+// GetObjectRetention returns the retention period and the legal hold of an object.
+func (endpoint *Endpoint) GetObjectRetention(ctx context.Context, req *internalpb.ObjectGetRetentionRequest) (resp *internalpb.ObjectGetRetentionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionRead,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedObjectKey,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	object, err := endpoint.getRetentionObject(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedObjectKey, req.Version)
+	if err != nil {
+		return nil, endpoint.convertRetentionError(err)
+	}
+
+	return &internalpb.ObjectGetRetentionResponse{
+		Version:     int64(object.Version),
+		RetainUntil: object.RetainUntil,
+		LegalHold:   object.LegalHold,
+	}, nil
+}
+
+// SetObjectRetention protects an object from deletion until the requested time.
+// The retention period can only be extended.
+func (endpoint *Endpoint) SetObjectRetention(ctx context.Context, req *internalpb.ObjectSetRetentionRequest) (resp *internalpb.ObjectSetRetentionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedObjectKey,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	if !req.RetainUntil.After(time.Now()) {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "retain until must be in the future")
+	}
+
+	object, err := endpoint.getRetentionObject(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedObjectKey, req.Version)
+	if err != nil {
+		return nil, endpoint.convertRetentionError(err)
+	}
+
+	err = endpoint.metainfo.metabaseDB.SetObjectRetention(ctx, metabase.SetObjectRetention{
+		ObjectLocation: object.Location(),
+		Version:        object.Version,
+		RetainUntil:    req.RetainUntil,
+	})
+	if err != nil {
+		return nil, endpoint.convertRetentionError(err)
+	}
+
+	endpoint.log.Info("Object Set Retention", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "set_retention"), zap.String("type", "object"))
+	mon.Meter("req_set_object_retention").Mark(1)
+
+	return &internalpb.ObjectSetRetentionResponse{}, nil
+}
+
+// SetObjectLegalHold places or removes a legal hold on an object.
+func (endpoint *Endpoint) SetObjectLegalHold(ctx context.Context, req *internalpb.ObjectSetLegalHoldRequest) (resp *internalpb.ObjectSetLegalHoldResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedObjectKey,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	object, err := endpoint.getRetentionObject(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedObjectKey, req.Version)
+	if err != nil {
+		return nil, endpoint.convertRetentionError(err)
+	}
+
+	err = endpoint.metainfo.metabaseDB.SetObjectLegalHold(ctx, metabase.SetObjectLegalHold{
+		ObjectLocation: object.Location(),
+		Version:        object.Version,
+		LegalHold:      req.LegalHold,
+	})
+	if err != nil {
+		return nil, endpoint.convertRetentionError(err)
+	}
+
+	endpoint.log.Info("Object Set Legal Hold", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "set_legal_hold"), zap.String("type", "object"))
+	mon.Meter("req_set_object_legal_hold").Mark(1)
+
+	return &internalpb.ObjectSetLegalHoldResponse{}, nil
+}
+
+// getRetentionObject returns the committed object version, or the latest committed
+// version when version is zero.
+func (endpoint *Endpoint) getRetentionObject(ctx context.Context, projectID uuid.UUID, bucket, encryptedObjectKey []byte, version int64) (_ metabase.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	location := metabase.ObjectLocation{
+		ProjectID:  projectID,
+		BucketName: string(bucket),
+		ObjectKey:  metabase.ObjectKey(encryptedObjectKey),
+	}
+
+	if version == 0 {
+		return endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+			ObjectLocation: location,
+		})
+	}
+	return endpoint.metainfo.metabaseDB.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+		ObjectLocation: location,
+		Version:        metabase.Version(version),
+	})
+}
+
+// convertRetentionError converts an error of a retention operation to an rpc error.
+func (endpoint *Endpoint) convertRetentionError(err error) error {
+	switch {
+	case storj.ErrObjectNotFound.Has(err):
+		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	case metabase.ErrObjectLocked.Has(err):
+		return rpcstatus.Error(rpcstatus.PermissionDenied, "retention period cannot be shortened")
+	case metabase.ErrInvalidRequest.Has(err):
+		return rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	endpoint.log.Error("internal", zap.Error(err))
+	return rpcstatus.Error(rpcstatus.Internal, err.Error())
+}
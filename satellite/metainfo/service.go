@@ -56,6 +56,12 @@ func (s *Service) GetBucket(ctx context.Context, bucketName []byte, projectID uu
 	return s.bucketsDB.GetBucket(ctx, bucketName, projectID)
 }
 
+// GetBucketUploadSettings returns the versioning, redundancy and placement of a bucket.
+func (s *Service) GetBucketUploadSettings(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ BucketUploadSettings, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.GetBucketUploadSettings(ctx, bucketName, projectID)
+}
+
 // UpdateBucket returns an updated bucket in the buckets db.
 func (s *Service) UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error) {
 	defer mon.Task()(&ctx)(&err)
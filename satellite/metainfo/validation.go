@@ -47,15 +47,43 @@ func getAPIKey(ctx context.Context, header *pb.RequestHeader) (key *macaroon.API
 func (endpoint *Endpoint) validateAuth(ctx context.Context, header *pb.RequestHeader, action macaroon.Action) (_ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return endpoint.validateAuthN(ctx, header, verifyPermission{action: action})
+}
+
+// verifyPermission is a permission checked by validateAuthN.
+type verifyPermission struct {
+	action macaroon.Action
+	// optional permissions don't fail the validation when they are denied.
+	// The result of the check is stored in actionPermitted.
+	optional        bool
+	actionPermitted *bool
+}
+
+// validateAuthN is like validateAuth, but checks all the permissions that a
+// request needs. The API key is validated only once, so the request counts
+// only once against the rate limits and the request quota of the key.
+func (endpoint *Endpoint) validateAuthN(ctx context.Context, header *pb.RequestHeader, permissions ...verifyPermission) (_ *console.APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	key, keyInfo, err := endpoint.validateBasic(ctx, header)
 	if err != nil {
 		return nil, err
 	}
 
-	err = key.Check(ctx, keyInfo.Secret, action, endpoint.revocations)
-	if err != nil {
-		endpoint.log.Debug("unauthorized request", zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+	for _, permission := range permissions {
+		err = key.Check(ctx, keyInfo.Secret, permission.action, endpoint.revocations)
+		if permission.actionPermitted != nil {
+			*permission.actionPermitted = err == nil
+		}
+		if err != nil && !permission.optional {
+			endpoint.log.Debug("unauthorized request", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+		}
+	}
+
+	// the request is counted only after it passed all the other checks
+	if err = endpoint.checkAPIKeyQuota(ctx, keyInfo); err != nil {
+		return nil, err
 	}
 
 	return keyInfo, nil
@@ -76,7 +104,7 @@ func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestH
 		return nil, nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
 	}
 
-	if err = endpoint.checkRate(ctx, keyInfo.ProjectID); err != nil {
+	if err = endpoint.checkRate(ctx, keyInfo); err != nil {
 		endpoint.log.Debug("rate check failed", zap.Error(err))
 		return nil, nil, err
 	}
@@ -104,17 +132,73 @@ func (endpoint *Endpoint) validateRevoke(ctx context.Context, header *pb.Request
 	// contained within the checked tails of the macaroon we want to revoke.
 	for i := 0; i < len(tails)-1; i++ {
 		if subtle.ConstantTimeCompare(tails[i], keyTail) == 1 {
+			if err := endpoint.checkAPIKeyQuota(ctx, keyInfo); err != nil {
+				return nil, err
+			}
 			return keyInfo, nil
 		}
 	}
 	return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized attempt to revoke macaroon")
 }
 
-func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (err error) {
+// checkRate applies the rate limit of the project and, if it has one, the rate
+// limit of the API key. The API key limit is applied in addition to the project
+// rate limit, so a single key cannot use up the rate of the whole project.
+// A request rejected by either of the limits doesn't use up the other one.
+func (endpoint *Endpoint) checkRate(ctx context.Context, keyInfo *console.APIKeyInfo) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !endpoint.config.RateLimiter.Enabled {
 		return nil
 	}
+
+	projectLimiter, err := endpoint.projectLimiter(ctx, keyInfo.ProjectID)
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+	apiKeyLimiter, err := endpoint.apiKeyLimiter(keyInfo)
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+
+	now := time.Now()
+
+	projectReservation := projectLimiter.ReserveN(now, 1)
+	if projectReservation.DelayFrom(now) > 0 {
+		projectReservation.CancelAt(now)
+
+		endpoint.log.Warn("too many requests for project",
+			zap.Stringer("projectID", keyInfo.ProjectID),
+			zap.Float64("limit", float64(projectLimiter.Limit())))
+
+		mon.Event("metainfo_rate_limit_exceeded") //mon:locked
+
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
+	}
+
+	if apiKeyLimiter == nil {
+		return nil
+	}
+
+	apiKeyReservation := apiKeyLimiter.ReserveN(now, 1)
+	if apiKeyReservation.DelayFrom(now) > 0 {
+		apiKeyReservation.CancelAt(now)
+		projectReservation.CancelAt(now)
+
+		endpoint.log.Warn("too many requests for api key",
+			zap.Stringer("projectID", keyInfo.ProjectID),
+			zap.Stringer("apiKeyID", keyInfo.ID),
+			zap.Float64("limit", float64(apiKeyLimiter.Limit())))
+
+		mon.Event("metainfo_api_key_rate_limit_exceeded")
+
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
+	}
+
+	return nil
+}
+
+// projectLimiter returns the rate limiter of the project.
+func (endpoint *Endpoint) projectLimiter(ctx context.Context, projectID uuid.UUID) (_ *rate.Limiter, err error) {
 	limiter, err := endpoint.limiterCache.Get(projectID.String(), func() (interface{}, error) {
 		limit := rate.Limit(endpoint.config.RateLimiter.Rate)
 
@@ -128,20 +212,64 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 
 		// initialize the limiter with limit and burst the same so that we don't limit how quickly calls
 		// are made within the second.
-		return rate.NewLimiter(limit, int(limit)), nil
+		return rate.NewLimiter(limit, burstForLimit(limit)), nil
 	})
 	if err != nil {
-		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+		return nil, err
 	}
+	return limiter.(*rate.Limiter), nil
+}
 
-	if !limiter.(*rate.Limiter).Allow() {
-		endpoint.log.Warn("too many requests for project",
-			zap.Stringer("projectID", projectID),
-			zap.Float64("limit", float64(limiter.(*rate.Limiter).Limit())))
+// apiKeyLimiter returns the rate limiter of the API key, or nil when the key
+// has no rate limit of its own.
+func (endpoint *Endpoint) apiKeyLimiter(keyInfo *console.APIKeyInfo) (_ *rate.Limiter, err error) {
+	if keyInfo.RateLimit == nil || *keyInfo.RateLimit <= 0 {
+		return nil, nil
+	}
+	limiter, err := endpoint.apiKeyLimiterCache.Get(keyInfo.ID.String(), func() (interface{}, error) {
+		limit := rate.Limit(*keyInfo.RateLimit)
+		return rate.NewLimiter(limit, burstForLimit(limit)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return limiter.(*rate.Limiter), nil
+}
 
-		mon.Event("metainfo_rate_limit_exceeded") //mon:locked
+// burstForLimit returns the burst of a limiter with the limit. The burst is
+// at least one, otherwise a limit below one request per second would reject
+// every request.
+func burstForLimit(limit rate.Limit) int {
+	if limit < 1 {
+		return 1
+	}
+	return int(limit)
+}
 
-		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
+// checkAPIKeyQuota counts the request towards the daily request quota of the
+// API key, if it has one.
+func (endpoint *Endpoint) checkAPIKeyQuota(ctx context.Context, keyInfo *console.APIKeyInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if keyInfo.RequestQuota == nil || *keyInfo.RequestQuota <= 0 {
+		return nil
+	}
+
+	exceeded, err := endpoint.projectUsage.ExceedsAPIKeyRequestQuota(ctx, keyInfo.ID, *keyInfo.RequestQuota)
+	if err != nil {
+		endpoint.log.Error("Retrieving api key request count failed; request quota won't be enforced",
+			zap.Stringer("apiKeyID", keyInfo.ID), zap.Error(err))
+		return nil
+	}
+
+	if exceeded {
+		endpoint.log.Warn("request quota exceeded for api key",
+			zap.Stringer("projectID", keyInfo.ProjectID),
+			zap.Stringer("apiKeyID", keyInfo.ID),
+			zap.Int64("quota", *keyInfo.RequestQuota))
+
+		mon.Event("metainfo_api_key_request_quota_exceeded")
+
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "API Key Request Quota Exceeded")
 	}
 
 	return nil
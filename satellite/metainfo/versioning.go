@@ -0,0 +1,264 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Versioning is the versioning state of a bucket.
+type Versioning int
+
+const (
+	// Unversioned means that uploading an object replaces the previous one
+	// and deleting an object removes all of its data.
+	Unversioned Versioning = 0
+	// VersioningEnabled means that uploading an object creates a new version,
+	// and deleting an object creates a delete marker while keeping previous versions.
+	VersioningEnabled Versioning = 1
+)
+
+// String returns a string representation of versioning state.
+func (versioning Versioning) String() string {
+	switch versioning {
+	case Unversioned:
+		return "unversioned"
+	case VersioningEnabled:
+		return "enabled"
+	default:
+		return "unknown"
+	}
+}
+
+// GetBucketVersioning returns the versioning state of a bucket.
+func (s *Service) GetBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ Versioning, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.GetBucketVersioning(ctx, bucketName, projectID)
+}
+
+// EnableBucketVersioning enables versioning of a bucket.
+// Once enabled, versioning cannot be turned off for the bucket.
+func (s *Service) EnableBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.UpdateBucketVersioning(ctx, bucketName, projectID, VersioningEnabled)
+}
+
+// GetBucketVersioning returns the versioning state of a bucket.
+func (endpoint *Endpoint) GetBucketVersioning(ctx context.Context, req *internalpb.BucketGetVersioningRequest) (resp *internalpb.BucketGetVersioningResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	versioning, err := endpoint.metainfo.GetBucketVersioning(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	return &internalpb.BucketGetVersioningResponse{
+		Versioning: int32(versioning),
+	}, nil
+}
+
+// SetBucketVersioning updates the versioning state of a bucket. Versioning can
+// only be enabled, it cannot be turned off once objects may have multiple versions.
+func (endpoint *Endpoint) SetBucketVersioning(ctx context.Context, req *internalpb.BucketSetVersioningRequest) (resp *internalpb.BucketSetVersioningResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if Versioning(req.Versioning) != VersioningEnabled {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "only enabling versioning is supported")
+	}
+
+	err = endpoint.metainfo.EnableBucketVersioning(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	return &internalpb.BucketSetVersioningResponse{}, nil
+}
+
+// ListObjectVersions lists all committed versions and delete markers of the objects in a bucket.
+// The versions of an object are ordered from the oldest to the latest.
+func (endpoint *Endpoint) ListObjectVersions(ctx context.Context, req *internalpb.ObjectListVersionsRequest) (resp *internalpb.ObjectListVersionsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionList,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPrefix,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	exists, err := endpoint.metainfo.HasBucket(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.log.Error("unable to check bucket", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if !exists {
+		return nil, rpcstatus.Error(rpcstatus.NotFound, "bucket not found: "+string(req.Bucket))
+	}
+
+	limit := int(req.Limit)
+	if limit < 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "limit is negative")
+	}
+	metabase.ListLimit.Ensure(&limit)
+
+	var prefix metabase.ObjectKey
+	if len(req.EncryptedPrefix) != 0 {
+		prefix = metabase.ObjectKey(req.EncryptedPrefix)
+		if prefix[len(prefix)-1] != metabase.Delimiter {
+			prefix += metabase.ObjectKey(metabase.Delimiter)
+		}
+	}
+
+	cursor := string(req.EncryptedCursor)
+	if len(cursor) != 0 {
+		cursor = string(prefix) + cursor
+	}
+
+	// one more entry is collected to know whether the last listed version is the latest one
+	var entries []metabase.ObjectEntry
+	err = endpoint.metainfo.metabaseDB.IterateObjectVersions(ctx,
+		metabase.IterateObjectVersions{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			Prefix:     prefix,
+			Cursor: metabase.IterateCursor{
+				Key:     metabase.ObjectKey(cursor),
+				Version: metabase.Version(req.CursorVersion),
+			},
+			Recursive: req.Recursive,
+			BatchSize: limit + 1,
+		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+			entry := metabase.ObjectEntry{}
+			for len(entries) < limit+1 && it.Next(ctx, &entry) {
+				entries = append(entries, entry)
+			}
+			return nil
+		})
+	if err != nil {
+		if metabase.ErrInvalidRequest.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		endpoint.log.Error("unable to list object versions", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	resp = &internalpb.ObjectListVersionsResponse{}
+	if len(entries) > limit {
+		resp.More = true
+	}
+	for i, entry := range entries {
+		if i == limit {
+			break
+		}
+		// versions are ordered, so the latest version is followed by a different key
+		latest := i+1 == len(entries) || entries[i+1].ObjectKey != entry.ObjectKey
+		resp.Items = append(resp.Items, &internalpb.ObjectVersion{
+			EncryptedObjectKey: []byte(entry.ObjectKey),
+			Version:            int64(entry.Version),
+			IsPrefix:           entry.IsPrefix,
+			IsDeleteMarker:     entry.Status == metabase.DeleteMarker,
+			IsLatest:           latest && !entry.IsPrefix,
+			CreatedAt:          entry.CreatedAt,
+			PlainSize:          entry.TotalPlainSize,
+		})
+	}
+
+	endpoint.log.Info("Object List Versions", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "list"), zap.String("type", "object"))
+	mon.Meter("req_list_object_versions").Mark(1)
+
+	return resp, nil
+}
+
+// DeleteObjectVersion deletes an exact version of an object. Deleting a delete marker
+// makes the previous version visible again.
+func (endpoint *Endpoint) DeleteObjectVersion(ctx context.Context, req *internalpb.ObjectDeleteVersionRequest) (resp *internalpb.ObjectDeleteVersionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionDelete,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedObjectKey,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	if req.Version <= 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "version is required")
+	}
+
+	_, err = endpoint.DeleteObjectExactVersion(ctx, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+	}, metabase.Version(req.Version))
+	if err != nil {
+		switch {
+		case storj.ErrObjectNotFound.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		case metabase.ErrObjectLocked.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "object is locked and cannot be deleted")
+		case metabase.ErrInvalidRequest.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	endpoint.log.Info("Object Version Delete", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "delete"), zap.String("type", "object"))
+	mon.Meter("req_delete_object_version").Mark(1)
+
+	return &internalpb.ObjectDeleteVersionResponse{}, nil
+}
+
+// convertBucketError converts an error of a bucket operation to an rpc error.
+func (endpoint *Endpoint) convertBucketError(err error) error {
+	if storj.ErrBucketNotFound.Has(err) {
+		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	}
+	endpoint.log.Error("internal", zap.Error(err))
+	return rpcstatus.Error(rpcstatus.Internal, err.Error())
+}
@@ -12,13 +12,31 @@ type Node struct {
 	storj.NodeURL
 	LastNet    string
 	LastIPPort string
+	// CountryCode is the ISO 3166-1 alpha-2 code of the node location, empty when unknown.
+	CountryCode string
+	// FreeDisk is the free disk space reported by the node in bytes.
+	FreeDisk int64
+	// Reputation is the audit reputation score of the node between 0 and 1.
+	Reputation float64
 }
 
 // Clone returns a deep clone of the selected node.
 func (node *Node) Clone() *Node {
 	return &Node{
-		NodeURL:    node.NodeURL,
-		LastNet:    node.LastNet,
-		LastIPPort: node.LastIPPort,
+		NodeURL:     node.NodeURL,
+		LastNet:     node.LastNet,
+		LastIPPort:  node.LastIPPort,
+		CountryCode: node.CountryCode,
+		FreeDisk:    node.FreeDisk,
+		Reputation:  node.Reputation,
 	}
 }
+
+// Weight returns the weight of the node for weighted selection,
+// which is its free disk space scaled by its reputation.
+func (node *Node) Weight() float64 {
+	if node.FreeDisk <= 0 || node.Reputation <= 0 {
+		return 0
+	}
+	return float64(node.FreeDisk) * node.Reputation
+}
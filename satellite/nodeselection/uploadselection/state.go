@@ -10,6 +10,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
+	"storj.io/storj/satellite/placement"
 )
 
 // ErrNotEnoughNodes is when selecting nodes failed with the given parameters.
@@ -22,15 +23,26 @@ type State struct {
 	stats Stats
 	// netByID returns subnet based on storj.NodeID
 	netByID map[storj.NodeID]string
+
+	weighted bool
+	// reputableNodes and newNodes contain all nodes, used for creating the placement selectors.
+	reputableNodes []*Node
+	newNodes       []*Node
+	// byPlacement contains the selectors for nodes allowed by the placement.
+	byPlacement map[placement.Placement]*selectors
+}
+
+// selectors contains selectors for a subset of nodes.
+type selectors struct {
 	// nonDistinct contains selectors for non-distinct selection.
 	nonDistinct struct {
-		Reputable SelectByID
-		New       SelectByID
+		Reputable Selector
+		New       Selector
 	}
 	// distinct contains selectors for distinct slection.
 	distinct struct {
-		Reputable SelectBySubnet
-		New       SelectBySubnet
+		Reputable Selector
+		New       Selector
 	}
 }
 
@@ -53,8 +65,24 @@ type Selector interface {
 }
 
 // NewState returns a state based on the input.
+// Every node has equal probability of being selected.
 func NewState(reputableNodes, newNodes []*Node) *State {
-	state := &State{}
+	return newState(reputableNodes, newNodes, false)
+}
+
+// NewWeightedState returns a state based on the input.
+// The probability of selecting a node is proportional to its weight.
+func NewWeightedState(reputableNodes, newNodes []*Node) *State {
+	return newState(reputableNodes, newNodes, true)
+}
+
+func newState(reputableNodes, newNodes []*Node, weighted bool) *State {
+	state := &State{
+		weighted:       weighted,
+		reputableNodes: reputableNodes,
+		newNodes:       newNodes,
+		byPlacement:    map[placement.Placement]*selectors{},
+	}
 
 	state.netByID = map[storj.NodeID]string{}
 	for _, node := range reputableNodes {
@@ -64,37 +92,91 @@ func NewState(reputableNodes, newNodes []*Node) *State {
 		state.netByID[node.ID] = node.LastNet
 	}
 
-	state.nonDistinct.Reputable = SelectByID(reputableNodes)
-	state.nonDistinct.New = SelectByID(newNodes)
-
-	state.distinct.Reputable = SelectBySubnetFromNodes(reputableNodes)
-	state.distinct.New = SelectBySubnetFromNodes(newNodes)
+	all := state.newSelectors(placement.EveryCountry)
+	state.byPlacement[placement.EveryCountry] = all
 
 	state.stats = Stats{
-		New:       state.nonDistinct.New.Count(),
-		Reputable: state.nonDistinct.Reputable.Count(),
+		New:       all.nonDistinct.New.Count(),
+		Reputable: all.nonDistinct.Reputable.Count(),
 
-		NewDistinct:       state.distinct.New.Count(),
-		ReputableDistinct: state.distinct.Reputable.Count(),
+		NewDistinct:       all.distinct.New.Count(),
+		ReputableDistinct: all.distinct.Reputable.Count(),
 	}
 
 	return state
 }
 
+// newSelectors creates selectors for the nodes allowed by the placement.
+func (state *State) newSelectors(placement placement.Placement) *selectors {
+	reputableNodes := filterByPlacement(state.reputableNodes, placement)
+	newNodes := filterByPlacement(state.newNodes, placement)
+
+	s := &selectors{}
+	if state.weighted {
+		s.nonDistinct.Reputable = SelectByWeight(reputableNodes)
+		s.nonDistinct.New = SelectByWeight(newNodes)
+
+		s.distinct.Reputable = SelectBySubnetWeightFromNodes(reputableNodes)
+		s.distinct.New = SelectBySubnetWeightFromNodes(newNodes)
+	} else {
+		s.nonDistinct.Reputable = SelectByID(reputableNodes)
+		s.nonDistinct.New = SelectByID(newNodes)
+
+		s.distinct.Reputable = SelectBySubnetFromNodes(reputableNodes)
+		s.distinct.New = SelectBySubnetFromNodes(newNodes)
+	}
+	return s
+}
+
+// selectorsFor returns selectors for the placement, creating them when necessary.
+func (state *State) selectorsFor(placement placement.Placement) *selectors {
+	state.mu.RLock()
+	s, ok := state.byPlacement[placement]
+	state.mu.RUnlock()
+	if ok {
+		return s
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if s, ok := state.byPlacement[placement]; ok {
+		return s
+	}
+	s = state.newSelectors(placement)
+	state.byPlacement[placement] = s
+	return s
+}
+
+// filterByPlacement returns the nodes allowed by the placement.
+func filterByPlacement(nodes []*Node, constraint placement.Placement) []*Node {
+	if constraint == placement.EveryCountry {
+		return nodes
+	}
+
+	filtered := []*Node{}
+	for _, node := range nodes {
+		if constraint.AllowedCountry(node.CountryCode) {
+			filtered = append(filtered, node)
+		}
+	}
+	return filtered
+}
+
 // Request contains arguments for State.Request.
 type Request struct {
 	Count       int
 	NewFraction float64
 	Distinct    bool
 	ExcludedIDs []storj.NodeID
+	Placement   placement.Placement
 }
 
 // Select selects requestedCount nodes where there will be newFraction nodes.
 func (state *State) Select(ctx context.Context, request Request) (_ []*Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	state.mu.RLock()
-	defer state.mu.RUnlock()
+	selectors := state.selectorsFor(request.Placement)
 
 	totalCount := request.Count
 	newCount := int(float64(totalCount) * request.NewFraction)
@@ -112,11 +194,11 @@ func (state *State) Select(ctx context.Context, request Request) (_ []*Node, err
 				excludedNets[net] = struct{}{}
 			}
 		}
-		reputableNodes = state.distinct.Reputable
-		newNodes = state.distinct.New
+		reputableNodes = selectors.distinct.Reputable
+		newNodes = selectors.distinct.New
 	} else {
-		reputableNodes = state.nonDistinct.Reputable
-		newNodes = state.nonDistinct.New
+		reputableNodes = selectors.nonDistinct.Reputable
+		newNodes = selectors.nonDistinct.New
 	}
 
 	// Get a random selection of new nodes out of the cache first so that if there aren't
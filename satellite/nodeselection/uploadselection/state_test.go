@@ -14,6 +14,7 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/satellite/nodeselection/uploadselection"
+	"storj.io/storj/satellite/placement"
 )
 
 func TestState_Select(t *testing.T) {
@@ -161,6 +162,111 @@ func TestState_Select_Concurrent(t *testing.T) {
 	require.NoError(t, group.Wait())
 }
 
+func TestState_Select_Placement(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	euNodes := joinNodes(
+		createRandomNodes(2, "1.0.1"),
+		createRandomNodes(2, "1.0.2"),
+	)
+	for _, node := range euNodes {
+		node.CountryCode = "DE"
+	}
+	usNodes := createRandomNodes(3, "1.0.3")
+	for _, node := range usNodes {
+		node.CountryCode = "US"
+	}
+	unknownNodes := createRandomNodes(3, "1.0.4")
+
+	for _, state := range []*uploadselection.State{
+		uploadselection.NewState(joinNodes(euNodes, usNodes, unknownNodes), nil),
+		uploadselection.NewWeightedState(joinNodes(euNodes, usNodes, unknownNodes), nil),
+	} {
+		{ // select only EU nodes
+			selected, err := state.Select(ctx, uploadselection.Request{
+				Count:     4,
+				Placement: placement.EU,
+			})
+			require.NoError(t, err)
+			require.Len(t, intersectLists(selected, euNodes), 4)
+		}
+
+		{ // select distinct EU nodes
+			selected, err := state.Select(ctx, uploadselection.Request{
+				Count:     2,
+				Distinct:  true,
+				Placement: placement.EU,
+			})
+			require.NoError(t, err)
+			require.Len(t, intersectLists(selected, euNodes), 2)
+			require.NotEqual(t, selected[0].LastNet, selected[1].LastNet)
+		}
+
+		{ // there are not enough US nodes
+			selected, err := state.Select(ctx, uploadselection.Request{
+				Count:     4,
+				Placement: placement.US,
+			})
+			require.True(t, uploadselection.ErrNotEnoughNodes.Has(err))
+			require.Len(t, intersectLists(selected, usNodes), 3)
+		}
+
+		{ // nodes with unknown location can be selected without placement
+			selected, err := state.Select(ctx, uploadselection.Request{
+				Count: 10,
+			})
+			require.NoError(t, err)
+			require.Len(t, selected, 10)
+		}
+	}
+}
+
+func TestState_Select_Weighted(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	large := createRandomNodes(1, "1.0.1")[0]
+	large.FreeDisk = 9000
+	large.Reputation = 1
+	small := createRandomNodes(1, "1.0.2")[0]
+	small.FreeDisk = 1000
+	small.Reputation = 1
+	zeroReputation := createRandomNodes(1, "1.0.3")[0]
+	zeroReputation.FreeDisk = 1000
+	zeroReputation.Reputation = 0
+
+	nodes := []*uploadselection.Node{large, small, zeroReputation}
+
+	for _, distinct := range []bool{false, true} {
+		state := uploadselection.NewWeightedState(nodes, nil)
+
+		const executionCount = 10000
+		selectedCount := map[storj.NodeID]int{}
+		for i := 0; i < executionCount; i++ {
+			selected, err := state.Select(ctx, uploadselection.Request{
+				Count:    1,
+				Distinct: distinct,
+			})
+			require.NoError(t, err)
+			require.Len(t, selected, 1)
+			selectedCount[selected[0].ID]++
+		}
+
+		require.InDelta(t, 0.9, float64(selectedCount[large.ID])/executionCount, 0.05)
+		require.InDelta(t, 0.1, float64(selectedCount[small.ID])/executionCount, 0.05)
+		require.Zero(t, selectedCount[zeroReputation.ID])
+
+		// nodes without weight are used, when there are no other nodes
+		selected, err := state.Select(ctx, uploadselection.Request{
+			Count:    3,
+			Distinct: distinct,
+		})
+		require.NoError(t, err)
+		require.Len(t, selected, 3)
+	}
+}
+
 // createRandomNodes creates n random nodes all in the subnet.
 func createRandomNodes(n int, subnet string) []*uploadselection.Node {
 	xs := make([]*uploadselection.Node, n)
@@ -0,0 +1,187 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection
+
+import (
+	"container/heap"
+	"math"
+	mathrand "math/rand" // Using mathrand here because crypto-graphic randomness is not required and simplifies code.
+
+	"storj.io/common/storj"
+)
+
+// SelectByWeight implements selection from nodes with the probability of selecting
+// a node being proportional to its weight.
+type SelectByWeight []*Node
+
+var _ Selector = (SelectByWeight)(nil)
+
+// Count returns the number of maximum number of nodes that it can return.
+func (nodes SelectByWeight) Count() int { return len(nodes) }
+
+// Select selects upto n nodes.
+func (nodes SelectByWeight) Select(n int, excludedIDs []storj.NodeID, excludedNets map[string]struct{}) []*Node {
+	if n <= 0 {
+		return nil
+	}
+
+	weights := make([]float64, len(nodes))
+	for i, node := range nodes {
+		weights[i] = node.Weight()
+	}
+
+	selected := []*Node{}
+	order := newWeightedOrder(weights)
+	for order.Len() > 0 {
+		node := nodes[order.Next()]
+
+		if ContainsID(excludedIDs, node.ID) {
+			continue
+		}
+		if excludedNets != nil {
+			if _, excluded := excludedNets[node.LastNet]; excluded {
+				continue
+			}
+			excludedNets[node.LastNet] = struct{}{}
+		}
+
+		selected = append(selected, node.Clone())
+		if len(selected) >= n {
+			break
+		}
+	}
+
+	return selected
+}
+
+// SelectBySubnetWeight implements selection from subnets with the probability of
+// selecting a subnet being proportional to the total weight of its nodes.
+// Within a subnet the node is selected proportionally to its weight.
+type SelectBySubnetWeight []Subnet
+
+var _ Selector = (SelectBySubnetWeight)(nil)
+
+// SelectBySubnetWeightFromNodes creates SelectBySubnetWeight selector from nodes.
+func SelectBySubnetWeightFromNodes(nodes []*Node) SelectBySubnetWeight {
+	return SelectBySubnetWeight(SelectBySubnetFromNodes(nodes))
+}
+
+// Count returns the number of maximum number of nodes that it can return.
+func (subnets SelectBySubnetWeight) Count() int { return len(subnets) }
+
+// Select selects upto n nodes.
+func (subnets SelectBySubnetWeight) Select(n int, excludedIDs []storj.NodeID, excludedNets map[string]struct{}) []*Node {
+	if n <= 0 {
+		return nil
+	}
+
+	weights := make([]float64, len(subnets))
+	for i, subnet := range subnets {
+		for _, node := range subnet.Nodes {
+			weights[i] += node.Weight()
+		}
+	}
+
+	selected := []*Node{}
+	order := newWeightedOrder(weights)
+	for order.Len() > 0 {
+		idx := order.Next()
+		subnet := subnets[idx]
+		node := subnet.Nodes[weightedIndex(subnet.Nodes, weights[idx])]
+
+		if ContainsID(excludedIDs, node.ID) {
+			continue
+		}
+		if excludedNets != nil {
+			if _, excluded := excludedNets[node.LastNet]; excluded {
+				continue
+			}
+			excludedNets[node.LastNet] = struct{}{}
+		}
+
+		selected = append(selected, node.Clone())
+		if len(selected) >= n {
+			break
+		}
+	}
+
+	return selected
+}
+
+// weightedOrder returns indexes in a random order, where the probability of an
+// index being earlier is proportional to its weight.
+//
+// It uses the algorithm by Efraimidis and Spirakis. The keys are kept in a heap,
+// so that taking the first k indexes doesn't need sorting all of them. Items without
+// weight come last in random order.
+type weightedOrder struct {
+	keys []float64
+	ties []float64
+	perm []int
+}
+
+func newWeightedOrder(weights []float64) *weightedOrder {
+	order := &weightedOrder{
+		keys: make([]float64, len(weights)),
+		ties: make([]float64, len(weights)),
+		perm: make([]int, len(weights)),
+	}
+	for i, weight := range weights {
+		order.perm[i] = i
+		if weight > 0 {
+			order.keys[i] = mathrand.ExpFloat64() / weight
+		} else {
+			order.keys[i] = math.Inf(1)
+			order.ties[i] = mathrand.Float64()
+		}
+	}
+	heap.Init(order)
+	return order
+}
+
+// Next returns the next index, it must only be called when Len is positive.
+func (order *weightedOrder) Next() int { return heap.Pop(order).(int) }
+
+// Len implements heap.Interface.
+func (order *weightedOrder) Len() int { return len(order.perm) }
+
+// Less implements heap.Interface.
+func (order *weightedOrder) Less(i, k int) bool {
+	a, b := order.perm[i], order.perm[k]
+	if order.keys[a] == order.keys[b] {
+		return order.ties[a] < order.ties[b]
+	}
+	return order.keys[a] < order.keys[b]
+}
+
+// Swap implements heap.Interface.
+func (order *weightedOrder) Swap(i, k int) {
+	order.perm[i], order.perm[k] = order.perm[k], order.perm[i]
+}
+
+// Push implements heap.Interface.
+func (order *weightedOrder) Push(x interface{}) { order.perm = append(order.perm, x.(int)) }
+
+// Pop implements heap.Interface.
+func (order *weightedOrder) Pop() interface{} {
+	last := order.perm[len(order.perm)-1]
+	order.perm = order.perm[:len(order.perm)-1]
+	return last
+}
+
+// weightedIndex returns a random index of the nodes, proportionally to their weights.
+func weightedIndex(nodes []*Node, total float64) int {
+	if total <= 0 {
+		return mathrand.Intn(len(nodes))
+	}
+
+	r := mathrand.Float64() * total
+	for i, node := range nodes {
+		r -= node.Weight()
+		if r < 0 {
+			return i
+		}
+	}
+	return len(nodes) - 1
+}
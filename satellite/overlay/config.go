@@ -24,6 +24,13 @@ type Config struct {
 	NodeSelectionCache    UploadSelectionCacheConfig
 	UpdateStatsBatchSize  int           `help:"number of update requests to process per transaction" default:"100"`
 	NodeCheckInWaitPeriod time.Duration `help:"the amount of time to wait before accepting a redundant check-in from a node (unmodified info since last check-in)" default:"2h" testDefault:"30s"`
+	GeoIP                 GeoIPConfig
+}
+
+// GeoIPConfig is a configuration struct for resolving the location of the nodes.
+type GeoIPConfig struct {
+	DB            string   `help:"the location of the MaxMind database containing geoip country information" default:""`
+	MockCountries []string `help:"a mock list of countries the satellite will attribute to nodes (useful for testing)"`
 }
 
 // AsOfSystemTimeConfig is a configuration struct to enable 'AS OF SYSTEM TIME' for CRDB queries.
@@ -40,6 +47,7 @@ type NodeSelectionConfig struct {
 	OnlineWindow     time.Duration `help:"the amount of time without seeing a node before its considered offline" default:"4h" testDefault:"1m"`
 	DistinctIP       bool          `help:"require distinct IPs when choosing nodes for upload" releaseDefault:"true" devDefault:"false"`
 	MinimumDiskSpace memory.Size   `help:"how much disk space a node at minimum must have to be selected for upload" default:"500.00MB" testDefault:"100.00MB"`
+	Weighted         bool          `help:"select nodes proportionally to their free disk space and reputation" default:"false"`
 
 	AsOfSystemTime AsOfSystemTimeConfig
 }
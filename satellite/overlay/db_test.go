@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/pb"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/private/teststorj"
@@ -164,3 +165,38 @@ func TestOperatorConfig(t *testing.T) {
 		}
 	})
 }
+
+func TestGetNodesByLastNetAndReinstate(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		cache := planet.Satellites[0].Overlay.DB
+		node := planet.StorageNodes[0]
+
+		dossier, err := cache.Get(ctx, node.ID())
+		require.NoError(t, err)
+
+		nodes, err := cache.GetNodesByLastNet(ctx, dossier.LastNet)
+		require.NoError(t, err)
+		var nodeIDs storj.NodeIDList
+		for _, n := range nodes {
+			nodeIDs = append(nodeIDs, n.Id)
+		}
+		require.Contains(t, nodeIDs, node.ID())
+		require.Contains(t, nodeIDs, planet.StorageNodes[1].ID())
+
+		nodes, err = cache.GetNodesByLastNet(ctx, "10.10.10.0")
+		require.NoError(t, err)
+		require.Empty(t, nodes)
+
+		require.NoError(t, cache.DisqualifyNode(ctx, node.ID()))
+		dossier, err = cache.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.NotNil(t, dossier.Disqualified)
+
+		require.NoError(t, cache.ReinstateNode(ctx, node.ID()))
+		dossier, err = cache.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.Nil(t, dossier.Disqualified)
+	})
+}
@@ -14,7 +14,9 @@ import (
 
 	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/storj/satellite/geoip"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/placement"
 )
 
 // ErrEmptyNode is returned when the nodeID is empty.
@@ -57,7 +59,7 @@ type DB interface {
 	// KnownReliable filters a set of nodes to reliable (online and qualified) nodes.
 	KnownReliable(ctx context.Context, onlineWindow time.Duration, nodeIDs storj.NodeIDList) ([]*pb.Node, error)
 	// Reliable returns all nodes that are reliable
-	Reliable(context.Context, *NodeCriteria) (storj.NodeIDList, error)
+	Reliable(context.Context, *NodeCriteria) ([]*SelectedNode, error)
 	// UpdateReputation updates the DB columns for all reputation fields in ReputationStatus.
 	UpdateReputation(ctx context.Context, id storj.NodeID, request *ReputationStatus) error
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
@@ -83,18 +85,22 @@ type DB interface {
 
 	// GetNodesNetwork returns the /24 subnet for each storage node, order is not guaranteed.
 	GetNodesNetwork(ctx context.Context, nodeIDs []storj.NodeID) (nodeNets []string, err error)
+	// GetNodesByLastNet returns the storage nodes in the subnet, ordered by ID.
+	GetNodesByLastNet(ctx context.Context, lastNet string) (nodes []*NodeDossier, err error)
 
 	// DisqualifyNode disqualifies a storage node.
 	DisqualifyNode(ctx context.Context, nodeID storj.NodeID) (err error)
+	// ReinstateNode removes the disqualification of a storage node and resets its audit reputation.
+	ReinstateNode(ctx context.Context, nodeID storj.NodeID) (err error)
 
 	// DQNodesLastSeenBefore disqualifies a limited number of nodes where last_contact_success < cutoff except those already disqualified
 	// or gracefully exited or where last_contact_success = '0001-01-01 00:00:00+00'.
 	DQNodesLastSeenBefore(ctx context.Context, cutoff time.Time, limit int) (count int, err error)
 
-	// TestSuspendNodeUnknownAudit suspends a storage node for unknown audits.
-	TestSuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error)
-	// TestUnsuspendNodeUnknownAudit unsuspends a storage node for unknown audits.
-	TestUnsuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID) (err error)
+	// SuspendNodeUnknownAudit suspends a storage node for unknown audits.
+	SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error)
+	// UnsuspendNodeUnknownAudit unsuspends a storage node for unknown audits.
+	UnsuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID) (err error)
 
 	// TestVetNode directly sets a node's vetted_at timestamp to make testing easier
 	TestVetNode(ctx context.Context, nodeID storj.NodeID) (vettedTime *time.Time, err error)
@@ -111,14 +117,15 @@ type DB interface {
 
 // NodeCheckInInfo contains all the info that will be updated when a node checkins.
 type NodeCheckInInfo struct {
-	NodeID     storj.NodeID
-	Address    *pb.NodeAddress
-	LastNet    string
-	LastIPPort string
-	IsUp       bool
-	Operator   *pb.NodeOperator
-	Capacity   *pb.NodeCapacity
-	Version    *pb.NodeVersion
+	NodeID      storj.NodeID
+	Address     *pb.NodeAddress
+	LastNet     string
+	LastIPPort  string
+	CountryCode string // empty keeps the previously known country
+	IsUp        bool
+	Operator    *pb.NodeOperator
+	Capacity    *pb.NodeCapacity
+	Version     *pb.NodeVersion
 }
 
 // InfoResponse contains node dossier info requested from the storage node.
@@ -135,6 +142,7 @@ type FindStorageNodesRequest struct {
 	ExcludedIDs        []storj.NodeID
 	MinimumVersion     string        // semver or empty
 	AsOfSystemInterval time.Duration // only used for CRDB queries
+	Placement          placement.Placement
 }
 
 // NodeCriteria are the requirements for selecting nodes.
@@ -146,6 +154,7 @@ type NodeCriteria struct {
 	OnlineWindow       time.Duration
 	DistinctIP         bool
 	AsOfSystemInterval time.Duration // only used for CRDB queries
+	Placement          placement.Placement
 }
 
 // ReputationStatus indicates current reputation status for a node.
@@ -234,6 +243,7 @@ type NodeDossier struct {
 	CreatedAt             time.Time
 	LastNet               string
 	LastIPPort            string
+	CountryCode           string
 }
 
 // NodeStats contains statistics about a node.
@@ -262,6 +272,12 @@ type SelectedNode struct {
 	Address    *pb.NodeAddress
 	LastNet    string
 	LastIPPort string
+
+	// CountryCode is the ISO 3166-1 alpha-2 code of the node location, empty when unknown.
+	CountryCode string
+	// FreeDisk and Reputation are used by weighted node selection.
+	FreeDisk   int64
+	Reputation float64
 }
 
 // Clone returns a deep clone of the selected node.
@@ -272,8 +288,11 @@ func (node *SelectedNode) Clone() *SelectedNode {
 			Transport: node.Address.Transport,
 			Address:   node.Address.Address,
 		},
-		LastNet:    node.LastNet,
-		LastIPPort: node.LastIPPort,
+		LastNet:     node.LastNet,
+		LastIPPort:  node.LastIPPort,
+		CountryCode: node.CountryCode,
+		FreeDisk:    node.FreeDisk,
+		Reputation:  node.Reputation,
 	}
 }
 
@@ -284,6 +303,7 @@ type Service struct {
 	log    *zap.Logger
 	db     DB
 	config Config
+	geoIP  geoip.IPToCountry
 
 	UploadSelectionCache   *UploadSelectionCache
 	DownloadSelectionCache *DownloadSelectionCache
@@ -295,10 +315,23 @@ func NewService(log *zap.Logger, db DB, config Config) (*Service, error) {
 		return nil, err
 	}
 
+	var geoIP geoip.IPToCountry
+	switch {
+	case config.GeoIP.DB != "":
+		maxmind, err := geoip.OpenMaxmindDB(config.GeoIP.DB)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		geoIP = maxmind
+	case len(config.GeoIP.MockCountries) > 0:
+		geoIP = geoip.NewMockIPToCountry(config.GeoIP.MockCountries)
+	}
+
 	return &Service{
 		log:    log,
 		db:     db,
 		config: config,
+		geoIP:  geoIP,
 
 		UploadSelectionCache: NewUploadSelectionCache(log, db,
 			config.NodeSelectionCache.Staleness, config.Node,
@@ -313,7 +346,12 @@ func NewService(log *zap.Logger, db DB, config Config) (*Service, error) {
 }
 
 // Close closes resources.
-func (service *Service) Close() error { return nil }
+func (service *Service) Close() error {
+	if service.geoIP != nil {
+		return service.geoIP.Close()
+	}
+	return nil
+}
 
 // Get looks up the provided nodeID from the overlay.
 func (service *Service) Get(ctx context.Context, nodeID storj.NodeID) (_ *NodeDossier, err error) {
@@ -412,6 +450,7 @@ func (service *Service) FindStorageNodesWithPreferences(ctx context.Context, req
 		OnlineWindow:       preferences.OnlineWindow,
 		DistinctIP:         preferences.DistinctIP,
 		AsOfSystemInterval: req.AsOfSystemInterval,
+		Placement:          req.Placement,
 	}
 	nodes, err = service.db.SelectStorageNodes(ctx, totalNeededNodes, newNodeCount, &criteria)
 	if err != nil {
@@ -450,7 +489,7 @@ func (service *Service) KnownReliable(ctx context.Context, nodeIDs storj.NodeIDL
 }
 
 // Reliable filters a set of nodes that are reliable, independent of new.
-func (service *Service) Reliable(ctx context.Context) (nodes storj.NodeIDList, err error) {
+func (service *Service) Reliable(ctx context.Context) (nodes []*SelectedNode, err error) {
 	defer mon.Task()(&ctx)(&err)
 	criteria := &NodeCriteria{
 		OnlineWindow: service.config.Node.OnlineWindow,
@@ -458,6 +497,17 @@ func (service *Service) Reliable(ctx context.Context) (nodes storj.NodeIDList, e
 	return service.db.Reliable(ctx, criteria)
 }
 
+// LookupCountryCode returns the ISO country code of the address,
+// or an empty string when the location is unknown.
+func (service *Service) LookupCountryCode(ctx context.Context, address string) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if service.geoIP == nil {
+		return "", nil
+	}
+	countryCode, err := service.geoIP.LookupISOCountryCode(address)
+	return countryCode, Error.Wrap(err)
+}
+
 // UpdateReputation updates the DB columns for any of the reputation fields.
 func (service *Service) UpdateReputation(ctx context.Context, id storj.NodeID, request *ReputationStatus) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -514,7 +564,8 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 		(node.Capacity != nil && node.Capacity.FreeDisk != oldInfo.Capacity.FreeDisk)
 
 	if dbStale || addrChanged || walletChanged || verChanged || spaceChanged ||
-		oldInfo.LastNet != node.LastNet || oldInfo.LastIPPort != node.LastIPPort {
+		oldInfo.LastNet != node.LastNet || oldInfo.LastIPPort != node.LastIPPort ||
+		(node.CountryCode != "" && oldInfo.CountryCode != node.CountryCode) {
 		return service.db.UpdateCheckIn(ctx, node, timestamp, service.config.Node)
 	}
 
@@ -418,7 +418,7 @@ func TestKnownReliable(t *testing.T) {
 		require.False(t, service.IsOnline(node))
 
 		// unknown audit suspend storage node #2
-		err = oc.TestSuspendNodeUnknownAudit(ctx, planet.StorageNodes[2].ID(), time.Now())
+		err = oc.SuspendNodeUnknownAudit(ctx, planet.StorageNodes[2].ID(), time.Now())
 		require.NoError(t, err)
 
 		// offline suspend storage node #3
@@ -634,7 +634,7 @@ func TestSuspendedSelection(t *testing.T) {
 					require.NoError(t, err)
 					continue
 				}
-				err = cache.TestSuspendNodeUnknownAudit(ctx, newID, time.Now())
+				err = cache.SuspendNodeUnknownAudit(ctx, newID, time.Now())
 				require.NoError(t, err)
 				suspendedIDs[newID] = true
 			}
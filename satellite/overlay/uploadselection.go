@@ -79,7 +79,11 @@ func (cache *UploadSelectionCache) refresh(ctx context.Context) (state *uploadse
 	}
 
 	cache.lastRefresh = time.Now().UTC()
-	cache.state = uploadselection.NewState(convSelectedNodesToNodes(reputableNodes), convSelectedNodesToNodes(newNodes))
+	if cache.selectionConfig.Weighted {
+		cache.state = uploadselection.NewWeightedState(convSelectedNodesToNodes(reputableNodes), convSelectedNodesToNodes(newNodes))
+	} else {
+		cache.state = uploadselection.NewState(convSelectedNodesToNodes(reputableNodes), convSelectedNodesToNodes(newNodes))
+	}
 
 	mon.IntVal("refresh_cache_size_reputable").Observe(int64(len(reputableNodes)))
 	mon.IntVal("refresh_cache_size_new").Observe(int64(len(newNodes)))
@@ -110,6 +114,7 @@ func (cache *UploadSelectionCache) GetNodes(ctx context.Context, req FindStorage
 		NewFraction: cache.selectionConfig.NewNodeFraction,
 		Distinct:    cache.selectionConfig.DistinctIP,
 		ExcludedIDs: req.ExcludedIDs,
+		Placement:   req.Placement,
 	})
 	if uploadselection.ErrNotEnoughNodes.Has(err) {
 		err = ErrNotEnoughNodes.Wrap(err)
@@ -135,10 +140,13 @@ func (cache *UploadSelectionCache) Size() (reputableNodeCount int, newNodeCount
 func convNodesToSelectedNodes(nodes []*uploadselection.Node) (xs []*SelectedNode) {
 	for _, n := range nodes {
 		xs = append(xs, &SelectedNode{
-			ID:         n.ID,
-			Address:    &pb.NodeAddress{Address: n.Address},
-			LastNet:    n.LastNet,
-			LastIPPort: n.LastIPPort,
+			ID:          n.ID,
+			Address:     &pb.NodeAddress{Address: n.Address},
+			LastNet:     n.LastNet,
+			LastIPPort:  n.LastIPPort,
+			CountryCode: n.CountryCode,
+			FreeDisk:    n.FreeDisk,
+			Reputation:  n.Reputation,
 		})
 	}
 	return xs
@@ -151,8 +159,11 @@ func convSelectedNodesToNodes(nodes []*SelectedNode) (xs []*uploadselection.Node
 				ID:      n.ID,
 				Address: n.Address.Address,
 			},
-			LastNet:    n.LastNet,
-			LastIPPort: n.LastIPPort,
+			LastNet:     n.LastNet,
+			LastIPPort:  n.LastIPPort,
+			CountryCode: n.CountryCode,
+			FreeDisk:    n.FreeDisk,
+			Reputation:  n.Reputation,
 		})
 	}
 	return xs
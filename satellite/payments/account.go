@@ -29,6 +29,9 @@ type Accounts interface {
 	// ProjectCharges returns how much money current user will be charged for each project.
 	ProjectCharges(ctx context.Context, userID uuid.UUID, since, before time.Time) ([]ProjectCharge, error)
 
+	// ProjectCharge returns how much money will be charged for the project.
+	ProjectCharge(ctx context.Context, projectID uuid.UUID, since, before time.Time) (ProjectCharge, error)
+
 	// CheckProjectInvoicingStatus returns true if for the given project there are outstanding project records and/or usage
 	// which have not been applied/invoiced yet (meaning sent over to stripe).
 	CheckProjectInvoicingStatus(ctx context.Context, projectID uuid.UUID) (unpaidUsage bool, err error)
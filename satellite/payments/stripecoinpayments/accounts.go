@@ -112,24 +112,35 @@ func (accounts *accounts) ProjectCharges(ctx context.Context, userID uuid.UUID,
 	}
 
 	for _, project := range projects {
-		usage, err := accounts.service.usageDB.GetProjectTotal(ctx, project.ID, since, before)
+		charge, err := accounts.ProjectCharge(ctx, project.ID, since, before)
 		if err != nil {
-			return charges, Error.Wrap(err)
+			return charges, err
 		}
+		charges = append(charges, charge)
+	}
 
-		projectPrice := accounts.service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.ObjectCount)
+	return charges, nil
+}
 
-		charges = append(charges, payments.ProjectCharge{
-			ProjectUsage: *usage,
+// ProjectCharge returns how much money will be charged for the project.
+func (accounts *accounts) ProjectCharge(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ payments.ProjectCharge, err error) {
+	defer mon.Task()(&ctx, projectID, since, before)(&err)
 
-			ProjectID:    project.ID,
-			Egress:       projectPrice.Egress.IntPart(),
-			ObjectCount:  projectPrice.Objects.IntPart(),
-			StorageGbHrs: projectPrice.Storage.IntPart(),
-		})
+	usage, err := accounts.service.usageDB.GetProjectTotal(ctx, projectID, since, before)
+	if err != nil {
+		return payments.ProjectCharge{}, Error.Wrap(err)
 	}
 
-	return charges, nil
+	projectPrice := accounts.service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.ObjectCount)
+
+	return payments.ProjectCharge{
+		ProjectUsage: *usage,
+
+		ProjectID:    projectID,
+		Egress:       projectPrice.Egress.IntPart(),
+		ObjectCount:  projectPrice.Objects.IntPart(),
+		StorageGbHrs: projectPrice.Storage.IntPart(),
+	}, nil
 }
 
 // CheckProjectInvoicingStatus returns true if for the given project there are outstanding project records and/or usage
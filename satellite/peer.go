@@ -31,6 +31,8 @@ import (
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
+	"storj.io/storj/satellite/metainfo/bucketnotifications"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/nodeapiversion"
@@ -127,6 +129,9 @@ type Config struct {
 	GarbageCollection gc.Config
 
 	ExpiredDeletion expireddeletion.Config
+	BucketLifecycle bucketlifecycle.Config
+
+	BucketNotifications bucketnotifications.Config
 
 	Tally            tally.Config
 	Rollup           rollup.Config
@@ -0,0 +1,129 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package placement defines the constraints on the location of the nodes storing
+// the pieces of a segment.
+package placement
+
+import (
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// Error represents a placement error.
+var Error = errs.Class("placement")
+
+// Placement is a constraint on the location of the nodes storing the pieces of a segment.
+type Placement int
+
+const (
+	// EveryCountry allows nodes from any country, including nodes with unknown location.
+	EveryCountry Placement = 0
+	// EU allows only nodes from the member countries of the European Union.
+	EU Placement = 1
+	// EEA allows only nodes from the countries of the European Economic Area.
+	EEA Placement = 2
+	// US allows only nodes from the United States.
+	US Placement = 3
+	// DE allows only nodes from Germany.
+	DE Placement = 4
+)
+
+// euCountries contains ISO 3166-1 alpha-2 codes of the European Union member states.
+var euCountries = map[string]struct{}{
+	"AT": {}, "BE": {}, "BG": {}, "CY": {}, "CZ": {}, "DE": {}, "DK": {},
+	"EE": {}, "ES": {}, "FI": {}, "FR": {}, "GR": {}, "HR": {}, "HU": {},
+	"IE": {}, "IT": {}, "LT": {}, "LU": {}, "LV": {}, "MT": {}, "NL": {},
+	"PL": {}, "PT": {}, "RO": {}, "SE": {}, "SI": {}, "SK": {},
+}
+
+// eeaNonEUCountries contains countries which are part of the European Economic Area, but not of the European Union.
+var eeaNonEUCountries = map[string]struct{}{
+	"IS": {}, "LI": {}, "NO": {},
+}
+
+// AllowedCountry checks whether a node with the country code can store pieces with this placement.
+// The country code is an ISO 3166-1 alpha-2 code, empty when the location of the node is unknown.
+func (placement Placement) AllowedCountry(countryCode string) bool {
+	countryCode = strings.ToUpper(countryCode)
+
+	switch placement {
+	case EveryCountry:
+		return true
+	case EU:
+		_, ok := euCountries[countryCode]
+		return ok
+	case EEA:
+		if _, ok := euCountries[countryCode]; ok {
+			return true
+		}
+		_, ok := eeaNonEUCountries[countryCode]
+		return ok
+	case US:
+		return countryCode == "US"
+	case DE:
+		return countryCode == "DE"
+	default:
+		return false
+	}
+}
+
+// Countries returns the country codes allowed by the placement, nil when all countries are allowed.
+func (placement Placement) Countries() []string {
+	switch placement {
+	case EveryCountry:
+		return nil
+	case EU:
+		return countryCodes(euCountries)
+	case EEA:
+		return append(countryCodes(euCountries), countryCodes(eeaNonEUCountries)...)
+	case US:
+		return []string{"US"}
+	case DE:
+		return []string{"DE"}
+	default:
+		return []string{}
+	}
+}
+
+// Valid returns whether the placement is one of the known placements.
+func (placement Placement) Valid() bool {
+	return placement >= EveryCountry && placement <= DE
+}
+
+// String returns a string representation of the placement.
+func (placement Placement) String() string {
+	switch placement {
+	case EveryCountry:
+		return "every-country"
+	case EU:
+		return "eu"
+	case EEA:
+		return "eea"
+	case US:
+		return "us"
+	case DE:
+		return "de"
+	default:
+		return "unknown"
+	}
+}
+
+// Parse parses the string representation of a placement.
+func Parse(s string) (Placement, error) {
+	for _, placement := range []Placement{EveryCountry, EU, EEA, US, DE} {
+		if strings.EqualFold(s, placement.String()) {
+			return placement, nil
+		}
+	}
+	return EveryCountry, Error.New("unknown placement %q", s)
+}
+
+func countryCodes(countries map[string]struct{}) []string {
+	codes := make([]string, 0, len(countries))
+	for code := range countries {
+		codes = append(codes, code)
+	}
+	return codes
+}
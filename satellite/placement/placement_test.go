@@ -0,0 +1,50 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package placement_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/placement"
+)
+
+func TestPlacement(t *testing.T) {
+	require.True(t, placement.EveryCountry.AllowedCountry(""))
+	require.True(t, placement.EveryCountry.AllowedCountry("US"))
+	require.Nil(t, placement.EveryCountry.Countries())
+
+	require.True(t, placement.EU.AllowedCountry("DE"))
+	require.True(t, placement.EU.AllowedCountry("fr"))
+	require.False(t, placement.EU.AllowedCountry("NO"))
+	require.False(t, placement.EU.AllowedCountry(""))
+	require.Len(t, placement.EU.Countries(), 27)
+
+	require.True(t, placement.EEA.AllowedCountry("NO"))
+	require.True(t, placement.EEA.AllowedCountry("DE"))
+	require.False(t, placement.EEA.AllowedCountry("CH"))
+	require.Len(t, placement.EEA.Countries(), 30)
+
+	require.True(t, placement.US.AllowedCountry("US"))
+	require.False(t, placement.US.AllowedCountry("CA"))
+
+	require.True(t, placement.DE.AllowedCountry("DE"))
+	require.False(t, placement.DE.AllowedCountry("AT"))
+
+	require.False(t, placement.Placement(100).AllowedCountry("US"))
+	require.Empty(t, placement.Placement(100).Countries())
+
+	for _, constraint := range []placement.Placement{
+		placement.EveryCountry, placement.EU, placement.EEA,
+		placement.US, placement.DE,
+	} {
+		parsed, err := placement.Parse(constraint.String())
+		require.NoError(t, err)
+		require.Equal(t, constraint, parsed)
+	}
+
+	_, err := placement.Parse("mars")
+	require.Error(t, err)
+}
@@ -42,6 +42,7 @@ type Checker struct {
 	statsCollector  *statsCollector
 	repairOverrides RepairOverridesMap
 	nodeFailureRate float64
+	clusterLimits   repair.ClusterLimits
 	Loop            *sync2.Cycle
 }
 
@@ -57,6 +58,7 @@ func NewChecker(logger *zap.Logger, repairQueue queue.RepairQueue, metabase *met
 		statsCollector:  newStatsCollector(),
 		repairOverrides: config.RepairOverrides.GetMap(),
 		nodeFailureRate: config.NodeFailureRate,
+		clusterLimits:   config.ClusterLimits,
 
 		Loop: sync2.NewCycle(config.Interval),
 	}
@@ -115,6 +117,7 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 		monStats:         aggregateStats{},
 		repairOverrides:  checker.repairOverrides,
 		nodeFailureRate:  checker.nodeFailureRate,
+		clusterLimits:    checker.clusterLimits,
 		getNodesEstimate: checker.getNodesEstimate,
 		log:              checker.logger,
 	}
@@ -148,6 +151,8 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 	mon.IntVal("remote_segments_over_threshold_5").Observe(observer.monStats.remoteSegmentsOverThreshold[4])   //mon:locked
 	mon.IntVal("healthy_segments_removed_from_queue").Observe(healthyDeleted)                                  //mon:locked
 
+	mon.IntVal("remote_segments_clustered").Observe(observer.monStats.remoteSegmentsClustered)
+
 	allUnhealthy := observer.monStats.remoteSegmentsNeedingRepair + observer.monStats.remoteSegmentsFailedToCheck
 	allChecked := observer.monStats.remoteSegmentsChecked
 	allHealthy := allChecked - allUnhealthy
@@ -168,6 +173,7 @@ type checkerObserver struct {
 	monStats         aggregateStats // TODO(cam): once we verify statsCollector reports data correctly, remove this
 	repairOverrides  RepairOverridesMap
 	nodeFailureRate  float64
+	clusterLimits    repair.ClusterLimits
 	getNodesEstimate func(ctx context.Context) (int, error)
 	log              *zap.Logger
 
@@ -257,6 +263,13 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 		return errs.Combine(Error.New("error getting missing pieces"), err)
 	}
 
+	clusteredPieces, err := obs.nodestate.ClusteredPieces(ctx, segment.CreatedAt, segment.Pieces, segment.Placement, obs.clusterLimits)
+	if err != nil {
+		obs.monStats.remoteSegmentsFailedToCheck++
+		stats.iterationAggregates.remoteSegmentsFailedToCheck++
+		return errs.Combine(Error.New("error getting clustered pieces"), err)
+	}
+
 	numHealthy := len(pieces) - len(missingPieces)
 	mon.IntVal("checker_segment_total_count").Observe(int64(len(pieces))) //mon:locked
 	stats.segmentTotalCount.Observe(int64(len(pieces)))
@@ -269,6 +282,9 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 
 	required, repairThreshold, successThreshold, _ := obs.loadRedundancy(segment.Redundancy)
 
+	// pieces exceeding the cluster limits may be lost all at once with their network or country
+	numUnclustered := numHealthy - len(repair.ReplaceableClustered(numHealthy, clusteredPieces, required))
+
 	segmentHealth := repair.SegmentHealth(numHealthy, required, totalNumNodes, obs.nodeFailureRate)
 	mon.FloatVal("checker_segment_health").Observe(segmentHealth) //mon:locked
 	stats.segmentHealth.Observe(segmentHealth)
@@ -276,7 +292,17 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 	// we repair when the number of healthy pieces is less than or equal to the repair threshold and is greater or equal to
 	// minimum required pieces in redundancy
 	// except for the case when the repair and success thresholds are the same (a case usually seen during testing)
-	if numHealthy <= repairThreshold && numHealthy < successThreshold {
+	// segments which need repair only because of clustering are queued with the
+	// health of all their healthy pieces, so they are repaired after the segments
+	// which actually lost pieces.
+	injured := numHealthy <= repairThreshold && numHealthy < successThreshold
+	clustered := numUnclustered <= repairThreshold && numUnclustered < successThreshold
+	if clustered && !injured {
+		obs.monStats.remoteSegmentsClustered++
+		stats.iterationAggregates.remoteSegmentsClustered++
+	}
+
+	if injured || clustered {
 		mon.FloatVal("checker_injured_segment_health").Observe(segmentHealth) //mon:locked
 		stats.injuredSegmentHealth.Observe(segmentHealth)
 		obs.monStats.remoteSegmentsNeedingRepair++
@@ -286,6 +312,7 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 			Position:      segment.Position,
 			UpdatedAt:     time.Now().UTC(),
 			SegmentHealth: segmentHealth,
+			Placement:     segment.Placement,
 		})
 		if err != nil {
 			obs.log.Error("error adding injured segment to queue", zap.Error(err))
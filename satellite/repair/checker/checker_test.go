@@ -10,12 +10,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -83,6 +85,49 @@ func TestIdentifyInjuredSegments(t *testing.T) {
 	})
 }
 
+func TestIdentifyClusteredSegments(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				// all the storage nodes of testplanet are in the same network
+				config.Checker.ClusterLimits.MaxPiecesPerNetwork = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		checker := planet.Satellites[0].Repair.Checker
+		repairQueue := planet.Satellites[0].DB.RepairQueue()
+
+		checker.Loop.Pause()
+		planet.Satellites[0].Repair.Repairer.Loop.Pause()
+
+		rs := storj.RedundancyScheme{
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+			ShareSize:      256,
+		}
+
+		err := planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], "test-bucket")
+		require.NoError(t, err)
+
+		location := metabase.SegmentLocation{
+			ProjectID:  planet.Uplinks[0].Projects[0].ID,
+			BucketName: "test-bucket",
+			ObjectKey:  metabase.ObjectKey("clustered"),
+		}
+		streamID := insertSegment(ctx, t, planet, rs, location, createPieces(planet, rs), nil)
+
+		checker.Loop.TriggerWait()
+
+		// all pieces are healthy, but only two of them are in distinct locations
+		injuredSegment, err := repairQueue.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, streamID, injuredSegment.StreamID)
+	})
+}
+
 func TestIdentifyIrreparableSegments(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 3, UplinkCount: 1,
@@ -210,7 +255,7 @@ func TestCleanRepairQueue(t *testing.T) {
 
 		// suspend enough nodes to make healthy pointers unhealthy
 		for i := rs.RequiredShares; i < rs.OptimalShares; i++ {
-			require.NoError(t, planet.Satellites[0].Overlay.DB.TestSuspendNodeUnknownAudit(ctx, planet.StorageNodes[i].ID(), time.Now()))
+			require.NoError(t, planet.Satellites[0].Overlay.DB.SuspendNodeUnknownAudit(ctx, planet.StorageNodes[i].ID(), time.Now()))
 		}
 
 		require.NoError(t, planet.Satellites[0].Repair.Checker.RefreshReliabilityCache(ctx))
@@ -230,7 +275,7 @@ func TestCleanRepairQueue(t *testing.T) {
 
 		// unsuspend nodes to make the previously healthy pointers healthy again
 		for i := rs.RequiredShares; i < rs.OptimalShares; i++ {
-			require.NoError(t, planet.Satellites[0].Overlay.DB.TestUnsuspendNodeUnknownAudit(ctx, planet.StorageNodes[i].ID()))
+			require.NoError(t, planet.Satellites[0].Overlay.DB.UnsuspendNodeUnknownAudit(ctx, planet.StorageNodes[i].ID()))
 		}
 
 		require.NoError(t, planet.Satellites[0].Repair.Checker.RefreshReliabilityCache(ctx))
@@ -11,6 +11,7 @@ import (
 
 	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/storj/satellite/repair"
 )
 
 // Config contains configurable values for checker.
@@ -22,6 +23,8 @@ type Config struct {
 	// Node failure rate is an estimation based on a 6 hour checker run interval (4 checker iterations per day), a network of about 9200 nodes, and about 2 nodes churning per day.
 	// This results in `2/9200/4 = 0.00005435` being the probability of any single node going down in the interval of one checker iteration.
 	NodeFailureRate float64 `help:"the probability of a single node going down within the next checker iteration" default:"0.00005435" `
+	// ClusterLimits are shared with the repairer, which moves the surplus pieces to other nodes.
+	ClusterLimits repair.ClusterLimits
 }
 
 // RepairOverride is a configuration struct that contains an override repair
@@ -12,6 +12,8 @@ import (
 	"storj.io/common/storj"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/placement"
+	"storj.io/storj/satellite/repair"
 )
 
 // ReliabilityCache caches the reliable nodes for the specified staleness duration
@@ -27,7 +29,7 @@ type ReliabilityCache struct {
 
 // reliabilityState.
 type reliabilityState struct {
-	reliable map[storj.NodeID]struct{}
+	reliable map[storj.NodeID]repair.NodeLocation
 	created  time.Time
 }
 
@@ -79,6 +81,21 @@ func (cache *ReliabilityCache) MissingPieces(ctx context.Context, created time.T
 	return unreliable, nil
 }
 
+// ClusteredPieces returns the reliable pieces exceeding the limits of pieces per network or country.
+func (cache *ReliabilityCache) ClusteredPieces(ctx context.Context, created time.Time, pieces metabase.Pieces, placement placement.Placement, limits repair.ClusterLimits) (_ metabase.Pieces, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !limits.Enabled() {
+		return nil, nil
+	}
+
+	state, err := cache.loadFast(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+	return limits.ClusteredPieces(pieces, state.reliable, placement), nil
+}
+
 func (cache *ReliabilityCache) loadFast(ctx context.Context, validUpTo time.Time) (_ *reliabilityState, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -127,10 +144,13 @@ func (cache *ReliabilityCache) refreshLocked(ctx context.Context) (_ *reliabilit
 
 	state := &reliabilityState{
 		created:  time.Now(),
-		reliable: make(map[storj.NodeID]struct{}, len(nodes)),
+		reliable: make(map[storj.NodeID]repair.NodeLocation, len(nodes)),
 	}
-	for _, id := range nodes {
-		state.reliable[id] = struct{}{}
+	for _, node := range nodes {
+		state.reliable[node.ID] = repair.NodeLocation{
+			LastNet:     node.LastNet,
+			CountryCode: node.CountryCode,
+		}
 	}
 
 	cache.state.Store(state)
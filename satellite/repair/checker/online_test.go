@@ -11,11 +11,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
-	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/placement"
+	"storj.io/storj/satellite/repair"
 )
 
 func TestReliabilityCache_Concurrent(t *testing.T) {
@@ -42,13 +43,53 @@ func TestReliabilityCache_Concurrent(t *testing.T) {
 	ctx.Wait()
 }
 
-type fakeOverlayDB struct{ overlay.DB }
+func TestReliabilityCache_ClusteredPieces(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	nodes := []*overlay.SelectedNode{
+		{ID: testrand.NodeID(), LastNet: "10.0.0.0", CountryCode: "DE"},
+		{ID: testrand.NodeID(), LastNet: "10.0.0.0", CountryCode: "DE"},
+		{ID: testrand.NodeID(), LastNet: "10.0.1.0", CountryCode: "DE"},
+	}
+
+	ocache, err := overlay.NewService(zap.NewNop(), fakeOverlayDB{nodes: nodes}, overlay.Config{})
+	require.NoError(t, err)
+	rcache := NewReliabilityCache(ocache, time.Minute)
+
+	pieces := metabase.Pieces{
+		{Number: 0, StorageNode: nodes[0].ID},
+		{Number: 1, StorageNode: nodes[1].ID},
+		{Number: 2, StorageNode: nodes[2].ID},
+		{Number: 3, StorageNode: testrand.NodeID()},
+	}
+
+	clustered, err := rcache.ClusteredPieces(ctx, time.Now(), pieces, placement.EveryCountry, repair.ClusterLimits{})
+	require.NoError(t, err)
+	require.Empty(t, clustered)
+
+	clustered, err = rcache.ClusteredPieces(ctx, time.Now(), pieces, placement.EveryCountry, repair.ClusterLimits{MaxPiecesPerNetwork: 1})
+	require.NoError(t, err)
+	require.Equal(t, metabase.Pieces{pieces[1]}, clustered)
 
-func (fakeOverlayDB) Reliable(context.Context, *overlay.NodeCriteria) (storj.NodeIDList, error) {
-	return storj.NodeIDList{
-		testrand.NodeID(),
-		testrand.NodeID(),
-		testrand.NodeID(),
-		testrand.NodeID(),
+	clustered, err = rcache.ClusteredPieces(ctx, time.Now(), pieces, placement.EveryCountry, repair.ClusterLimits{MaxPiecesPerCountry: 1})
+	require.NoError(t, err)
+	require.Equal(t, metabase.Pieces{pieces[1], pieces[2]}, clustered)
+}
+
+type fakeOverlayDB struct {
+	overlay.DB
+	nodes []*overlay.SelectedNode
+}
+
+func (db fakeOverlayDB) Reliable(context.Context, *overlay.NodeCriteria) ([]*overlay.SelectedNode, error) {
+	if db.nodes != nil {
+		return db.nodes, nil
+	}
+	return []*overlay.SelectedNode{
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
 	}, nil
 }
@@ -0,0 +1,99 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repair
+
+import (
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/placement"
+)
+
+// ClusterLimits contains the maximum number of pieces of a segment, which can be
+// stored in the same network or country. Losing a single network or country
+// can lose all the pieces stored there, so the pieces exceeding the limits are
+// considered to be at risk.
+type ClusterLimits struct {
+	MaxPiecesPerNetwork int `help:"maximum number of pieces of a segment stored in the same /24 (IPv4) or /64 (IPv6) network before the surplus pieces are considered at risk, 0 means no limit" default:"0"`
+	MaxPiecesPerCountry int `help:"maximum number of pieces of a segment stored in the same country before the surplus pieces are considered at risk, 0 means no limit" default:"0"`
+}
+
+// Enabled returns whether any of the limits is set.
+func (limits ClusterLimits) Enabled() bool {
+	return limits.MaxPiecesPerNetwork > 0 || limits.MaxPiecesPerCountry > 0
+}
+
+// NodeLocation describes where the node storing a piece is located.
+type NodeLocation struct {
+	LastNet     string
+	CountryCode string
+}
+
+// ClusteredPieces returns the pieces exceeding the limits of pieces per network or country.
+//
+// Pieces are kept in the order of the piece numbers, the pieces after the limit
+// was reached are returned. Pieces stored on nodes missing from the locations
+// are ignored, they are expected to be handled as missing pieces. Pieces on nodes
+// with an unknown network or country are not limited by that criteria.
+//
+// The placement restricts the countries the pieces can be stored in, the limit of
+// pieces per country is raised to what can be achieved within those countries.
+func (limits ClusterLimits) ClusteredPieces(pieces metabase.Pieces, locations map[storj.NodeID]NodeLocation, placement placement.Placement) metabase.Pieces {
+	if !limits.Enabled() {
+		return nil
+	}
+
+	maxPerCountry := limits.MaxPiecesPerCountry
+	if countries := len(placement.Countries()); maxPerCountry > 0 && countries > 0 {
+		located := 0
+		for _, piece := range pieces {
+			if location, ok := locations[piece.StorageNode]; ok && location.CountryCode != "" {
+				located++
+			}
+		}
+		if achievable := (located + countries - 1) / countries; achievable > maxPerCountry {
+			maxPerCountry = achievable
+		}
+	}
+
+	perNetwork := map[string]int{}
+	perCountry := map[string]int{}
+
+	var clustered metabase.Pieces
+	for _, piece := range pieces {
+		location, ok := locations[piece.StorageNode]
+		if !ok {
+			continue
+		}
+
+		if limits.MaxPiecesPerNetwork > 0 && location.LastNet != "" && perNetwork[location.LastNet] >= limits.MaxPiecesPerNetwork {
+			clustered = append(clustered, piece)
+			continue
+		}
+		if maxPerCountry > 0 && location.CountryCode != "" && perCountry[location.CountryCode] >= maxPerCountry {
+			clustered = append(clustered, piece)
+			continue
+		}
+
+		if location.LastNet != "" {
+			perNetwork[location.LastNet]++
+		}
+		if location.CountryCode != "" {
+			perCountry[location.CountryCode]++
+		}
+	}
+	return clustered
+}
+
+// ReplaceableClustered returns the clustered pieces, which repair can replace.
+//
+// The repairer doesn't download the clustered pieces, so they are only replaced
+// when the remaining healthy pieces are enough to reconstruct the segment. The
+// checker and the repairer both use it, otherwise the checker could queue
+// segments which the repairer considers healthy.
+func ReplaceableClustered(numHealthy int, clustered metabase.Pieces, requiredShares int) metabase.Pieces {
+	if numHealthy-len(clustered) < requiredShares {
+		return nil
+	}
+	return clustered
+}
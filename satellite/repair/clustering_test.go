@@ -0,0 +1,73 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repair_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/placement"
+	"storj.io/storj/satellite/repair"
+)
+
+func TestClusteredPieces(t *testing.T) {
+	locations := []repair.NodeLocation{
+		{LastNet: "10.0.0.0", CountryCode: "DE"},
+		{LastNet: "10.0.0.0", CountryCode: "DE"},
+		{LastNet: "10.0.1.0", CountryCode: "DE"},
+		{LastNet: "10.0.2.0", CountryCode: "US"},
+		{LastNet: "10.0.2.0", CountryCode: "US"},
+		{LastNet: "", CountryCode: ""},
+		{LastNet: "", CountryCode: ""},
+	}
+
+	pieces := metabase.Pieces{}
+	nodes := map[storj.NodeID]repair.NodeLocation{}
+	for i, location := range locations {
+		piece := metabase.Piece{Number: uint16(i), StorageNode: testrand.NodeID()}
+		pieces = append(pieces, piece)
+		nodes[piece.StorageNode] = location
+	}
+	// piece on a node that isn't reliable anymore
+	pieces = append(pieces, metabase.Piece{Number: uint16(len(pieces)), StorageNode: testrand.NodeID()})
+
+	numbers := func(pieces metabase.Pieces) []uint16 {
+		var xs []uint16
+		for _, piece := range pieces {
+			xs = append(xs, piece.Number)
+		}
+		return xs
+	}
+
+	for _, tt := range []struct {
+		limits    repair.ClusterLimits
+		placement placement.Placement
+		clustered []uint16
+	}{
+		{limits: repair.ClusterLimits{}, clustered: nil},
+		{limits: repair.ClusterLimits{MaxPiecesPerNetwork: 1}, clustered: []uint16{1, 4}},
+		{limits: repair.ClusterLimits{MaxPiecesPerNetwork: 2}, clustered: nil},
+		{limits: repair.ClusterLimits{MaxPiecesPerCountry: 2}, clustered: []uint16{2}},
+		{limits: repair.ClusterLimits{MaxPiecesPerCountry: 1}, clustered: []uint16{1, 2, 4}},
+		{limits: repair.ClusterLimits{MaxPiecesPerNetwork: 1, MaxPiecesPerCountry: 2}, clustered: []uint16{1, 4}},
+		// a single country placement can't spread the pieces over countries
+		{limits: repair.ClusterLimits{MaxPiecesPerCountry: 1}, placement: placement.DE, clustered: nil},
+		{limits: repair.ClusterLimits{MaxPiecesPerNetwork: 1, MaxPiecesPerCountry: 1}, placement: placement.US, clustered: []uint16{1, 4}},
+	} {
+		require.Equal(t, tt.clustered, numbers(tt.limits.ClusteredPieces(pieces, nodes, tt.placement)), "%+v %v", tt.limits, tt.placement)
+	}
+}
+
+func TestReplaceableClustered(t *testing.T) {
+	clustered := metabase.Pieces{{Number: 1}, {Number: 2}}
+
+	require.Equal(t, clustered, repair.ReplaceableClustered(7, clustered, 4))
+	require.Equal(t, clustered, repair.ReplaceableClustered(6, clustered, 4))
+	// the clustered pieces are needed to reconstruct the segment
+	require.Nil(t, repair.ReplaceableClustered(5, clustered, 4))
+}
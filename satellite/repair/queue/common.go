@@ -7,5 +7,9 @@ import (
 	"github.com/zeebo/errs"
 )
 
-// Error is a standard error class for this package.
-var Error = errs.Class("repair queue")
+var (
+	// Error is a standard error class for this package.
+	Error = errs.Class("repair queue")
+	// ErrSegmentNotFound is returned when the segment is not in the repair queue.
+	ErrSegmentNotFound = errs.Class("segment not found in repair queue")
+)
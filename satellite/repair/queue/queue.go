@@ -9,6 +9,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/placement"
 )
 
 // InjuredSegment contains information about segment which
@@ -21,6 +22,39 @@ type InjuredSegment struct {
 	AttemptedAt   *time.Time
 	UpdatedAt     time.Time
 	InsertedAt    time.Time
+
+	// Placement is the placement of the segment, the repaired pieces must be stored
+	// on nodes allowed by it.
+	Placement placement.Placement
+}
+
+// Filter limits which injured segments are selected or listed.
+type Filter struct {
+	// Placements matches the segments with one of the placements, all placements match when empty.
+	Placements []placement.Placement
+	// StreamID matches the segments of a single stream, all streams match when zero.
+	StreamID uuid.UUID
+}
+
+// ListCursor is the position in the queue, after which the listing continues.
+type ListCursor struct {
+	SegmentHealth float64
+	StreamID      uuid.UUID
+	Position      metabase.SegmentPosition
+}
+
+// ListOptions contains the arguments for listing injured segments.
+type ListOptions struct {
+	Filter Filter
+	// Cursor is the last segment of the previous page, nil for the first page.
+	Cursor *ListCursor
+	Limit  int
+}
+
+// ListResult contains a page of injured segments.
+type ListResult struct {
+	Segments []InjuredSegment
+	More     bool
 }
 
 // RepairQueue implements queueing for segments that need repairing.
@@ -30,8 +64,17 @@ type InjuredSegment struct {
 type RepairQueue interface {
 	// Insert adds an injured segment.
 	Insert(ctx context.Context, s *InjuredSegment) (alreadyInserted bool, err error)
-	// Select gets an injured segment.
+	// Select gets the injured segment with the lowest health.
 	Select(ctx context.Context) (*InjuredSegment, error)
+	// SelectFiltered gets the injured segment with the lowest health matching the filter.
+	SelectFiltered(ctx context.Context, filter Filter) (*InjuredSegment, error)
+	// Get returns the injured segment at the position.
+	Get(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition) (*InjuredSegment, error)
+	// List lists the injured segments in the order they are selected for repair.
+	List(ctx context.Context, opts ListOptions) (ListResult, error)
+	// UpdateSegmentHealth changes the health of an injured segment, which determines
+	// its priority in the queue. Inserting the segment again doesn't change the overridden health.
+	UpdateSegmentHealth(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, segmentHealth float64) error
 	// Delete removes an injured segment.
 	Delete(ctx context.Context, s *InjuredSegment) error
 	// Clean removes all segments last updated before a certain time
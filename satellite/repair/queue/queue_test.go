@@ -16,6 +16,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/placement"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 	"storj.io/storj/storage"
@@ -254,3 +255,159 @@ func TestClean(t *testing.T) {
 		require.Equal(t, 0, count)
 	})
 }
+
+func TestSelectFiltered(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		q := db.RepairQueue()
+
+		everywhere := &queue.InjuredSegment{
+			StreamID:      testrand.UUID(),
+			SegmentHealth: 1,
+		}
+		eu := &queue.InjuredSegment{
+			StreamID:      testrand.UUID(),
+			SegmentHealth: 2,
+			Placement:     placement.EU,
+		}
+		us := &queue.InjuredSegment{
+			StreamID:      testrand.UUID(),
+			SegmentHealth: 3,
+			Placement:     placement.US,
+		}
+		for _, seg := range []*queue.InjuredSegment{everywhere, eu, us} {
+			_, err := q.Insert(ctx, seg)
+			require.NoError(t, err)
+		}
+
+		s, err := q.SelectFiltered(ctx, queue.Filter{
+			Placements: []placement.Placement{placement.US},
+		})
+		require.NoError(t, err)
+		require.Equal(t, us.StreamID, s.StreamID)
+		require.Equal(t, placement.US, s.Placement)
+
+		s, err = q.SelectFiltered(ctx, queue.Filter{StreamID: eu.StreamID})
+		require.NoError(t, err)
+		require.Equal(t, eu.StreamID, s.StreamID)
+		require.Equal(t, placement.EU, s.Placement)
+
+		_, err = q.SelectFiltered(ctx, queue.Filter{
+			Placements: []placement.Placement{placement.EU, placement.US},
+		})
+		require.True(t, storage.ErrEmptyQueue.Has(err), "error should of class EmptyQueue")
+
+		s, err = q.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, everywhere.StreamID, s.StreamID)
+	})
+}
+
+func TestList(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		q := db.RepairQueue()
+
+		const N = 10
+		var expected []queue.InjuredSegment
+		for i := 0; i < N; i++ {
+			seg := queue.InjuredSegment{
+				StreamID:      testrand.UUID(),
+				Position:      metabase.SegmentPosition{Index: uint32(i)},
+				SegmentHealth: float64(N - i),
+				Placement:     placement.Placement(i % 2),
+			}
+			_, err := q.Insert(ctx, &seg)
+			require.NoError(t, err)
+			expected = append(expected, seg)
+		}
+		sort.Slice(expected, func(i, k int) bool {
+			return expected[i].SegmentHealth < expected[k].SegmentHealth
+		})
+
+		var listed []queue.InjuredSegment
+		opts := queue.ListOptions{Limit: 3}
+		for {
+			result, err := q.List(ctx, opts)
+			require.NoError(t, err)
+			listed = append(listed, result.Segments...)
+			if !result.More {
+				break
+			}
+			require.Len(t, result.Segments, 3)
+
+			last := result.Segments[len(result.Segments)-1]
+			opts.Cursor = &queue.ListCursor{
+				SegmentHealth: last.SegmentHealth,
+				StreamID:      last.StreamID,
+				Position:      last.Position,
+			}
+		}
+
+		require.Len(t, listed, N)
+		for i := range expected {
+			require.Equal(t, expected[i].StreamID, listed[i].StreamID)
+			require.Equal(t, expected[i].Position, listed[i].Position)
+			require.Equal(t, expected[i].SegmentHealth, listed[i].SegmentHealth)
+			require.Equal(t, expected[i].Placement, listed[i].Placement)
+		}
+
+		result, err := q.List(ctx, queue.ListOptions{
+			Filter: queue.Filter{Placements: []placement.Placement{placement.EU}},
+		})
+		require.NoError(t, err)
+		require.False(t, result.More)
+		require.Len(t, result.Segments, N/2)
+		for _, seg := range result.Segments {
+			require.Equal(t, placement.EU, seg.Placement)
+		}
+	})
+}
+
+func TestGetUpdateSegmentHealth(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		q := db.RepairQueue()
+
+		first := &queue.InjuredSegment{
+			StreamID:      testrand.UUID(),
+			SegmentHealth: 1,
+		}
+		second := &queue.InjuredSegment{
+			StreamID:      testrand.UUID(),
+			Position:      metabase.SegmentPosition{Part: 1, Index: 2},
+			SegmentHealth: 2,
+		}
+		for _, seg := range []*queue.InjuredSegment{first, second} {
+			_, err := q.Insert(ctx, seg)
+			require.NoError(t, err)
+		}
+
+		err := q.UpdateSegmentHealth(ctx, second.StreamID, second.Position, 0.5)
+		require.NoError(t, err)
+
+		s, err := q.Get(ctx, second.StreamID, second.Position)
+		require.NoError(t, err)
+		require.Equal(t, second.StreamID, s.StreamID)
+		require.Equal(t, second.Position, s.Position)
+		require.Equal(t, 0.5, s.SegmentHealth)
+
+		// the checker doesn't change the overridden health
+		alreadyInserted, err := q.Insert(ctx, second)
+		require.NoError(t, err)
+		require.True(t, alreadyInserted)
+
+		s, err = q.Get(ctx, second.StreamID, second.Position)
+		require.NoError(t, err)
+		require.Equal(t, 0.5, s.SegmentHealth)
+
+		// the reprioritized segment is selected first
+		s, err = q.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, second.StreamID, s.StreamID)
+
+		missing := testrand.UUID()
+		_, err = q.Get(ctx, missing, metabase.SegmentPosition{})
+		require.True(t, queue.ErrSegmentNotFound.Has(err))
+
+		err = q.UpdateSegmentHealth(ctx, missing, metabase.SegmentPosition{}, 0)
+		require.True(t, queue.ErrSegmentNotFound.Has(err))
+	})
+}
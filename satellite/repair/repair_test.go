@@ -989,7 +989,7 @@ func testRepairMultipleDisqualifiedAndSuspended(t *testing.T, inMemoryRepair boo
 		}
 		for i := toDisqualify; i < toDisqualify+toSuspend; i++ {
 			nodesToSuspend[remotePieces[i].StorageNode] = true
-			err := satellite.DB.OverlayCache().TestSuspendNodeUnknownAudit(ctx, remotePieces[i].StorageNode, time.Now())
+			err := satellite.DB.OverlayCache().SuspendNodeUnknownAudit(ctx, remotePieces[i].StorageNode, time.Now())
 			require.NoError(t, err)
 		}
 		for i := toDisqualify + toSuspend; i < len(remotePieces); i++ {
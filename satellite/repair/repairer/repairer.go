@@ -5,6 +5,7 @@ package repairer
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -14,6 +15,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/sync2"
+	"storj.io/storj/satellite/placement"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/storage"
 )
@@ -34,6 +36,39 @@ type Config struct {
 	MaxBufferMem                  memory.Size   `help:"maximum buffer memory (in bytes) to be allocated for read buffers" default:"4.0 MiB"`
 	MaxExcessRateOptimalThreshold float64       `help:"ratio applied to the optimal threshold to calculate the excess of the maximum number of repaired pieces to upload" default:"0.05"`
 	InMemoryRepair                bool          `help:"whether to download pieces for repair in memory (true) or download to disk (false)" default:"false"`
+	Placements                    Placements    `help:"comma-separated placements of the segments to repair, segments of all placements are repaired when empty" default:""`
+}
+
+// Placements is a list of placements, which can be configured as a comma-separated flag.
+type Placements []placement.Placement
+
+// Type implements pflag.Value.
+func (Placements) Type() string { return "repairer.Placements" }
+
+// String returns the comma-separated placements.
+func (placements *Placements) String() string {
+	names := make([]string, len(*placements))
+	for i, placement := range *placements {
+		names[i] = placement.String()
+	}
+	return strings.Join(names, ",")
+}
+
+// Set sets the value from a comma-separated list of placements.
+func (placements *Placements) Set(s string) error {
+	*placements = nil
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		placement, err := placement.Parse(name)
+		if err != nil {
+			return err
+		}
+		*placements = append(*placements, placement)
+	}
+	return nil
 }
 
 // Service contains the information needed to run the repair service.
@@ -127,7 +162,9 @@ func (service *Service) process(ctx context.Context) (err error) {
 	// return from service.Run when queue fetch fails.
 	ctx, cancel := context.WithTimeout(ctx, service.config.TotalTimeout)
 
-	seg, err := service.queue.Select(ctx)
+	seg, err := service.queue.SelectFiltered(ctx, queue.Filter{
+		Placements: service.config.Placements,
+	})
 	if err != nil {
 		service.JobLimiter.Release(1)
 		cancel()
@@ -20,6 +20,8 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/placement"
+	"storj.io/storj/satellite/repair"
 	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/reputation"
@@ -67,6 +69,9 @@ type SegmentRepairer struct {
 	// repairOverrides is the set of values configured by the checker to override the repair threshold for various RS schemes.
 	repairOverrides checker.RepairOverridesMap
 
+	// clusterLimits limits how many pieces of a segment can be stored in the same network or country.
+	clusterLimits repair.ClusterLimits
+
 	nowFn func() time.Time
 }
 
@@ -79,8 +84,8 @@ func NewSegmentRepairer(
 	log *zap.Logger, metabase *metabase.DB, orders *orders.Service,
 	overlay *overlay.Service, reputation *reputation.Service, dialer rpc.Dialer,
 	timeout time.Duration, excessOptimalThreshold float64,
-	repairOverrides checker.RepairOverrides, downloadTimeout time.Duration,
-	inMemoryRepair bool, satelliteSignee signing.Signee,
+	repairOverrides checker.RepairOverrides, clusterLimits repair.ClusterLimits,
+	downloadTimeout time.Duration, inMemoryRepair bool, satelliteSignee signing.Signee,
 ) *SegmentRepairer {
 
 	if excessOptimalThreshold < 0 {
@@ -98,6 +103,7 @@ func NewSegmentRepairer(
 		timeout:                    timeout,
 		multiplierOptimalThreshold: 1 + excessOptimalThreshold,
 		repairOverrides:            repairOverrides.GetMap(),
+		clusterLimits:              clusterLimits,
 
 		nowFn: time.Now,
 	}
@@ -187,11 +193,20 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		repairThreshold = overrideValue
 	}
 
+	clusteredPieces, err := repairer.clusteredPieces(ctx, pieces, missingPieces, segment.Placement)
+	if err != nil {
+		return false, overlayQueryError.New("error identifying clustered pieces: %w", err)
+	}
+	// the clustered pieces needed for reconstructing the segment are moved
+	// once there are enough pieces on distinct locations.
+	clusteredPieces = repair.ReplaceableClustered(numHealthy, clusteredPieces, int(segment.Redundancy.RequiredShares))
+	numUnclustered := numHealthy - len(clusteredPieces)
+
 	// repair not needed
-	if numHealthy > int(repairThreshold) {
+	if numUnclustered > int(repairThreshold) {
 		mon.Meter("repair_unnecessary").Mark(1) //mon:locked
 		stats.repairUnnecessary.Mark(1)
-		repairer.log.Debug("segment above repair threshold", zap.Int("numHealthy", numHealthy), zap.Int32("repairThreshold", repairThreshold))
+		repairer.log.Debug("segment above repair threshold", zap.Int("numHealthy", numHealthy), zap.Int("numUnclustered", numUnclustered), zap.Int32("repairThreshold", repairThreshold))
 		return true, nil
 	}
 
@@ -202,7 +217,12 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	mon.FloatVal("healthy_ratio_before_repair").Observe(healthyRatioBeforeRepair) //mon:locked
 	stats.healthyRatioBeforeRepair.Observe(healthyRatioBeforeRepair)
 
+	// clustered pieces are replaced the same way as the lost pieces, their nodes
+	// and with distinct IP also their networks are excluded from the new nodes.
 	lostPiecesSet := sliceToSet(missingPieces)
+	for _, piece := range clusteredPieces {
+		lostPiecesSet[piece.Number] = true
+	}
 
 	var healthyPieces, unhealthyPieces metabase.Pieces
 	healthyMap := make(map[uint16]bool)
@@ -247,6 +267,7 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: requestCount,
 		ExcludedIDs:    excludeNodeIDs,
+		Placement:      segment.Placement,
 	}
 	newNodes, err := repairer.overlay.FindStorageNodesForUpload(ctx, request)
 	if err != nil {
@@ -460,6 +481,40 @@ func (repairer *SegmentRepairer) SetNow(nowFn func() time.Time) {
 	repairer.nowFn = nowFn
 }
 
+// clusteredPieces returns the healthy pieces, which exceed the cluster limits.
+func (repairer *SegmentRepairer) clusteredPieces(ctx context.Context, pieces metabase.Pieces, missingPieces []uint16, placement placement.Placement) (_ metabase.Pieces, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !repairer.clusterLimits.Enabled() {
+		return nil, nil
+	}
+
+	missing := sliceToSet(missingPieces)
+	var healthy metabase.Pieces
+	var nodeIDs []storj.NodeID
+	for _, piece := range pieces {
+		if !missing[piece.Number] {
+			healthy = append(healthy, piece)
+			nodeIDs = append(nodeIDs, piece.StorageNode)
+		}
+	}
+
+	nodes, err := repairer.overlay.GetOnlineNodesForGetDelete(ctx, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make(map[storj.NodeID]repair.NodeLocation, len(nodes))
+	for id, node := range nodes {
+		locations[id] = repair.NodeLocation{
+			LastNet:     node.LastNet,
+			CountryCode: node.CountryCode,
+		}
+	}
+
+	return repairer.clusterLimits.ClusteredPieces(healthy, locations, placement), nil
+}
+
 // sliceToSet converts the given slice to a set.
 func sliceToSet(slice []uint16) map[uint16]bool {
 	set := make(map[uint16]bool, len(slice))
@@ -23,6 +23,8 @@ type DB interface {
 	UnsuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID) (err error)
 	// DisqualifyNode disqualifies a storage node.
 	DisqualifyNode(ctx context.Context, nodeID storj.NodeID) (err error)
+	// ReinstateNode removes the disqualification of a storage node and resets its audit reputation.
+	ReinstateNode(ctx context.Context, nodeID storj.NodeID) (err error)
 	// SuspendNodeUnknownAudit suspends a storage node for unknown audits.
 	SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error)
 	// UpdateAuditHistory updates a node's audit history
@@ -122,18 +124,34 @@ func (service *Service) Get(ctx context.Context, nodeID storj.NodeID) (info *Inf
 	return info, nil
 }
 
-// TestSuspendNodeUnknownAudit suspends a storage node for unknown audits.
-func (service *Service) TestSuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error) {
+// SuspendNodeUnknownAudit suspends a storage node for unknown audits.
+func (service *Service) SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	err = service.db.SuspendNodeUnknownAudit(ctx, nodeID, suspendedAt)
 	if err != nil {
 		return err
 	}
 
-	return service.overlay.TestSuspendNodeUnknownAudit(ctx, nodeID, suspendedAt)
+	return service.overlay.SuspendNodeUnknownAudit(ctx, nodeID, suspendedAt)
 }
 
-// TestDisqualifyNode disqualifies a storage node.
-func (service *Service) TestDisqualifyNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+// UnsuspendNodeUnknownAudit unsuspends a storage node for unknown audits.
+func (service *Service) UnsuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.db.UnsuspendNodeUnknownAudit(ctx, nodeID)
+	if err != nil {
+		return err
+	}
+
+	return service.overlay.UnsuspendNodeUnknownAudit(ctx, nodeID)
+}
+
+// DisqualifyNode disqualifies a storage node.
+func (service *Service) DisqualifyNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	err = service.db.DisqualifyNode(ctx, nodeID)
 	if err != nil {
 		return err
@@ -142,14 +160,33 @@ func (service *Service) TestDisqualifyNode(ctx context.Context, nodeID storj.Nod
 	return service.overlay.DisqualifyNode(ctx, nodeID)
 }
 
-// TestUnsuspendNodeUnknownAudit unsuspends a storage node for unknown audits.
-func (service *Service) TestUnsuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID) (err error) {
-	err = service.db.UnsuspendNodeUnknownAudit(ctx, nodeID)
+// ReinstateNode removes the disqualification of a storage node. The audit
+// reputation of the node is reset, otherwise a node with a low audit score
+// would be disqualified again by its next failed audit.
+func (service *Service) ReinstateNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.db.ReinstateNode(ctx, nodeID)
 	if err != nil {
 		return err
 	}
 
-	return service.overlay.TestUnsuspendNodeUnknownAudit(ctx, nodeID)
+	return service.overlay.ReinstateNode(ctx, nodeID)
+}
+
+// TestSuspendNodeUnknownAudit suspends a storage node for unknown audits.
+func (service *Service) TestSuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error) {
+	return service.SuspendNodeUnknownAudit(ctx, nodeID, suspendedAt)
+}
+
+// TestDisqualifyNode disqualifies a storage node.
+func (service *Service) TestDisqualifyNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+	return service.DisqualifyNode(ctx, nodeID)
+}
+
+// TestUnsuspendNodeUnknownAudit unsuspends a storage node for unknown audits.
+func (service *Service) TestUnsuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID) (err error) {
+	return service.UnsuspendNodeUnknownAudit(ctx, nodeID)
 }
 
 // Close closes resources.
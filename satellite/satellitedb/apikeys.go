@@ -5,6 +5,7 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 
 	"github.com/zeebo/errs"
@@ -70,7 +71,7 @@ func (keys *apikeys) GetPagedByProjectID(ctx context.Context, projectID uuid.UUI
 	}
 
 	repoundQuery := keys.db.Rebind(`
-		SELECT ak.id, ak.project_id, ak.name, ak.partner_id, ak.created_at
+		SELECT ak.id, ak.project_id, ak.name, ak.partner_id, ak.rate_limit, ak.request_quota, ak.restrictions, ak.created_at
 		FROM api_keys ak
 		WHERE ak.project_id = ?
 		AND lower(ak.name) LIKE ?
@@ -94,13 +95,15 @@ func (keys *apikeys) GetPagedByProjectID(ctx context.Context, projectID uuid.UUI
 	for rows.Next() {
 		ak := console.APIKeyInfo{}
 		var partnerID uuid.NullUUID
+		var restrictions sql.NullString
 
-		err = rows.Scan(&ak.ID, &ak.ProjectID, &ak.Name, &partnerID, &ak.CreatedAt)
+		err = rows.Scan(&ak.ID, &ak.ProjectID, &ak.Name, &partnerID, &ak.RateLimit, &ak.RequestQuota, &restrictions, &ak.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
 
 		ak.PartnerID = partnerID.UUID
+		ak.Restrictions = restrictions.String
 		apiKeys = append(apiKeys, ak)
 	}
 
@@ -175,6 +178,15 @@ func (keys *apikeys) Create(ctx context.Context, head []byte, info console.APIKe
 	if !info.PartnerID.IsZero() {
 		optional.PartnerId = dbx.ApiKey_PartnerId(info.PartnerID[:])
 	}
+	if info.RateLimit != nil {
+		optional.RateLimit = dbx.ApiKey_RateLimit(*info.RateLimit)
+	}
+	if info.RequestQuota != nil {
+		optional.RequestQuota = dbx.ApiKey_RequestQuota(*info.RequestQuota)
+	}
+	if info.Restrictions != "" {
+		optional.Restrictions = dbx.ApiKey_Restrictions(info.Restrictions)
+	}
 
 	dbKey, err := keys.methods.Create_ApiKey(
 		ctx,
@@ -231,6 +243,13 @@ func fromDBXAPIKey(ctx context.Context, key *dbx.ApiKey) (_ *console.APIKeyInfo,
 		Name:      key.Name,
 		CreatedAt: key.CreatedAt,
 		Secret:    key.Secret,
+
+		RateLimit:    key.RateLimit,
+		RequestQuota: key.RequestQuota,
+	}
+
+	if key.Restrictions != nil {
+		result.Restrictions = *key.Restrictions
 	}
 
 	if key.PartnerId != nil {
@@ -0,0 +1,159 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that auditEvents implements console.AuditEvents.
+var _ console.AuditEvents = (*auditEvents)(nil)
+
+// auditEvents is an implementation of console.AuditEvents.
+type auditEvents struct {
+	methods dbx.Methods
+	db      *satelliteDB
+}
+
+// Insert appends the event to the audit log and sets its ID.
+func (events *auditEvents) Insert(ctx context.Context, event *console.AuditEvent) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	optional := dbx.AuditEvent_Create_Fields{
+		OldValue: dbx.AuditEvent_OldValue_Raw(event.OldValue),
+		NewValue: dbx.AuditEvent_NewValue_Raw(event.NewValue),
+	}
+	if !event.ActorID.IsZero() {
+		optional.ActorId = dbx.AuditEvent_ActorId(event.ActorID[:])
+	}
+	if !event.UserID.IsZero() {
+		optional.UserId = dbx.AuditEvent_UserId(event.UserID[:])
+	}
+	if !event.ProjectID.IsZero() {
+		optional.ProjectId = dbx.AuditEvent_ProjectId(event.ProjectID[:])
+	}
+
+	err = events.methods.CreateNoReturn_AuditEvent(ctx,
+		dbx.AuditEvent_Id(id[:]),
+		dbx.AuditEvent_Actor(event.Actor),
+		dbx.AuditEvent_Action(event.Action),
+		dbx.AuditEvent_Target(event.Target),
+		optional,
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	event.ID = id
+	return nil
+}
+
+// GetPaged returns the events matching the cursor, the newest first.
+func (events *auditEvents) GetPaged(ctx context.Context, cursor console.AuditEventCursor) (page *console.AuditEventPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cursor.Limit == 0 {
+		return nil, errs.New("limit cannot be 0")
+	}
+	if cursor.Page == 0 {
+		return nil, errs.New("page cannot be 0")
+	}
+
+	page = &console.AuditEventPage{
+		Limit:  cursor.Limit,
+		Offset: uint64((cursor.Page - 1) * cursor.Limit),
+	}
+
+	var conditions []string
+	var args []interface{}
+	if !cursor.UserID.IsZero() {
+		conditions = append(conditions, "(actor_id = ? OR user_id = ?)")
+		args = append(args, cursor.UserID[:], cursor.UserID[:])
+	}
+	if !cursor.ProjectID.IsZero() {
+		conditions = append(conditions, "project_id = ?")
+		args = append(args, cursor.ProjectID[:])
+	}
+	if !cursor.Since.IsZero() {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, cursor.Since)
+	}
+	if !cursor.Before.IsZero() {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, cursor.Before)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	countRow := events.db.QueryRowContext(ctx, events.db.Rebind(`
+		SELECT COUNT(*) FROM audit_events
+		`+where), args...)
+	err = countRow.Scan(&page.TotalCount)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if page.TotalCount == 0 {
+		return page, nil
+	}
+	if page.Offset > page.TotalCount-1 {
+		return nil, errs.New("page is out of range")
+	}
+
+	rows, err := events.db.QueryContext(ctx, events.db.Rebind(`
+		SELECT id, actor_id, actor, action, user_id, project_id, target, old_value, new_value, created_at
+		FROM audit_events
+		`+where+`
+		ORDER BY created_at DESC, id
+		LIMIT ? OFFSET ?
+		`), append(args, page.Limit, page.Offset)...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var event console.AuditEvent
+		var actorID, userID, projectID uuid.NullUUID
+		var oldValue, newValue []byte
+
+		err = rows.Scan(&event.ID, &actorID, &event.Actor, &event.Action, &userID, &projectID,
+			&event.Target, &oldValue, &newValue, &event.CreatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		event.ActorID = actorID.UUID
+		event.UserID = userID.UUID
+		event.ProjectID = projectID.UUID
+		event.OldValue = oldValue
+		event.NewValue = newValue
+
+		page.Events = append(page.Events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	page.PageCount = uint(page.TotalCount / uint64(cursor.Limit))
+	if page.TotalCount%uint64(cursor.Limit) != 0 {
+		page.PageCount++
+	}
+	page.CurrentPage = cursor.Page
+
+	return page, nil
+}
@@ -6,12 +6,16 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 
 	"storj.io/common/macaroon"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/placement"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
 
@@ -225,6 +229,147 @@ func (db *bucketsDB) CountBuckets(ctx context.Context, projectID uuid.UUID) (cou
 	return int(count64), nil
 }
 
+// GetBucketVersioning returns the versioning state of a bucket.
+func (db *bucketsDB) GetBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ metainfo.Versioning, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxBucket, err := db.db.Get_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return metainfo.Unversioned, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return metainfo.Unversioned, storj.ErrBucket.Wrap(err)
+	}
+	return metainfo.Versioning(dbxBucket.Versioning), nil
+}
+
+// UpdateBucketVersioning updates the versioning state of a bucket.
+func (db *bucketsDB) UpdateBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID, versioning metainfo.Versioning) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var updateFields dbx.BucketMetainfo_Update_Fields
+	updateFields.Versioning = dbx.BucketMetainfo_Versioning(int(versioning))
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		updateFields,
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// GetBucketLifecycle returns the lifecycle configuration of a bucket.
+func (db *bucketsDB) GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ metainfo.BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxBucket, err := db.db.Get_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return metainfo.BucketLifecycle{}, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return metainfo.BucketLifecycle{}, storj.ErrBucket.Wrap(err)
+	}
+
+	var lifecycle metainfo.BucketLifecycle
+	if len(dbxBucket.Lifecycle) > 0 {
+		if err := json.Unmarshal(dbxBucket.Lifecycle, &lifecycle); err != nil {
+			return metainfo.BucketLifecycle{}, storj.ErrBucket.Wrap(err)
+		}
+	}
+	return lifecycle, nil
+}
+
+// UpdateBucketLifecycle replaces the lifecycle configuration of a bucket.
+func (db *bucketsDB) UpdateBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, lifecycle metainfo.BucketLifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var updateFields dbx.BucketMetainfo_Update_Fields
+	if lifecycle.IsZero() {
+		updateFields.Lifecycle = dbx.BucketMetainfo_Lifecycle_Null()
+	} else {
+		data, err := json.Marshal(lifecycle)
+		if err != nil {
+			return storj.ErrBucket.Wrap(err)
+		}
+		updateFields.Lifecycle = dbx.BucketMetainfo_Lifecycle(data)
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		updateFields,
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// GetBucketNotifications returns the notification configuration of a bucket.
+func (db *bucketsDB) GetBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ metainfo.BucketNotifications, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxBucket, err := db.db.Get_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return metainfo.BucketNotifications{}, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return metainfo.BucketNotifications{}, storj.ErrBucket.Wrap(err)
+	}
+
+	var notifications metainfo.BucketNotifications
+	if len(dbxBucket.Notifications) > 0 {
+		if err := json.Unmarshal(dbxBucket.Notifications, &notifications); err != nil {
+			return metainfo.BucketNotifications{}, storj.ErrBucket.Wrap(err)
+		}
+	}
+	return notifications, nil
+}
+
+// UpdateBucketNotifications replaces the notification configuration of a bucket.
+func (db *bucketsDB) UpdateBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID, notifications metainfo.BucketNotifications) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var updateFields dbx.BucketMetainfo_Update_Fields
+	if notifications.IsZero() {
+		updateFields.Notifications = dbx.BucketMetainfo_Notifications_Null()
+	} else {
+		data, err := json.Marshal(notifications)
+		if err != nil {
+			return storj.ErrBucket.Wrap(err)
+		}
+		updateFields.Notifications = dbx.BucketMetainfo_Notifications(data)
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		updateFields,
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
 func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket storj.Bucket, err error) {
 	id, err := uuid.FromBytes(dbxBucket.Id)
 	if err != nil {
@@ -266,3 +411,118 @@ func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket storj.Bucket, err
 
 	return bucket, nil
 }
+
+// GetBucketUploadSettings returns the settings of a bucket that apply to new uploads.
+func (db *bucketsDB) GetBucketUploadSettings(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ metainfo.BucketUploadSettings, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxBucket, err := db.db.Get_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return metainfo.BucketUploadSettings{}, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return metainfo.BucketUploadSettings{}, storj.ErrBucket.Wrap(err)
+	}
+
+	bucket, err := convertDBXtoBucket(dbxBucket)
+	if err != nil {
+		return metainfo.BucketUploadSettings{}, err
+	}
+
+	settings := metainfo.BucketUploadSettings{
+		Versioning: metainfo.Versioning(dbxBucket.Versioning),
+		Redundancy: bucket.DefaultRedundancyScheme,
+		Placement:  placement.EveryCountry,
+	}
+	if dbxBucket.Placement != nil {
+		settings.Placement = placement.Placement(*dbxBucket.Placement)
+	}
+	return settings, nil
+}
+
+// GetBucketPlacement returns the placement constraint of a bucket.
+func (db *bucketsDB) GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ placement.Placement, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxBucket, err := db.db.Get_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return placement.EveryCountry, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return placement.EveryCountry, storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket.Placement == nil {
+		return placement.EveryCountry, nil
+	}
+	return placement.Placement(*dbxBucket.Placement), nil
+}
+
+// UpdateBucketPlacement updates the placement constraint of a bucket.
+func (db *bucketsDB) UpdateBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID, constraint placement.Placement) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var updateFields dbx.BucketMetainfo_Update_Fields
+	if constraint == placement.EveryCountry {
+		updateFields.Placement = dbx.BucketMetainfo_Placement_Null()
+	} else {
+		updateFields.Placement = dbx.BucketMetainfo_Placement(int(constraint))
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		updateFields,
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// GetBucketLimits returns the storage and bandwidth limits of a bucket.
+func (db *bucketsDB) GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ accounting.BucketLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxBucket, err := db.db.Get_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accounting.BucketLimits{}, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return accounting.BucketLimits{}, storj.ErrBucket.Wrap(err)
+	}
+	return accounting.BucketLimits{
+		Usage:     dbxBucket.UsageLimit,
+		Bandwidth: dbxBucket.BandwidthLimit,
+	}, nil
+}
+
+// UpdateBucketLimits updates the storage and bandwidth limits of a bucket.
+// A nil limit removes the limit.
+func (db *bucketsDB) UpdateBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits accounting.BucketLimits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		dbx.BucketMetainfo_Update_Fields{
+			UsageLimit:     dbx.BucketMetainfo_UsageLimit_Raw(limits.Usage),
+			BandwidthLimit: dbx.BucketMetainfo_BandwidthLimit_Raw(limits.Bandwidth),
+		},
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
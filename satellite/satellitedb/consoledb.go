@@ -68,6 +68,11 @@ func (db *ConsoleDB) ResetPasswordTokens() console.ResetPasswordTokens {
 	return &resetPasswordTokens{db.methods}
 }
 
+// AuditEvents is a getter for AuditEvents repository.
+func (db *ConsoleDB) AuditEvents() console.AuditEvents {
+	return &auditEvents{methods: db.methods, db: db.db}
+}
+
 // WithTx is a method for executing and retrying transaction.
 func (db *ConsoleDB) WithTx(ctx context.Context, fn func(context.Context, console.DBTx) error) error {
 	if db.db == nil {
@@ -33,13 +33,17 @@ type coupons struct {
 	db *satelliteDB
 }
 
-// Insert inserts a coupon into the database.
+// Insert inserts a coupon into the database. The ID of the coupon is generated
+// when it's zero.
 func (coupons *coupons) Insert(ctx context.Context, coupon payments.Coupon) (_ payments.Coupon, err error) {
 	defer mon.Task()(&ctx, coupon)(&err)
 
-	id, err := uuid.New()
-	if err != nil {
-		return payments.Coupon{}, err
+	id := coupon.ID
+	if id.IsZero() {
+		id, err = uuid.New()
+		if err != nil {
+			return payments.Coupon{}, err
+		}
 	}
 
 	duration := 0
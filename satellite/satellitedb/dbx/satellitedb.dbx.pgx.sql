@@ -16,6 +16,19 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE audit_events (
+	id bytea NOT NULL,
+	actor_id bytea,
+	actor text NOT NULL,
+	action text NOT NULL,
+	user_id bytea,
+	project_id bytea,
+	target text NOT NULL,
+	old_value bytea,
+	new_value bytea,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE audit_histories (
 	node_id bytea NOT NULL,
 	history bytea NOT NULL,
@@ -55,6 +68,7 @@ CREATE TABLE bucket_storage_tallies (
 	inline_segments_count integer NOT NULL,
 	object_count integer NOT NULL,
 	metadata_size bigint NOT NULL,
+	billable_bytes bigint NOT NULL DEFAULT 0,
 	PRIMARY KEY ( bucket_name, project_id, interval_start )
 );
 CREATE TABLE coinpayments_transactions (
@@ -140,6 +154,49 @@ CREATE TABLE graceful_exit_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, path, piece_num )
 );
+CREATE TABLE live_accounting_api_key_requests (
+	api_key_id bytea NOT NULL,
+	interval_day date NOT NULL,
+	count bigint NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( api_key_id, interval_day )
+);
+CREATE TABLE live_accounting_bandwidth (
+	project_id bytea NOT NULL,
+	interval_day date NOT NULL,
+	used bigint NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, interval_day )
+);
+CREATE TABLE live_accounting_bucket_bandwidth (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	interval_day date NOT NULL,
+	used bigint NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, interval_day )
+);
+CREATE TABLE live_accounting_bucket_storage (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE live_accounting_objects (
+	project_id bytea NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE live_accounting_segments (
+	project_id bytea NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE live_accounting_storage (
+	project_id bytea NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -181,6 +238,7 @@ CREATE TABLE nodes (
 	exit_loop_completed_at timestamp with time zone,
 	exit_finished_at timestamp with time zone,
 	exit_success boolean NOT NULL DEFAULT false,
+	country_code text,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE node_api_versions (
@@ -220,6 +278,8 @@ CREATE TABLE projects (
 	bandwidth_limit bigint,
 	rate_limit integer,
 	max_buckets integer,
+	segment_limit bigint,
+	object_limit bigint,
 	partner_id bytea,
 	owner_id bytea NOT NULL,
 	created_at timestamp with time zone NOT NULL,
@@ -254,6 +314,8 @@ CREATE TABLE repair_queue (
 	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
+	placement integer NOT NULL DEFAULT 0,
+	health_overridden boolean NOT NULL DEFAULT false,
 	PRIMARY KEY ( stream_id, position )
 );
 CREATE TABLE reputations (
@@ -432,6 +494,9 @@ CREATE TABLE api_keys (
 	name text NOT NULL,
 	secret bytea NOT NULL,
 	partner_id bytea,
+	rate_limit integer,
+	request_quota bigint,
+	restrictions text,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id ),
 	UNIQUE ( head ),
@@ -453,6 +518,12 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_repair_shares integer NOT NULL,
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
+	versioning integer NOT NULL DEFAULT 0,
+	lifecycle bytea,
+	placement integer,
+	usage_limit bigint,
+	bandwidth_limit bigint,
+	notifications bytea,
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
@@ -460,6 +531,7 @@ CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	created_at timestamp with time zone NOT NULL,
+	role integer NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
 CREATE TABLE stripecoinpayments_apply_balance_intents (
@@ -482,6 +554,10 @@ CREATE TABLE user_credits (
 	UNIQUE ( id, offer_id )
 );
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX audit_events_created_at_index ON audit_events ( created_at ) ;
+CREATE INDEX audit_events_actor_id_index ON audit_events ( actor_id ) ;
+CREATE INDEX audit_events_user_id_index ON audit_events ( user_id ) ;
+CREATE INDEX audit_events_project_id_index ON audit_events ( project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
@@ -1605,6 +1605,185 @@ func (db *satelliteDB) PostgresMigration() *migrate.Migration {
 					`DROP TABLE injuredsegments`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add versioning column to bucket_metainfos",
+				Version:     170,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN versioning integer NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add lifecycle column to bucket_metainfos",
+				Version:     171,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN lifecycle bytea;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add placement to bucket_metainfos and country_code to nodes",
+				Version:     172,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN placement integer;`,
+					`ALTER TABLE nodes ADD COLUMN country_code text;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add placement column to repair_queue",
+				Version:     173,
+				Action: migrate.SQL{
+					`ALTER TABLE repair_queue ADD COLUMN placement integer NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add billable_bytes column to bucket_storage_tallies",
+				Version:     174,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_storage_tallies ADD COLUMN billable_bytes bigint NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add segment_limit and object_limit columns to projects",
+				Version:     175,
+				Action: migrate.SQL{
+					`ALTER TABLE projects ADD COLUMN segment_limit bigint;`,
+					`ALTER TABLE projects ADD COLUMN object_limit bigint;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add usage_limit and bandwidth_limit columns to bucket_metainfos",
+				Version:     176,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN usage_limit bigint;`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN bandwidth_limit bigint;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add rate_limit and request_quota columns to api_keys",
+				Version:     177,
+				Action: migrate.SQL{
+					`ALTER TABLE api_keys ADD COLUMN rate_limit integer;`,
+					`ALTER TABLE api_keys ADD COLUMN request_quota bigint;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket notifications",
+				Version:     178,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN notifications bytea;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add audit_events table",
+				Version:     179,
+				Action: migrate.SQL{
+					`CREATE TABLE audit_events (
+						id bytea NOT NULL,
+						actor_id bytea,
+						actor text NOT NULL,
+						action text NOT NULL,
+						user_id bytea,
+						project_id bytea,
+						target text NOT NULL,
+						old_value bytea,
+						new_value bytea,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX audit_events_created_at_index ON audit_events ( created_at );`,
+					`CREATE INDEX audit_events_actor_id_index ON audit_events ( actor_id );`,
+					`CREATE INDEX audit_events_user_id_index ON audit_events ( user_id );`,
+					`CREATE INDEX audit_events_project_id_index ON audit_events ( project_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add role to project_members, existing members are admins of the project",
+				Version:     180,
+				Action: migrate.SQL{
+					`ALTER TABLE project_members ADD COLUMN role integer NOT NULL DEFAULT 2;`,
+					`UPDATE project_members SET role = 1
+						FROM projects
+						WHERE projects.id = project_members.project_id
+						AND projects.owner_id = project_members.member_id;`,
+					`ALTER TABLE project_members ALTER COLUMN role DROP DEFAULT;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add restrictions to api_keys",
+				Version:     181,
+				Action: migrate.SQL{
+					`ALTER TABLE api_keys ADD COLUMN restrictions text;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add health_overridden to repair_queue",
+				Version:     182,
+				Action: migrate.SQL{
+					`ALTER TABLE repair_queue ADD COLUMN health_overridden boolean NOT NULL DEFAULT false;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add live accounting tables",
+				Version:     183,
+				Action: migrate.SQL{
+					`CREATE TABLE live_accounting_api_key_requests (
+						api_key_id bytea NOT NULL,
+						interval_day date NOT NULL,
+						count bigint NOT NULL,
+						expires_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( api_key_id, interval_day )
+					);`,
+					`CREATE TABLE live_accounting_bandwidth (
+						project_id bytea NOT NULL,
+						interval_day date NOT NULL,
+						used bigint NOT NULL,
+						expires_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, interval_day )
+					);`,
+					`CREATE TABLE live_accounting_bucket_bandwidth (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						interval_day date NOT NULL,
+						used bigint NOT NULL,
+						expires_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name, interval_day )
+					);`,
+					`CREATE TABLE live_accounting_bucket_storage (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						total bigint NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+					`CREATE TABLE live_accounting_objects (
+						project_id bytea NOT NULL,
+						total bigint NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+					`CREATE TABLE live_accounting_segments (
+						project_id bytea NOT NULL,
+						total bigint NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+					`CREATE TABLE live_accounting_storage (
+						project_id bytea NOT NULL,
+						total bigint NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -224,6 +224,12 @@ func nodeSelectionCondition(ctx context.Context, criteria *overlay.NodeCriteria,
 		}
 		conds.add(`last_net <> ''`)
 	}
+	if countries := criteria.Placement.Countries(); countries != nil {
+		conds.add(
+			`country_code = any(?::text[])`,
+			pgutil.TextArray(countries),
+		)
+	}
 	return conds.combine(), nil
 }
 
@@ -56,7 +56,8 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 	defer mon.Task()(&ctx)(&err)
 
 	query := `
-		SELECT id, address, last_net, last_ip_port, vetted_at
+		SELECT id, address, last_net, last_ip_port, vetted_at,
+			country_code, free_disk, audit_reputation_alpha, audit_reputation_beta
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(selectionCfg.AsOfSystemTime.DefaultInterval) + `
 			WHERE disqualified IS NULL
@@ -98,15 +99,23 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 	for rows.Next() {
 		var node overlay.SelectedNode
 		node.Address = &pb.NodeAddress{}
-		var lastIPPort sql.NullString
+		var lastIPPort, countryCode sql.NullString
 		var vettedAt *time.Time
-		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt)
+		var alpha, beta float64
+		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt,
+			&countryCode, &node.FreeDisk, &alpha, &beta)
 		if err != nil {
 			return nil, nil, err
 		}
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
+		if countryCode.Valid {
+			node.CountryCode = countryCode.String
+		}
+		if alpha+beta > 0 {
+			node.Reputation = alpha / (alpha + beta)
+		}
 
 		if vettedAt == nil {
 			newNodes = append(newNodes, &node)
@@ -233,6 +242,26 @@ func (cache *overlaycache) Get(ctx context.Context, id storj.NodeID) (dossier *o
 	return convertDBNode(ctx, node)
 }
 
+// GetNodesByLastNet returns the storage nodes in the subnet, ordered by ID.
+func (cache *overlaycache) GetNodesByLastNet(ctx context.Context, lastNet string) (nodes []*overlay.NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxNodes, err := cache.db.All_Node_By_LastNet_OrderBy_Asc_Id(ctx, dbx.Node_LastNet(lastNet))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	nodes = make([]*overlay.NodeDossier, 0, len(dbxNodes))
+	for _, node := range dbxNodes {
+		dossier, err := convertDBNode(ctx, node)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, dossier)
+	}
+	return nodes, nil
+}
+
 // GetOnlineNodesForGetDelete returns a map of nodes for the supplied nodeIDs.
 func (cache *overlaycache) GetOnlineNodesForGetDelete(ctx context.Context, nodeIDs []storj.NodeID, onlineWindow time.Duration) (nodes map[storj.NodeID]*overlay.SelectedNode, err error) {
 	for {
@@ -254,7 +283,7 @@ func (cache *overlaycache) getOnlineNodesForGetDelete(ctx context.Context, nodeI
 
 	var rows tagsql.Rows
 	rows, err = cache.db.Query(ctx, cache.db.Rebind(`
-		SELECT last_net, id, address, last_ip_port
+		SELECT last_net, id, address, last_ip_port, country_code
 		FROM nodes
 		WHERE id = any($1::bytea[])
 			AND disqualified IS NULL
@@ -271,14 +300,15 @@ func (cache *overlaycache) getOnlineNodesForGetDelete(ctx context.Context, nodeI
 		var node overlay.SelectedNode
 		node.Address = &pb.NodeAddress{Transport: pb.NodeTransport_TCP_TLS_GRPC}
 
-		var lastIPPort sql.NullString
-		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &lastIPPort)
+		var lastIPPort, countryCode sql.NullString
+		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &lastIPPort, &countryCode)
 		if err != nil {
 			return nil, err
 		}
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
+		node.CountryCode = countryCode.String
 
 		nodes[node.ID] = &node
 	}
@@ -449,7 +479,7 @@ func (cache *overlaycache) knownReliable(ctx context.Context, onlineWindow time.
 }
 
 // Reliable returns all reliable nodes.
-func (cache *overlaycache) Reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes storj.NodeIDList, err error) {
+func (cache *overlaycache) Reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes []*overlay.SelectedNode, err error) {
 	for {
 		nodes, err = cache.reliable(ctx, criteria)
 		if err != nil {
@@ -464,10 +494,10 @@ func (cache *overlaycache) Reliable(ctx context.Context, criteria *overlay.NodeC
 	return nodes, err
 }
 
-func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes storj.NodeIDList, err error) {
+func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes []*overlay.SelectedNode, err error) {
 	// get reliable and online nodes
 	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
-		SELECT id
+		SELECT id, address, last_net, last_ip_port, country_code
 		FROM nodes
 		`+cache.db.impl.AsOfSystemInterval(criteria.AsOfSystemInterval)+`
 		WHERE disqualified IS NULL
@@ -484,12 +514,20 @@ func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeC
 	}()
 
 	for rows.Next() {
-		var id storj.NodeID
-		err = rows.Scan(&id)
+		var node overlay.SelectedNode
+		node.Address = &pb.NodeAddress{}
+		var lastIPPort, countryCode sql.NullString
+		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &countryCode)
 		if err != nil {
 			return nil, err
 		}
-		nodes = append(nodes, id)
+		if lastIPPort.Valid {
+			node.LastIPPort = lastIPPort.String
+		}
+		if countryCode.Valid {
+			node.CountryCode = countryCode.String
+		}
+		nodes = append(nodes, &node)
 	}
 	return nodes, Error.Wrap(rows.Err())
 }
@@ -570,8 +608,26 @@ func (cache *overlaycache) DisqualifyNode(ctx context.Context, nodeID storj.Node
 	return nil
 }
 
-// TestSuspendNodeUnknownAudit suspends a storage node for unknown audits.
-func (cache *overlaycache) TestSuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error) {
+// ReinstateNode removes the disqualification of a storage node and resets its audit reputation.
+func (cache *overlaycache) ReinstateNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	updateFields := dbx.Node_Update_Fields{}
+	updateFields.Disqualified = dbx.Node_Disqualified_Null()
+	updateFields.AuditReputationAlpha = dbx.Node_AuditReputationAlpha(1)
+	updateFields.AuditReputationBeta = dbx.Node_AuditReputationBeta(0)
+
+	dbNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+	if err != nil {
+		return err
+	}
+	if dbNode == nil {
+		return errs.New("unable to get node by ID: %v", nodeID)
+	}
+	return nil
+}
+
+// SuspendNodeUnknownAudit suspends a storage node for unknown audits.
+func (cache *overlaycache) SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	updateFields := dbx.Node_Update_Fields{}
 	updateFields.UnknownAuditSuspended = dbx.Node_UnknownAuditSuspended(suspendedAt.UTC())
@@ -586,8 +642,8 @@ func (cache *overlaycache) TestSuspendNodeUnknownAudit(ctx context.Context, node
 	return nil
 }
 
-// TestUnsuspendNodeUnknownAudit unsuspends a storage node for unknown audits.
-func (cache *overlaycache) TestUnsuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID) (err error) {
+// UnsuspendNodeUnknownAudit unsuspends a storage node for unknown audits.
+func (cache *overlaycache) UnsuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	updateFields := dbx.Node_Update_Fields{}
 	updateFields.UnknownAuditSuspended = dbx.Node_UnknownAuditSuspended_Null()
@@ -934,6 +990,9 @@ func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier,
 	if info.LastIpPort != nil {
 		node.LastIPPort = *info.LastIpPort
 	}
+	if info.CountryCode != nil {
+		node.CountryCode = *info.CountryCode
+	}
 
 	return node, nil
 }
@@ -1076,6 +1135,12 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		return Error.Wrap(err)
 	}
 
+	// a NULL country code keeps the previously known location.
+	countryCode := sql.NullString{
+		String: node.CountryCode,
+		Valid:  node.CountryCode != "",
+	}
+
 	// First try the fast path.
 	var res sql.Result
 	res, err = cache.db.ExecContext(ctx, `
@@ -1097,7 +1162,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				ELSE nodes.last_contact_failure
 			END,
 			last_ip_port=$16,
-			wallet_features=$17
+			wallet_features=$17,
+			country_code=COALESCE($18, nodes.country_code)
 		WHERE id = $1
 	`, // args $1 - $4
 		node.NodeID.Bytes(), node.Address.GetAddress(), node.LastNet, node.Address.GetTransport(),
@@ -1113,6 +1179,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		node.LastIPPort,
 		// args $17,
 		walletFeatures,
+		// args $18,
+		countryCode,
 	)
 
 	if err == nil {
@@ -1133,7 +1201,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				unknown_audit_reputation_alpha, unknown_audit_reputation_beta,
 				major, minor, patch, hash, timestamp, release,
 				last_ip_port,
-				wallet_features
+				wallet_features,
+				country_code
 			)
 			VALUES (
 				$1, $2, $3, $4, $5,
@@ -1148,7 +1217,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				$10, $11,
 				$12, $13, $14, $15, $16, $17,
 				$19,
-				$20
+				$20,
+				$21
 			)
 			ON CONFLICT (id)
 			DO UPDATE
@@ -1169,7 +1239,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 					ELSE nodes.last_contact_failure
 				END,
 				last_ip_port=$19,
-				wallet_features=$20;
+				wallet_features=$20,
+				country_code=COALESCE($21, nodes.country_code);
 			`,
 		// args $1 - $5
 		node.NodeID.Bytes(), node.Address.GetAddress(), node.LastNet, node.Address.GetTransport(), int(pb.NodeType_STORAGE),
@@ -1187,6 +1258,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		node.LastIPPort,
 		// args $20,
 		walletFeatures,
+		// args $21,
+		countryCode,
 	)
 	if err != nil {
 		return Error.Wrap(err)
@@ -40,7 +40,7 @@ func (db *ProjectAccounting) SaveTallies(ctx context.Context, intervalStart time
 		return nil
 	}
 	var bucketNames, projectIDs [][]byte
-	var totalBytes, metadataSizes []int64
+	var totalBytes, metadataSizes, billableBytes []int64
 	var totalSegments, objectCounts []int64
 	for _, info := range bucketTallies {
 		bucketNames = append(bucketNames, []byte(info.BucketName))
@@ -49,6 +49,7 @@ func (db *ProjectAccounting) SaveTallies(ctx context.Context, intervalStart time
 		totalSegments = append(totalSegments, info.TotalSegments)
 		objectCounts = append(objectCounts, info.ObjectCount)
 		metadataSizes = append(metadataSizes, info.MetadataSize)
+		billableBytes = append(billableBytes, info.BillableBytes)
 	}
 	_, err = db.db.DB.ExecContext(ctx, db.db.Rebind(`
 		INSERT INTO bucket_storage_tallies (
@@ -56,18 +57,21 @@ func (db *ProjectAccounting) SaveTallies(ctx context.Context, intervalStart time
 			bucket_name, project_id,
 			total_bytes, inline, remote,
 			total_segments_count, remote_segments_count, inline_segments_count,
-			object_count, metadata_size)
+			object_count, metadata_size,
+			billable_bytes)
 		SELECT
 			$1,
 			unnest($2::bytea[]), unnest($3::bytea[]),
 			unnest($4::int8[]), $5, $6,
 			unnest($7::int8[]), $8, $9,
-			unnest($10::int8[]), unnest($11::int8[])`),
+			unnest($10::int8[]), unnest($11::int8[]),
+			unnest($12::int8[])`),
 		intervalStart,
 		pgutil.ByteaArray(bucketNames), pgutil.ByteaArray(projectIDs),
 		pgutil.Int8Array(totalBytes), 0, 0,
 		pgutil.Int8Array(totalSegments), 0, 0,
-		pgutil.Int8Array(objectCounts), pgutil.Int8Array(metadataSizes))
+		pgutil.Int8Array(objectCounts), pgutil.Int8Array(metadataSizes),
+		pgutil.Int8Array(billableBytes))
 
 	return Error.Wrap(err)
 }
@@ -106,6 +110,7 @@ func (db *ProjectAccounting) GetTallies(ctx context.Context) (tallies []accounti
 			TotalSegments: int64(totalSegments),
 			TotalBytes:    int64(totalBytes),
 			MetadataSize:  int64(dbxTally.MetadataSize),
+			BillableBytes: int64(dbxTally.BillableBytes),
 		})
 	}
 
@@ -122,18 +127,21 @@ func (db *ProjectAccounting) CreateStorageTally(ctx context.Context, tally accou
 			bucket_name, project_id,
 			total_bytes, inline, remote,
 			total_segments_count, remote_segments_count, inline_segments_count,
-			object_count, metadata_size)
+			object_count, metadata_size,
+			billable_bytes)
 		VALUES (
 			?,
 			?, ?,
 			?, ?, ?,
 			?, ?, ?,
-			?, ?
+			?, ?,
+			?
 		)`), tally.IntervalStart,
 		[]byte(tally.BucketName), tally.ProjectID,
 		tally.TotalBytes, 0, 0,
 		tally.TotalSegmentCount, 0, 0,
 		tally.ObjectCount, tally.MetadataSize,
+		tally.BillableBytes,
 	)
 
 	return Error.Wrap(err)
@@ -152,6 +160,19 @@ func (db *ProjectAccounting) GetAllocatedBandwidthTotal(ctx context.Context, pro
 	return *sum, err
 }
 
+// GetBucketAllocatedBandwidth returns the sum of GET bandwidth usage allocated for a bucket in the past time frame.
+func (db *ProjectAccounting) GetBucketAllocatedBandwidth(ctx context.Context, bucket metabase.BucketLocation, from time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var sum *int64
+	query := `SELECT SUM(allocated) FROM bucket_bandwidth_rollups WHERE project_id = ? AND bucket_name = ? AND action = ? AND interval_start >= ?;`
+	err = db.db.QueryRow(ctx, db.db.Rebind(query), bucket.ProjectID[:], []byte(bucket.BucketName), pb.PieceAction_GET, from.UTC()).Scan(&sum)
+	if errors.Is(err, sql.ErrNoRows) || sum == nil {
+		return 0, nil
+	}
+
+	return *sum, err
+}
+
 // GetProjectBandwidth returns the used bandwidth (settled or allocated) for the specified year, month and day.
 func (db *ProjectAccounting) GetProjectBandwidth(ctx context.Context, projectID uuid.UUID, year int, month time.Month, day int, asOfSystemInterval time.Duration) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -250,6 +271,34 @@ func (db *ProjectAccounting) GetProjectStorageLimit(ctx context.Context, project
 	return row.UsageLimit, nil
 }
 
+// UpdateProjectSegmentLimit updates project segment limit.
+func (db *ProjectAccounting) UpdateProjectSegmentLimit(ctx context.Context, projectID uuid.UUID, limit int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Update_Project_By_Id(ctx,
+		dbx.Project_Id(projectID[:]),
+		dbx.Project_Update_Fields{
+			SegmentLimit: dbx.Project_SegmentLimit(limit),
+		},
+	)
+
+	return err
+}
+
+// UpdateProjectObjectLimit updates project object limit.
+func (db *ProjectAccounting) UpdateProjectObjectLimit(ctx context.Context, projectID uuid.UUID, limit int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Update_Project_By_Id(ctx,
+		dbx.Project_Id(projectID[:]),
+		dbx.Project_Update_Fields{
+			ObjectLimit: dbx.Project_ObjectLimit(limit),
+		},
+	)
+
+	return err
+}
+
 // GetProjectBandwidthLimit returns project bandwidth usage limit.
 func (db *ProjectAccounting) GetProjectBandwidthLimit(ctx context.Context, projectID uuid.UUID) (_ *int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -279,7 +328,8 @@ func (db *ProjectAccounting) GetProjectTotal(ctx context.Context, projectID uuid
 			bucket_storage_tallies.total_bytes,
 			bucket_storage_tallies.inline,
 			bucket_storage_tallies.remote,
-			bucket_storage_tallies.object_count
+			bucket_storage_tallies.object_count,
+			bucket_storage_tallies.billable_bytes
 		FROM
 			bucket_storage_tallies
 		WHERE
@@ -304,7 +354,7 @@ func (db *ProjectAccounting) GetProjectTotal(ctx context.Context, projectID uuid
 			tally := accounting.BucketStorageTally{}
 
 			var inline, remote int64
-			err = storageTalliesRows.Scan(&tally.IntervalStart, &tally.TotalBytes, &inline, &remote, &tally.ObjectCount)
+			err = storageTalliesRows.Scan(&tally.IntervalStart, &tally.TotalBytes, &inline, &remote, &tally.ObjectCount, &tally.BillableBytes)
 			if err != nil {
 				return nil, errs.Combine(err, storageTalliesRows.Close())
 			}
@@ -336,7 +386,7 @@ func (db *ProjectAccounting) GetProjectTotal(ctx context.Context, projectID uuid
 		for i := len(tallies) - 1; i > 0; i-- {
 			current := (tallies)[i]
 			hours := (tallies)[i-1].IntervalStart.Sub(current.IntervalStart).Hours()
-			usage.Storage += memory.Size(current.Bytes()).Float64() * hours
+			usage.Storage += memory.Size(current.Billable()).Float64() * hours
 			usage.ObjectCount += float64(current.ObjectCount) * hours
 		}
 	}
@@ -578,8 +628,14 @@ func (db *ProjectAccounting) GetBucketTotals(ctx context.Context, projectID uuid
 		return nil, errs.New("page is out of range")
 	}
 
-	var buckets []string
-	bucketsQuery := db.db.Rebind(`SELECT name FROM bucket_metainfos
+	type bucketRow struct {
+		name           string
+		usageLimit     *int64
+		bandwidthLimit *int64
+	}
+
+	var buckets []bucketRow
+	bucketsQuery := db.db.Rebind(`SELECT name, usage_limit, bandwidth_limit FROM bucket_metainfos
 	WHERE project_id = ? AND ` + bucketNameRange + `ORDER BY name ASC LIMIT ? OFFSET ?`)
 
 	args = []interface{}{
@@ -598,8 +654,8 @@ func (db *ProjectAccounting) GetBucketTotals(ctx context.Context, projectID uuid
 	defer func() { err = errs.Combine(err, bucketRows.Close()) }()
 
 	for bucketRows.Next() {
-		var bucket string
-		err = bucketRows.Scan(&bucket)
+		var bucket bucketRow
+		err = bucketRows.Scan(&bucket.name, &bucket.usageLimit, &bucket.bandwidthLimit)
 		if err != nil {
 			return nil, err
 		}
@@ -621,7 +677,8 @@ func (db *ProjectAccounting) GetBucketTotals(ctx context.Context, projectID uuid
 		LIMIT 1`)
 
 	var bucketUsages []accounting.BucketUsage
-	for _, bucket := range buckets {
+	for _, row := range buckets {
+		bucket := row.name
 		bucketUsage := accounting.BucketUsage{
 			ProjectID:  projectID,
 			BucketName: bucket,
@@ -629,6 +686,15 @@ func (db *ProjectAccounting) GetBucketTotals(ctx context.Context, projectID uuid
 			Before:     before,
 		}
 
+		if row.usageLimit != nil {
+			storageLimit := memory.Size(*row.usageLimit).GB()
+			bucketUsage.StorageLimit = &storageLimit
+		}
+		if row.bandwidthLimit != nil {
+			egressLimit := memory.Size(*row.bandwidthLimit).GB()
+			bucketUsage.EgressLimit = &egressLimit
+		}
+
 		// get bucket_bandwidth_rollups
 		rollupRow := db.db.QueryRowContext(ctx, rollupsQuery, projectID[:], []byte(bucket), since, before, pb.PieceAction_GET)
 
@@ -774,21 +840,21 @@ func timeTruncateDown(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
 }
 
-// GetProjectLimits returns current project limit for both storage and bandwidth.
+// GetProjectLimits returns the current storage, bandwidth, segment and object limits of the project.
 func (db *ProjectAccounting) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (_ accounting.ProjectLimits, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	row, err := db.db.Get_Project_BandwidthLimit_Project_UsageLimit_By_Id(ctx,
-		dbx.Project_Id(projectID[:]),
-	)
+	var limits accounting.ProjectLimits
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT usage_limit, bandwidth_limit, segment_limit, object_limit
+		FROM projects
+		WHERE id = ?
+	`), projectID[:]).Scan(&limits.Usage, &limits.Bandwidth, &limits.Segments, &limits.Objects)
 	if err != nil {
 		return accounting.ProjectLimits{}, err
 	}
 
-	return accounting.ProjectLimits{
-		Usage:     row.UsageLimit,
-		Bandwidth: row.BandwidthLimit,
-	}, nil
+	return limits, nil
 }
 
 // GetRollupsSince retrieves all archived rollup records since a given time.
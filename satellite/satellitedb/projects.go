@@ -105,6 +105,8 @@ func (projects *projects) Insert(ctx context.Context, project *console.Project)
 	}
 	createFields.RateLimit = dbx.Project_RateLimit_Raw(project.RateLimit)
 	createFields.MaxBuckets = dbx.Project_MaxBuckets_Raw(project.MaxBuckets)
+	createFields.SegmentLimit = dbx.Project_SegmentLimit_Raw(project.SegmentLimit)
+	createFields.ObjectLimit = dbx.Project_ObjectLimit_Raw(project.ObjectLimit)
 
 	createdProject, err := projects.db.Create_Project(ctx,
 		dbx.Project_Id(projectID[:]),
@@ -145,6 +147,12 @@ func (projects *projects) Update(ctx context.Context, project *console.Project)
 	if project.BandwidthLimit != nil {
 		updateFields.BandwidthLimit = dbx.Project_BandwidthLimit(project.BandwidthLimit.Int64())
 	}
+	if project.SegmentLimit != nil {
+		updateFields.SegmentLimit = dbx.Project_SegmentLimit(*project.SegmentLimit)
+	}
+	if project.ObjectLimit != nil {
+		updateFields.ObjectLimit = dbx.Project_ObjectLimit(*project.ObjectLimit)
+	}
 
 	_, err = projects.db.Update_Project_By_Id(ctx,
 		dbx.Project_Id(project.ID[:]),
@@ -327,6 +335,8 @@ func projectFromDBX(ctx context.Context, project *dbx.Project) (_ *console.Proje
 		CreatedAt:      project.CreatedAt,
 		StorageLimit:   (*memory.Size)(project.UsageLimit),
 		BandwidthLimit: (*memory.Size)(project.BandwidthLimit),
+		SegmentLimit:   project.SegmentLimit,
+		ObjectLimit:    project.ObjectLimit,
 	}, nil
 }
 
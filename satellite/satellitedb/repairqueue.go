@@ -7,12 +7,15 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/zeebo/errs"
 
 	"storj.io/common/uuid"
 	"storj.io/private/dbutil"
+	"storj.io/private/dbutil/pgutil"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/satellitedb/dbx"
@@ -32,6 +35,7 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 	var query string
 
 	// we want to insert the segment if it is not in the queue, but update the segment health if it already is in the queue
+	// and an admin hasn't overridden it
 	// we also want to know if the result was an insert or an update - this is the reasoning for the xmax section of the postgres query
 	// and the separate cockroach query (which the xmax trick does not work for)
 	switch r.db.impl {
@@ -39,30 +43,33 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 		query = `
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health
+				stream_id, position, segment_health, placement
 			)
 			VALUES (
-				$1, $2, $3
+				$1, $2, $3, $4
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=$3, updated_at=current_timestamp
+			SET segment_health=CASE WHEN repair_queue.health_overridden THEN repair_queue.segment_health ELSE $3 END,
+				placement=$4, updated_at=current_timestamp
 			RETURNING (xmax != 0) AS alreadyInserted
 		`
 	case dbutil.Cockroach:
 		query = `
 			WITH updater AS (
-				UPDATE repair_queue SET segment_health = $3, updated_at = current_timestamp
+				UPDATE repair_queue SET
+					segment_health = CASE WHEN health_overridden THEN segment_health ELSE $3 END,
+					placement = $4, updated_at = current_timestamp
 				WHERE stream_id = $1 AND position = $2
 				RETURNING *
 			)
-			INSERT INTO repair_queue (stream_id, position, segment_health)
-			SELECT $1, $2, $3
+			INSERT INTO repair_queue (stream_id, position, segment_health, placement)
+			SELECT $1, $2, $3, $4
 			WHERE NOT EXISTS (SELECT * FROM updater)
 			RETURNING false
 		`
 	}
-	rows, err := r.db.QueryContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth)
+	rows, err := r.db.QueryContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth, seg.Placement)
 	if err != nil {
 		return false, err
 	}
@@ -82,27 +89,34 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 
 func (r *repairQueue) Select(ctx context.Context) (seg *queue.InjuredSegment, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return r.SelectFiltered(ctx, queue.Filter{})
+}
+
+func (r *repairQueue) SelectFiltered(ctx context.Context, filter queue.Filter) (seg *queue.InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conditions, args := repairQueueFilter(filter, nil)
 
 	segment := queue.InjuredSegment{}
 	switch r.db.impl {
 	case dbutil.Cockroach:
 		err = r.db.QueryRowContext(ctx, `
 				UPDATE repair_queue SET attempted_at = now()
-				WHERE attempted_at IS NULL OR attempted_at < now() - interval '6 hours'
+				WHERE (attempted_at IS NULL OR attempted_at < now() - interval '6 hours')`+conditions+`
 				ORDER BY segment_health ASC, attempted_at NULLS FIRST
 				LIMIT 1
-				RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health
-		`).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
-			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth)
+				RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement
+		`, args...).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
+			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth, &segment.Placement)
 	case dbutil.Postgres:
 		err = r.db.QueryRowContext(ctx, `
 				UPDATE repair_queue SET attempted_at = now() WHERE (stream_id, position) = (
 					SELECT stream_id, position FROM repair_queue
-					WHERE attempted_at IS NULL OR attempted_at < now() - interval '6 hours'
+					WHERE (attempted_at IS NULL OR attempted_at < now() - interval '6 hours')`+conditions+`
 					ORDER BY segment_health ASC, attempted_at NULLS FIRST FOR UPDATE SKIP LOCKED LIMIT 1
-				) RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health
-		`).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
-			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth)
+				) RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement
+		`, args...).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
+			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth, &segment.Placement)
 	default:
 		return seg, errs.New("unhandled database: %v", r.db.impl)
 	}
@@ -115,6 +129,90 @@ func (r *repairQueue) Select(ctx context.Context) (seg *queue.InjuredSegment, er
 	return &segment, err
 }
 
+func (r *repairQueue) Get(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition) (seg *queue.InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segment := queue.InjuredSegment{}
+	err = r.db.QueryRowContext(ctx, `
+		SELECT stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement
+		FROM repair_queue
+		WHERE stream_id = $1 AND position = $2
+	`, streamID, position.Encode()).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
+		&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth, &segment.Placement)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, queue.ErrSegmentNotFound.New("%s/%d", streamID, position.Encode())
+		}
+		return nil, Error.Wrap(err)
+	}
+	return &segment, nil
+}
+
+func (r *repairQueue) List(ctx context.Context, opts queue.ListOptions) (result queue.ListResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.Limit <= 0 || opts.Limit > RepairQueueSelectLimit {
+		opts.Limit = RepairQueueSelectLimit
+	}
+
+	conditions, args := repairQueueFilter(opts.Filter, nil)
+	if opts.Cursor != nil {
+		args = append(args, opts.Cursor.SegmentHealth, opts.Cursor.StreamID, opts.Cursor.Position.Encode())
+		conditions += fmt.Sprintf(" AND (segment_health, stream_id, position) > ($%d, $%d, $%d)", len(args)-2, len(args)-1, len(args))
+	}
+	args = append(args, opts.Limit+1)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement
+		FROM repair_queue
+		WHERE true`+conditions+`
+		ORDER BY segment_health ASC, stream_id ASC, position ASC
+		LIMIT $`+strconv.Itoa(len(args)), args...)
+	if err != nil {
+		return queue.ListResult{}, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var seg queue.InjuredSegment
+		err = rows.Scan(&seg.StreamID, &seg.Position, &seg.AttemptedAt,
+			&seg.UpdatedAt, &seg.InsertedAt, &seg.SegmentHealth, &seg.Placement)
+		if err != nil {
+			return queue.ListResult{}, Error.Wrap(err)
+		}
+		result.Segments = append(result.Segments, seg)
+	}
+	if err := rows.Err(); err != nil {
+		return queue.ListResult{}, Error.Wrap(err)
+	}
+
+	if len(result.Segments) > opts.Limit {
+		result.Segments = result.Segments[:opts.Limit]
+		result.More = true
+	}
+	return result, nil
+}
+
+func (r *repairQueue) UpdateSegmentHealth(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, segmentHealth float64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE repair_queue SET segment_health = $3, health_overridden = true
+		WHERE stream_id = $1 AND position = $2
+	`, streamID, position.Encode(), segmentHealth)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if count == 0 {
+		return queue.ErrSegmentNotFound.New("%s/%d", streamID, position.Encode())
+	}
+	return nil
+}
+
 func (r *repairQueue) Delete(ctx context.Context, seg *queue.InjuredSegment) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	_, err = r.db.ExecContext(ctx, r.db.Rebind(`DELETE FROM repair_queue WHERE stream_id = ? AND position = ?`), seg.StreamID, seg.Position.Encode())
@@ -179,3 +277,21 @@ func (r *repairQueue) TestingSetAttemptedTime(ctx context.Context, streamID uuid
 	count, err := res.RowsAffected()
 	return count, Error.Wrap(err)
 }
+
+// repairQueueFilter returns the SQL conditions and the arguments matching the filter,
+// the conditions are appended to a WHERE clause.
+func repairQueueFilter(filter queue.Filter, args []interface{}) (conditions string, _ []interface{}) {
+	if len(filter.Placements) > 0 {
+		placements := make([]int32, len(filter.Placements))
+		for i, placement := range filter.Placements {
+			placements[i] = int32(placement)
+		}
+		args = append(args, pgutil.Int4Array(placements))
+		conditions += fmt.Sprintf(" AND placement = any($%d::int4[])", len(args))
+	}
+	if !filter.StreamID.IsZero() {
+		args = append(args, filter.StreamID)
+		conditions += fmt.Sprintf(" AND stream_id = $%d", len(args))
+	}
+	return conditions, args
+}
@@ -192,6 +192,21 @@ func (reputations *reputations) DisqualifyNode(ctx context.Context, nodeID storj
 	return Error.Wrap(err)
 }
 
+// ReinstateNode removes the disqualification of a storage node and resets its
+// audit reputation, so the next failed audit doesn't disqualify it again.
+func (reputations *reputations) ReinstateNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updateFields := dbx.Reputation_Update_Fields{}
+	updateFields.Disqualified = dbx.Reputation_Disqualified_Null()
+	updateFields.AuditReputationAlpha = dbx.Reputation_AuditReputationAlpha(1)
+	updateFields.AuditReputationBeta = dbx.Reputation_AuditReputationBeta(0)
+
+	// nodes without a reputation entry have nothing to reinstate
+	_, err = reputations.db.Update_Reputation_By_Id(ctx, dbx.Reputation_Id(nodeID.Bytes()), updateFields)
+	return Error.Wrap(err)
+}
+
 // SuspendNodeUnknownAudit suspends a storage node for unknown audits.
 func (reputations *reputations) SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
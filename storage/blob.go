@@ -89,6 +89,9 @@ type Blobs interface {
 	RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error)
 	// EmptyTrash removes all files in trash that were moved to trash prior to trashedBefore and returns the total bytes emptied and keys deleted.
 	EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (int64, [][]byte, error)
+	// Quarantine moves a blob of a specific storage format out of the regular storage, so it's
+	// no longer served, but kept for inspection.
+	Quarantine(ctx context.Context, ref BlobRef, formatVer FormatVersion) error
 	// Stat looks up disk metadata on the blob file.
 	Stat(ctx context.Context, ref BlobRef) (BlobInfo, error)
 	// StatWithStorageFormat looks up disk metadata for the blob file with the given storage format
@@ -75,7 +75,12 @@ func NewDir(log *zap.Logger, path string) (*Dir, error) {
 		trashnow: time.Now,
 	}
 
-	return dir, errs.Combine(
+	return dir, dir.createDirs()
+}
+
+// createDirs creates the sub-directories for storing blobs.
+func (dir *Dir) createDirs() error {
+	return errs.Combine(
 		os.MkdirAll(dir.blobsdir(), dirPermission),
 		os.MkdirAll(dir.tempdir(), dirPermission),
 		os.MkdirAll(dir.garbagedir(), dirPermission),
@@ -98,6 +103,9 @@ func (dir *Dir) garbagedir() string { return filepath.Join(dir.path, "garbage")
 // trashdir contains files staged for deletion for a period of time.
 func (dir *Dir) trashdir() string { return filepath.Join(dir.path, "trash") }
 
+// quarantinedir contains corrupted files, which are kept for inspection.
+func (dir *Dir) quarantinedir() string { return filepath.Join(dir.path, "quarantine") }
+
 // CreateVerificationFile creates a file to be used for storage directory verification.
 func (dir *Dir) CreateVerificationFile(id storj.NodeID) error {
 	f, err := os.Create(filepath.Join(dir.path, verificationFileName))
@@ -411,6 +419,38 @@ func (dir *Dir) TrashWithStorageFormat(ctx context.Context, ref storage.BlobRef,
 	return err
 }
 
+// Quarantine moves the piece specified by ref to the quarantinedir for the specified format version.
+// The quarantined files are never restored or deleted automatically.
+func (dir *Dir) Quarantine(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	blobsBasePath, err := dir.blobToBasePath(ref)
+	if err != nil {
+		return err
+	}
+
+	quarantineBasePath, err := dir.refToDirPath(ref, dir.quarantinedir())
+	if err != nil {
+		return err
+	}
+
+	blobsVerPath := blobPathForFormatVersion(blobsBasePath, formatVer)
+	quarantineVerPath := blobPathForFormatVersion(quarantineBasePath, formatVer)
+
+	// ensure the dirs exist for quarantine path
+	err = os.MkdirAll(filepath.Dir(quarantineVerPath), dirPermission)
+	if err != nil && !os.IsExist(err) {
+		return err
+	}
+
+	err = rename(blobsVerPath, quarantineVerPath)
+	if os.IsNotExist(err) {
+		// the piece was deleted or moved concurrently
+		return nil
+	}
+	return err
+}
+
 // ReplaceTrashnow is a helper for tests to replace the trashnow function used
 // when moving files to the trash.
 func (dir *Dir) ReplaceTrashnow(trashnow func() time.Time) {
@@ -0,0 +1,713 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storage"
+)
+
+// additionalDirsFileName is the name of the file in the primary directory, which lists
+// the additional directories that have been initialized for the node.
+const additionalDirsFileName = "storage-dir-additional"
+
+var _ storage.Blobs = (*multiBlobStore)(nil)
+
+// DirStatus describes the state of a single directory of a blob store.
+type DirStatus struct {
+	Path string
+	// Available is whether new blobs are stored in the directory.
+	Available bool
+	// Err is the reason why the directory is not available.
+	Err error
+	// UsedSpace is an approximation of the space used by blobs in the directory.
+	UsedSpace int64
+	// FreeSpace is the space available on the disk of the directory.
+	FreeSpace int64
+}
+
+// multiBlobStore implements a blob store, which keeps blobs in multiple directories.
+//
+// New blobs are created in the available directory with the most free space and
+// existing blobs are looked up in all readable directories. A directory failing the
+// verification or writability check is excluded until it passes the check again.
+type multiBlobStore struct {
+	log    *zap.Logger
+	config Config
+
+	dirs []*multiDir
+
+	// registry contains additional directories that have been initialized.
+	registryMu sync.Mutex
+	registry   map[string]struct{}
+}
+
+// multiDir is a single directory of multiBlobStore.
+type multiDir struct {
+	store   *blobStore
+	primary bool
+
+	mu        sync.Mutex
+	verifyErr error // reason why the directory is not readable
+	writeErr  error // reason why the directory is not writable
+	used      int64
+	usedKnown bool
+}
+
+// errNotVerified is the initial state of additional directories.
+var errNotVerified = Error.New("storage directory has not been verified yet")
+
+// NewMulti creates a blob store, which keeps blobs in the primary directory and in the
+// additional directories. The additional directories are used only after they have
+// been verified. When a directory is verified for the first time, its blob directories
+// and verification file are created.
+func NewMulti(log *zap.Logger, primary *Dir, additionalPaths []string, config Config) (storage.Blobs, error) {
+	store := &multiBlobStore{
+		log:      log,
+		config:   config,
+		registry: map[string]struct{}{},
+	}
+
+	store.dirs = append(store.dirs, &multiDir{
+		store:   &blobStore{dir: primary, log: log, config: config},
+		primary: true,
+	})
+
+	for _, path := range additionalPaths {
+		path, err := filepath.Abs(path)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		dir := &Dir{
+			log:      log,
+			path:     path,
+			trashnow: time.Now,
+		}
+		store.dirs = append(store.dirs, &multiDir{
+			store:     &blobStore{dir: dir, log: log, config: config},
+			verifyErr: errNotVerified,
+		})
+	}
+
+	if err := store.loadRegistry(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return store, nil
+}
+
+// readable returns whether blobs can be read from the directory.
+func (dir *multiDir) readable() bool {
+	dir.mu.Lock()
+	defer dir.mu.Unlock()
+	return dir.verifyErr == nil
+}
+
+// status returns the availability of the directory.
+func (dir *multiDir) status() (available bool, err error) {
+	dir.mu.Lock()
+	defer dir.mu.Unlock()
+	if dir.verifyErr != nil {
+		return false, dir.verifyErr
+	}
+	if dir.writeErr != nil {
+		return false, dir.writeErr
+	}
+	return true, nil
+}
+
+// addUsed updates the space used by blobs in the directory.
+func (dir *multiDir) addUsed(delta int64) {
+	dir.mu.Lock()
+	defer dir.mu.Unlock()
+	dir.used += delta
+	if dir.used < 0 {
+		dir.used = 0
+	}
+}
+
+// setUsed sets the space used by blobs in the directory.
+func (dir *multiDir) setUsed(used int64) {
+	dir.mu.Lock()
+	defer dir.mu.Unlock()
+	dir.used = used
+	dir.usedKnown = true
+}
+
+// forgetUsed marks the space used by blobs unknown, so it's calculated again.
+func (dir *multiDir) forgetUsed() {
+	dir.mu.Lock()
+	defer dir.mu.Unlock()
+	dir.used = 0
+	dir.usedKnown = false
+}
+
+// anyFormat is used with sizeOf to look for the blob of any storage format.
+const anyFormat storage.FormatVersion = -1
+
+// sizeOf returns the size of the blob in the directory, 0 when it doesn't exist.
+func (dir *multiDir) sizeOf(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) int64 {
+	var info storage.BlobInfo
+	var err error
+	if formatVer == anyFormat {
+		info, err = dir.store.Stat(ctx, ref)
+	} else {
+		info, err = dir.store.StatWithStorageFormat(ctx, ref, formatVer)
+	}
+	if err != nil {
+		return 0
+	}
+	stat, err := info.Stat(ctx)
+	if err != nil {
+		return 0
+	}
+	return stat.Size()
+}
+
+// Close closes the store.
+func (store *multiBlobStore) Close() error { return nil }
+
+// readableDirs returns directories, which can be read from.
+func (store *multiBlobStore) readableDirs() []*multiDir {
+	var dirs []*multiDir
+	for _, dir := range store.dirs {
+		if dir.readable() {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// find calls fn for the readable directories until it succeeds. When fn fails for all
+// directories, it returns the first error that isn't caused by a missing blob.
+func (store *multiBlobStore) find(fn func(dir *multiDir) error) error {
+	var firstErr error
+	notExistErr := error(os.ErrNotExist)
+	for _, dir := range store.readableDirs() {
+		err := fn(dir)
+		if err == nil {
+			return nil
+		}
+		if errs.IsFunc(err, os.IsNotExist) {
+			notExistErr = err
+			continue
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	return notExistErr
+}
+
+// Create creates a new blob in the available directory with the most free space.
+func (store *multiBlobStore) Create(ctx context.Context, ref storage.BlobRef, size int64) (_ storage.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var selected *multiDir
+	var selectedFree int64
+	for _, dir := range store.dirs {
+		if available, _ := dir.status(); !available {
+			continue
+		}
+		info, err := dir.store.dir.Info()
+		if err != nil {
+			store.log.Error("unable to get free space of storage directory", zap.String("Path", dir.store.dir.Path()), zap.Error(err))
+			continue
+		}
+		if selected == nil || info.AvailableSpace > selectedFree {
+			selected, selectedFree = dir, info.AvailableSpace
+		}
+	}
+	if selected == nil {
+		return nil, Error.New("no storage directory available")
+	}
+
+	writer, err := selected.store.Create(ctx, ref, size)
+	if err != nil {
+		return nil, err
+	}
+	return &multiBlobWriter{BlobWriter: writer, dir: selected}, nil
+}
+
+// TestCreateV0 creates a new V0 blob in the primary directory. This is ONLY appropriate in test situations.
+func (store *multiBlobStore) TestCreateV0(ctx context.Context, ref storage.BlobRef) (_ storage.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+	primary := store.dirs[0]
+	writer, err := primary.store.TestCreateV0(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return &multiBlobWriter{BlobWriter: writer, dir: primary}, nil
+}
+
+// Open loads the blob from the directory, which contains it.
+func (store *multiBlobStore) Open(ctx context.Context, ref storage.BlobRef) (reader storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.find(func(dir *multiDir) (err error) {
+		reader, err = dir.store.Open(ctx, ref)
+		return err
+	})
+	return reader, err
+}
+
+// OpenWithStorageFormat loads the already-located blob from the directory, which contains it.
+func (store *multiBlobStore) OpenWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (reader storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.find(func(dir *multiDir) (err error) {
+		reader, err = dir.store.OpenWithStorageFormat(ctx, ref, formatVer)
+		return err
+	})
+	return reader, err
+}
+
+// Stat looks up disk metadata on the blob file.
+func (store *multiBlobStore) Stat(ctx context.Context, ref storage.BlobRef) (info storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.find(func(dir *multiDir) (err error) {
+		info, err = dir.store.Stat(ctx, ref)
+		return err
+	})
+	return info, err
+}
+
+// StatWithStorageFormat looks up disk metadata on the blob file with the given storage format version.
+func (store *multiBlobStore) StatWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (info storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.find(func(dir *multiDir) (err error) {
+		info, err = dir.store.StatWithStorageFormat(ctx, ref, formatVer)
+		return err
+	})
+	return info, err
+}
+
+// Delete deletes the blob with the specified ref from all directories.
+func (store *multiBlobStore) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, dir := range store.readableDirs() {
+		size := dir.sizeOf(ctx, ref, anyFormat)
+		if err := dir.store.Delete(ctx, ref); err != nil {
+			group.Add(err)
+			continue
+		}
+		dir.addUsed(-size)
+	}
+	return group.Err()
+}
+
+// DeleteWithStorageFormat deletes the blob with the specified ref and storage format version from all directories.
+func (store *multiBlobStore) DeleteWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, dir := range store.readableDirs() {
+		size := dir.sizeOf(ctx, ref, formatVer)
+		if err := dir.store.DeleteWithStorageFormat(ctx, ref, formatVer); err != nil {
+			group.Add(err)
+			continue
+		}
+		dir.addUsed(-size)
+	}
+	return group.Err()
+}
+
+// DeleteNamespace deletes the blobs of the namespace from all directories.
+func (store *multiBlobStore) DeleteNamespace(ctx context.Context, ref []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, dir := range store.readableDirs() {
+		group.Add(dir.store.DeleteNamespace(ctx, ref))
+		dir.forgetUsed()
+	}
+	return group.Err()
+}
+
+// Trash moves the blob to the trash of the directory, which contains it.
+func (store *multiBlobStore) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, dir := range store.readableDirs() {
+		size := dir.sizeOf(ctx, ref, anyFormat)
+		if err := dir.store.Trash(ctx, ref); err != nil {
+			group.Add(err)
+			continue
+		}
+		dir.addUsed(-size)
+	}
+	return group.Err()
+}
+
+// RestoreTrash restores the trash of the namespace in all directories.
+func (store *multiBlobStore) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, dir := range store.readableDirs() {
+		keys, err := dir.store.RestoreTrash(ctx, namespace)
+		group.Add(err)
+		for _, key := range keys {
+			dir.addUsed(dir.sizeOf(ctx, storage.BlobRef{Namespace: namespace, Key: key}, anyFormat))
+		}
+		keysRestored = append(keysRestored, keys...)
+	}
+	return keysRestored, group.Err()
+}
+
+// EmptyTrash empties the trash of the namespace in all directories.
+func (store *multiBlobStore) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, dir := range store.readableDirs() {
+		dirBytesEmptied, dirKeys, err := dir.store.EmptyTrash(ctx, namespace, trashedBefore)
+		group.Add(err)
+		bytesEmptied += dirBytesEmptied
+		keys = append(keys, dirKeys...)
+	}
+	return bytesEmptied, keys, group.Err()
+}
+
+// Quarantine moves the blob to the quarantine of the directory, which contains it.
+func (store *multiBlobStore) Quarantine(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, dir := range store.readableDirs() {
+		size := dir.sizeOf(ctx, ref, formatVer)
+		if err := dir.store.Quarantine(ctx, ref, formatVer); err != nil {
+			group.Add(err)
+			continue
+		}
+		dir.addUsed(-size)
+	}
+	return group.Err()
+}
+
+// GarbageCollect tries to delete any files that haven't yet been deleted.
+func (store *multiBlobStore) GarbageCollect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, dir := range store.readableDirs() {
+		group.Add(dir.store.GarbageCollect(ctx))
+	}
+	return group.Err()
+}
+
+// SpaceUsedForBlobs adds up the space used in all namespaces of all directories.
+func (store *multiBlobStore) SpaceUsedForBlobs(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, dir := range store.readableDirs() {
+		used, err := dir.store.SpaceUsedForBlobs(ctx)
+		if err != nil {
+			return 0, err
+		}
+		dir.setUsed(used)
+		total += used
+	}
+	return total, nil
+}
+
+// SpaceUsedForBlobsInNamespace adds up how much is used in the given namespace in all directories.
+func (store *multiBlobStore) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, dir := range store.readableDirs() {
+		used, err := dir.store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+		if err != nil {
+			return 0, err
+		}
+		total += used
+	}
+	return total, nil
+}
+
+// SpaceUsedForTrash returns the total space used by the trash of all directories.
+func (store *multiBlobStore) SpaceUsedForTrash(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, dir := range store.readableDirs() {
+		used, err := dir.store.SpaceUsedForTrash(ctx)
+		if err != nil {
+			return 0, err
+		}
+		total += used
+	}
+	return total, nil
+}
+
+// FreeSpace returns how much space is left on the disks of the available directories.
+// Directories on the same disk are counted once.
+func (store *multiBlobStore) FreeSpace() (int64, error) {
+	var total int64
+	var group errs.Group
+	disks := map[string]struct{}{}
+	found := false
+	for _, dir := range store.dirs {
+		if available, _ := dir.status(); !available {
+			continue
+		}
+		info, err := dir.store.dir.Info()
+		if err != nil {
+			group.Add(err)
+			continue
+		}
+		found = true
+		if _, ok := disks[info.ID]; ok && info.ID != "" {
+			continue
+		}
+		disks[info.ID] = struct{}{}
+		total += info.AvailableSpace
+	}
+	if !found {
+		if err := group.Err(); err != nil {
+			return 0, err
+		}
+		return 0, Error.New("no storage directory available")
+	}
+	return total, nil
+}
+
+// CheckWritability tests writability of every directory. The directories that are not
+// writable are excluded from storing new blobs. It returns an error only when no
+// directory is writable.
+func (store *multiBlobStore) CheckWritability() error {
+	var group errs.Group
+	writable := false
+	for _, dir := range store.dirs {
+		err := dir.store.CheckWritability()
+
+		dir.mu.Lock()
+		changed := (err == nil) != (dir.writeErr == nil)
+		dir.writeErr = err
+		dir.mu.Unlock()
+
+		if err != nil {
+			if changed {
+				store.log.Error("storage directory is not writable", zap.String("Path", dir.store.dir.Path()), zap.Error(err))
+			}
+			group.Add(err)
+			continue
+		}
+		if changed {
+			store.log.Info("storage directory is writable again", zap.String("Path", dir.store.dir.Path()))
+		}
+		writable = true
+	}
+	if !writable {
+		return group.Err()
+	}
+	return nil
+}
+
+// ListNamespaces finds all known namespace IDs in all directories.
+func (store *multiBlobStore) ListNamespaces(ctx context.Context) (ids [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	seen := map[string]struct{}{}
+	for _, dir := range store.readableDirs() {
+		namespaces, err := dir.store.ListNamespaces(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, namespace := range namespaces {
+			if _, ok := seen[string(namespace)]; ok {
+				continue
+			}
+			seen[string(namespace)] = struct{}{}
+			ids = append(ids, namespace)
+		}
+	}
+	return ids, nil
+}
+
+// WalkNamespace executes walkFunc for each locally stored blob in the given namespace of
+// every directory. If walkFunc returns a non-nil error, WalkNamespace will stop iterating
+// and return the error immediately.
+func (store *multiBlobStore) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	for _, dir := range store.readableDirs() {
+		if err := dir.store.WalkNamespace(ctx, namespace, walkFunc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateVerificationFile creates the verification file in every directory, initializing
+// the additional directories.
+func (store *multiBlobStore) CreateVerificationFile(id storj.NodeID) error {
+	var group errs.Group
+	for _, dir := range store.dirs {
+		if dir.primary {
+			group.Add(dir.store.CreateVerificationFile(id))
+			continue
+		}
+		group.Add(store.initialize(dir, id))
+	}
+	return group.Err()
+}
+
+// VerifyStorageDir verifies every directory. The directories failing the verification
+// are excluded until they pass it again. Additional directories, which have not been
+// initialized yet, are initialized. It returns an error only when no directory passes
+// the verification.
+func (store *multiBlobStore) VerifyStorageDir(id storj.NodeID) error {
+	var group errs.Group
+	verified := false
+	for _, dir := range store.dirs {
+		err := dir.store.VerifyStorageDir(id)
+		if err != nil && !dir.primary && errs.IsFunc(err, os.IsNotExist) && !store.registered(dir) {
+			err = store.initialize(dir, id)
+		}
+
+		dir.mu.Lock()
+		changed := (err == nil) != (dir.verifyErr == nil)
+		dir.verifyErr = err
+		dir.mu.Unlock()
+
+		if err != nil {
+			if changed {
+				store.log.Error("storage directory verification failed", zap.String("Path", dir.store.dir.Path()), zap.Error(err))
+			}
+			group.Add(err)
+			continue
+		}
+		if changed {
+			store.log.Info("storage directory verified", zap.String("Path", dir.store.dir.Path()))
+		}
+		verified = true
+	}
+	if !verified {
+		return group.Err()
+	}
+	return nil
+}
+
+// DirStatuses returns the state of every directory of the blob store.
+func (store *multiBlobStore) DirStatuses(ctx context.Context) (_ []DirStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statuses := make([]DirStatus, 0, len(store.dirs))
+	for _, dir := range store.dirs {
+		status := DirStatus{Path: dir.store.dir.Path()}
+		status.Available, status.Err = dir.status()
+
+		if dir.readable() {
+			dir.mu.Lock()
+			usedKnown := dir.usedKnown
+			dir.mu.Unlock()
+
+			if !usedKnown {
+				used, err := dir.store.SpaceUsedForBlobs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				dir.setUsed(used)
+			}
+		}
+
+		dir.mu.Lock()
+		status.UsedSpace = dir.used
+		dir.mu.Unlock()
+
+		if info, err := dir.store.dir.Info(); err == nil {
+			status.FreeSpace = info.AvailableSpace
+		}
+
+		mon.IntVal("storage_dir_used_space", monkit.NewSeriesTag("dir", status.Path)).Observe(status.UsedSpace)
+		mon.IntVal("storage_dir_free_space", monkit.NewSeriesTag("dir", status.Path)).Observe(status.FreeSpace)
+
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// initialize creates the blob directories and the verification file of an additional
+// directory and registers it as initialized.
+func (store *multiBlobStore) initialize(dir *multiDir, id storj.NodeID) error {
+	if err := dir.store.dir.createDirs(); err != nil {
+		return err
+	}
+	if err := dir.store.CreateVerificationFile(id); err != nil {
+		return err
+	}
+	store.log.Info("initialized storage directory", zap.String("Path", dir.store.dir.Path()))
+	return store.register(dir)
+}
+
+// registered returns whether the additional directory has been initialized before.
+// An initialized directory missing the verification file is most likely a disk that
+// is not mounted, hence it's not initialized again.
+func (store *multiBlobStore) registered(dir *multiDir) bool {
+	store.registryMu.Lock()
+	defer store.registryMu.Unlock()
+	_, ok := store.registry[dir.store.dir.Path()]
+	return ok
+}
+
+// register adds the additional directory to the registry in the primary directory.
+func (store *multiBlobStore) register(dir *multiDir) error {
+	store.registryMu.Lock()
+	defer store.registryMu.Unlock()
+
+	if _, ok := store.registry[dir.store.dir.Path()]; ok {
+		return nil
+	}
+	store.registry[dir.store.dir.Path()] = struct{}{}
+
+	var content bytes.Buffer
+	for path := range store.registry {
+		content.WriteString(path)
+		content.WriteByte('\n')
+	}
+	return ioutil.WriteFile(store.registryPath(), content.Bytes(), blobPermission)
+}
+
+// loadRegistry loads the initialized additional directories from the primary directory.
+func (store *multiBlobStore) loadRegistry() error {
+	content, err := ioutil.ReadFile(store.registryPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if path := strings.TrimSpace(scanner.Text()); path != "" {
+			store.registry[path] = struct{}{}
+		}
+	}
+	return scanner.Err()
+}
+
+func (store *multiBlobStore) registryPath() string {
+	return filepath.Join(store.dirs[0].store.dir.Path(), additionalDirsFileName)
+}
+
+// multiBlobWriter tracks the space used by the directory it's writing to.
+type multiBlobWriter struct {
+	storage.BlobWriter
+	dir *multiDir
+}
+
+// Commit commits the blob and adds its size to the space used by the directory.
+func (writer *multiBlobWriter) Commit(ctx context.Context) error {
+	size, sizeErr := writer.Size()
+	err := writer.BlobWriter.Commit(ctx)
+	if err == nil && sizeErr == nil {
+		writer.dir.addUsed(size)
+	}
+	return err
+}
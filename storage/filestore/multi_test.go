@@ -0,0 +1,122 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+func TestMultiStore(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+	nodeID := testrand.NodeID()
+
+	primaryPath := ctx.Dir("primary")
+	additionalPath := ctx.Dir("additional")
+
+	primary, err := filestore.NewDir(log, primaryPath)
+	require.NoError(t, err)
+
+	openStore := func() storage.Blobs {
+		store, err := filestore.NewMulti(log, primary, []string{additionalPath}, filestore.DefaultConfig)
+		require.NoError(t, err)
+		return store
+	}
+
+	store := openStore()
+	defer ctx.Check(store.Close)
+
+	require.NoError(t, store.CreateVerificationFile(nodeID))
+	require.NoError(t, store.VerifyStorageDir(nodeID))
+	require.FileExists(t, filepath.Join(additionalPath, "storage-dir-verification"))
+
+	namespace := testrand.Bytes(32)
+	var refs []storage.BlobRef
+	for i := 0; i < 10; i++ {
+		ref := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		refs = append(refs, ref)
+
+		writer, err := store.Create(ctx, ref, -1)
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+	}
+
+	for _, ref := range refs {
+		reader, err := store.Open(ctx, ref)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.Len(t, data, memory.KiB.Int())
+		require.NoError(t, reader.Close())
+	}
+
+	total, err := store.SpaceUsedForBlobs(ctx)
+	require.NoError(t, err)
+
+	statuses, err := store.(dirStatuser).DirStatuses(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+
+	var used int64
+	for _, status := range statuses {
+		require.True(t, status.Available)
+		require.NoError(t, status.Err)
+		used += status.UsedSpace
+	}
+	require.Equal(t, total, used)
+
+	// simulate a disk that is not mounted anymore
+	require.NoError(t, os.RemoveAll(additionalPath))
+	require.NoError(t, os.Mkdir(additionalPath, 0700))
+
+	// reopen the store to check that the initialized directories are remembered
+	require.NoError(t, store.Close())
+	store = openStore()
+
+	require.NoError(t, store.VerifyStorageDir(nodeID))
+	require.NoFileExists(t, filepath.Join(additionalPath, "storage-dir-verification"))
+
+	statuses, err = store.(dirStatuser).DirStatuses(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	require.True(t, statuses[0].Available)
+	require.False(t, statuses[1].Available)
+	require.Error(t, statuses[1].Err)
+
+	// new blobs are stored in the remaining directory
+	ref := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	writer, err := store.Create(ctx, ref, -1)
+	require.NoError(t, err)
+	_, err = writer.Write(testrand.Bytes(memory.KiB))
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+
+	info, err := store.Stat(ctx, ref)
+	require.NoError(t, err)
+	path, err := info.FullPath(ctx)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(path, primaryPath))
+}
+
+type dirStatuser interface {
+	DirStatuses(ctx context.Context) ([]filestore.DirStatus, error)
+}
@@ -154,6 +154,12 @@ func (store *blobStore) EmptyTrash(ctx context.Context, namespace []byte, trashe
 	return bytesEmptied, keys, Error.Wrap(err)
 }
 
+// Quarantine moves the ref of a specific storage format to the quarantine directory.
+func (store *blobStore) Quarantine(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(store.dir.Quarantine(ctx, ref, formatVer))
+}
+
 // GarbageCollect tries to delete any files that haven't yet been deleted.
 func (store *blobStore) GarbageCollect(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -191,6 +191,16 @@ func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 		FreeDisk: freeSpace,
 	})
 
+	dirs, err := service.store.DirStatuses(ctx)
+	if err != nil {
+		service.log.Error("unable to get status of storage directories", zap.Error(err))
+	}
+	for _, dir := range dirs {
+		if !dir.Available {
+			service.log.Warn("storage directory is not available", zap.String("Path", dir.Path), zap.Error(dir.Err))
+		}
+	}
+
 	return nil
 }
 
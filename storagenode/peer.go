@@ -59,6 +59,7 @@ import (
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/scrub"
 	"storj.io/storj/storagenode/storagenodedb"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
@@ -111,6 +112,7 @@ type Config struct {
 	Storage   piecestore.OldConfig
 	Storage2  piecestore.Config
 	Collector collector.Config
+	Scrub     scrub.Config
 
 	Filestore filestore.Config
 
@@ -141,6 +143,8 @@ func (config *Config) DatabaseConfig() storagenodedb.Config {
 		Info2:     filepath.Join(dbdir, "info.db"),
 		Pieces:    config.Storage.Path,
 		Filestore: config.Filestore,
+
+		AdditionalPieces: config.Storage.AdditionalPaths,
 	}
 }
 
@@ -247,6 +251,8 @@ type Peer struct {
 
 	Collector *collector.Service
 
+	Scrub *scrub.Service
+
 	NodeStats struct {
 		Service *nodestats.Service
 		Cache   *nodestats.Cache
@@ -769,6 +775,18 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 	peer.Debug.Server.Panel.Add(
 		debug.Cycle("Collector", peer.Collector.Loop))
 
+	peer.Scrub = scrub.NewService(peer.Log.Named("scrub"), peer.Identity.ID, peer.Storage2.Store, peer.Notifications.Service,
+		filepath.Join(config.Storage.Path, "scrub-checkpoint.json"), config.Scrub)
+	if config.Scrub.Enabled {
+		peer.Services.Add(lifecycle.Item{
+			Name:  "scrub",
+			Run:   peer.Scrub.Run,
+			Close: peer.Scrub.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Scrub", peer.Scrub.Loop))
+	}
+
 	peer.Bandwidth = bandwidth.NewService(peer.Log.Named("bandwidth"), peer.DB.Bandwidth(), config.Bandwidth)
 	peer.Services.Add(lifecycle.Item{
 		Name:  "bandwidth",
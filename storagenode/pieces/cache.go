@@ -300,6 +300,35 @@ func (blobs *BlobsUsageCache) Trash(ctx context.Context, blobRef storage.BlobRef
 	return nil
 }
 
+// Quarantine moves the piece out of the regular storage and updates the cache.
+func (blobs *BlobsUsageCache) Quarantine(ctx context.Context, blobRef storage.BlobRef, formatVer storage.FormatVersion) error {
+	blobInfo, err := blobs.StatWithStorageFormat(ctx, blobRef, formatVer)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	pieceAccess, err := newStoredPieceAccess(nil, blobInfo)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	pieceTotal, pieceContentSize, err := pieceAccess.Size(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = blobs.Blobs.Quarantine(ctx, blobRef, formatVer)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	satelliteID, err := storj.NodeIDFromBytes(blobRef.Namespace)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	blobs.Update(ctx, satelliteID, -pieceTotal, -pieceContentSize, 0)
+	return nil
+}
+
 // EmptyTrash empties the trash and updates the cache.
 func (blobs *BlobsUsageCache) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (int64, [][]byte, error) {
 	satelliteID, err := storj.NodeIDFromBytes(namespace)
@@ -360,6 +360,33 @@ func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID s
 	return Error.Wrap(err)
 }
 
+// Quarantine moves a corrupted piece of the specified storage format out of the regular
+// storage. The piece won't be served anymore, but it's kept on disk for inspection.
+func (store *Store) Quarantine(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, formatVersion storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = store.blobs.Quarantine(ctx, storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	}, formatVersion)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the piece is not stored anymore, hence it doesn't need to expire.
+	if store.expirationInfo != nil {
+		_, err = store.expirationInfo.DeleteExpiration(ctx, satellite, pieceID)
+	}
+	if formatVersion == filestore.FormatV0 && store.v0PieceInfo != nil {
+		err = errs.Combine(err, store.v0PieceInfo.Delete(ctx, satellite, pieceID))
+	}
+
+	store.log.Warn("quarantined piece", zap.Stringer("Satellite ID", satellite),
+		zap.Stringer("Piece ID", pieceID))
+
+	return Error.Wrap(err)
+}
+
 // EmptyTrash deletes pieces in the trash that have been in there longer than trashExpiryInterval.
 func (store *Store) EmptyTrash(ctx context.Context, satelliteID storj.NodeID, trashedBefore time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -561,7 +588,7 @@ func (store *Store) SpaceUsedForPieces(ctx context.Context) (piecesTotal int64,
 	if cache, ok := store.blobs.(*BlobsUsageCache); ok {
 		return cache.SpaceUsedForPieces(ctx)
 	}
-	satellites, err := store.getAllStoringSatellites(ctx)
+	satellites, err := store.StoringSatellites(ctx)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -599,7 +626,8 @@ func (store *Store) SpaceUsedForPiecesAndTrash(ctx context.Context) (int64, erro
 	return piecesTotal + trashTotal, nil
 }
 
-func (store *Store) getAllStoringSatellites(ctx context.Context) ([]storj.NodeID, error) {
+// StoringSatellites returns the satellites, which have pieces stored on this node.
+func (store *Store) StoringSatellites(ctx context.Context) ([]storj.NodeID, error) {
 	namespaces, err := store.blobs.ListNamespaces(ctx)
 	if err != nil {
 		return nil, err
@@ -647,7 +675,7 @@ func (store *Store) SpaceUsedBySatellite(ctx context.Context, satelliteID storj.
 func (store *Store) SpaceUsedTotalAndBySatellite(ctx context.Context) (piecesTotal, piecesContentSize int64, totalBySatellite map[storj.NodeID]SatelliteUsage, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	satelliteIDs, err := store.getAllStoringSatellites(ctx)
+	satelliteIDs, err := store.StoringSatellites(ctx)
 	if err != nil {
 		return 0, 0, nil, Error.New("failed to enumerate satellites: %w", err)
 	}
@@ -712,6 +740,25 @@ func (store *Store) CheckWritability() error {
 	return store.blobs.CheckWritability()
 }
 
+// DirStatuses returns the state of every storage directory, when the pieces are stored
+// in multiple directories. Otherwise it returns nil.
+func (store *Store) DirStatuses(ctx context.Context) (_ []filestore.DirStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	blobs := store.blobs
+	if cache, ok := blobs.(*BlobsUsageCache); ok {
+		blobs = cache.Blobs
+	}
+
+	multi, ok := blobs.(interface {
+		DirStatuses(ctx context.Context) ([]filestore.DirStatus, error)
+	})
+	if !ok {
+		return nil, nil
+	}
+	return multi.DirStatuses(ctx)
+}
+
 type storedPieceAccess struct {
 	storage.BlobInfo
 	store   *Store
@@ -4,8 +4,10 @@
 package piecestore
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"hash"
 	"io"
 	"os"
 	"sync/atomic"
@@ -22,11 +24,13 @@ import (
 	"storj.io/common/identity"
 	"storj.io/common/memory"
 	"storj.io/common/pb"
+	"storj.io/common/pkcrypto"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/rpc/rpctimeout"
 	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/storage"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
@@ -44,6 +48,7 @@ var (
 // OldConfig contains everything necessary for a server.
 type OldConfig struct {
 	Path                   string         `help:"path to store data in" default:"$CONFDIR/storage"`
+	AdditionalPaths        []string       `help:"additional paths to store pieces in, e.g. on other disks" default:""`
 	WhitelistedSatellites  storj.NodeURLs `help:"a comma-separated list of approved satellite node urls (unused)" devDefault:"" releaseDefault:""`
 	AllocatedDiskSpace     memory.Size    `user:"true" help:"total allocated disk space in bytes" default:"1TB"`
 	AllocatedBandwidth     memory.Size    `user:"true" help:"total allocated bandwidth in bytes (deprecated)" default:"0B"`
@@ -535,6 +540,15 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 		}
 		return rpcstatus.Wrap(rpcstatus.Internal, err)
 	}
+
+	// corrupted pieces are quarantined only after the piece reader is closed.
+	var corrupted bool
+	formatVersion := pieceReader.StorageFormatVersion()
+	defer func() {
+		if corrupted {
+			endpoint.quarantineCorrupted(context2.WithoutCancellation(ctx), limit.SatelliteId, limit.PieceId, formatVersion)
+		}
+	}()
 	defer func() {
 		err := pieceReader.Close() // similarly how transcation Rollback works
 		if err != nil {
@@ -548,6 +562,7 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 
 	// for repair traffic, send along the PieceHash and original OrderLimit for validation
 	// before sending the piece itself
+	var expectedHash []byte
 	if message.Limit.Action == pb.PieceAction_GET_REPAIR {
 		pieceHash, orderLimit, err := endpoint.store.GetHashAndLimit(ctx, limit.SatelliteId, limit.PieceId, pieceReader)
 		if err != nil {
@@ -562,6 +577,7 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 			endpoint.log.Error("error sending hash and order limit", zap.Error(err))
 			return rpcstatus.Wrap(rpcstatus.Internal, err)
 		}
+		expectedHash = pieceHash.Hash
 	}
 
 	// TODO: verify chunk.Size behavior logic with regards to reading all
@@ -574,6 +590,12 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 	throttle := sync2.NewThrottle()
 	// TODO: see whether this can be implemented without a goroutine
 
+	// when the whole piece is read, verify it against the stored hash to detect corruption on disk
+	var verifyHash hash.Hash
+	if expectedHash != nil && chunk.Offset == 0 && chunk.ChunkSize == pieceReader.Size() {
+		verifyHash = pkcrypto.NewHash()
+	}
+
 	group, ctx := errgroup.WithContext(ctx)
 	group.Go(func() (err error) {
 		var maximumChunkSize = 1 * memory.MiB.Int64()
@@ -603,6 +625,9 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 				endpoint.log.Error("error reading from piecereader", zap.Error(err))
 				return rpcstatus.Wrap(rpcstatus.Internal, err)
 			}
+			if verifyHash != nil {
+				_, _ = verifyHash.Write(chunkData)
+			}
 
 			err = rpctimeout.Run(ctx, endpoint.config.StreamOperationTimeout, func(_ context.Context) (err error) {
 				return stream.Send(&pb.PieceDownloadResponse{
@@ -624,6 +649,8 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 			currentOffset += chunkSize
 			unsentAmount -= chunkSize
 		}
+
+		corrupted = verifyHash != nil && !bytes.Equal(verifyHash.Sum(nil), expectedHash)
 		return nil
 	})
 
@@ -678,6 +705,17 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 	return rpcstatus.Wrap(rpcstatus.Internal, errs.Combine(sendErr, recvErr))
 }
 
+// quarantineCorrupted moves aside a piece, which content doesn't match its hash.
+func (endpoint *Endpoint) quarantineCorrupted(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, formatVersion storage.FormatVersion) {
+	mon.Meter("download_corrupted_piece").Mark(1)
+	endpoint.log.Error("piece is corrupted", zap.Stringer("Satellite ID", satellite), zap.Stringer("Piece ID", pieceID))
+
+	err := endpoint.store.Quarantine(ctx, satellite, pieceID, formatVersion)
+	if err != nil {
+		endpoint.log.Error("unable to quarantine piece", zap.Stringer("Satellite ID", satellite), zap.Stringer("Piece ID", pieceID), zap.Error(err))
+	}
+}
+
 // beginSaveOrder saves the order with all necessary information. It assumes it has been already verified.
 func (endpoint *Endpoint) beginSaveOrder(limit *pb.OrderLimit) (_commit func(ctx context.Context, order *pb.Order), err error) {
 	defer mon.Task()(nil)(&err)
@@ -0,0 +1,68 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrub
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"storj.io/common/fpath"
+)
+
+// checkpoint is the progress of scrubbing, persisted so a restarted node
+// continues verifying where it stopped instead of starting over.
+//
+// The pieces are walked one key prefix directory after another, in no
+// particular order, so the progress is tracked per directory.
+type checkpoint struct {
+	// Finished is when the last full pass over all the pieces completed.
+	Finished time.Time `json:"finished"`
+	// Satellites contains the satellites, which pieces were all verified in the current pass.
+	Satellites []string `json:"satellites,omitempty"`
+	// Prefixes contains the full paths of the verified key prefix directories of the
+	// satellite currently verified.
+	Prefixes []string `json:"prefixes,omitempty"`
+}
+
+// satelliteDone returns whether the pieces of the satellite were verified in the current pass.
+func (cp *checkpoint) satelliteDone(satellite string) bool {
+	for _, done := range cp.Satellites {
+		if done == satellite {
+			return true
+		}
+	}
+	return false
+}
+
+// loadCheckpoint reads the checkpoint, an empty checkpoint is returned when none was saved.
+func loadCheckpoint(path string) (cp checkpoint, err error) {
+	if path == "" {
+		return cp, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cp, nil
+		}
+		return cp, Error.Wrap(err)
+	}
+
+	return cp, Error.Wrap(json.Unmarshal(data, &cp))
+}
+
+// saveCheckpoint atomically replaces the saved checkpoint.
+func saveCheckpoint(path string, cp checkpoint) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(fpath.AtomicWriteFile(path, data, 0644))
+}
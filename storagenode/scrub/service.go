@@ -0,0 +1,334 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package scrub implements periodic verification of the pieces stored on the storage node.
+package scrub
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/memory"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/pieces"
+)
+
+var (
+	// Error is the default error class for the scrub package.
+	Error = errs.Class("scrub")
+	// ErrCorrupted is returned when the content of a stored piece doesn't match its hash.
+	ErrCorrupted = errs.Class("piece corrupted")
+
+	mon = monkit.Package()
+)
+
+// readBufferSize is the amount of piece data read at once while verifying.
+const readBufferSize = 256 * memory.KiB
+
+// checkInterval is how frequently the service checks whether a pass over the pieces is due.
+const checkInterval = time.Hour
+
+// Config defines parameters for the storage node piece scrubbing.
+type Config struct {
+	Enabled  bool          `help:"whether the stored pieces are periodically verified against their hashes" default:"true"`
+	Interval time.Duration `help:"how frequently all the stored pieces are verified" default:"168h0m0s"`
+	ReadRate memory.Size   `help:"maximum amount of piece data read per second while verifying, 0 means unlimited" default:"4MiB"`
+}
+
+// Stats contains the outcome of verifying the stored pieces.
+type Stats struct {
+	Verified  int64
+	Corrupted int64
+	Failed    int64
+}
+
+// Service verifies the stored pieces against their hashes and quarantines
+// the corrupted ones, so bit rot is found before it fails an audit.
+//
+// architecture: Chore
+type Service struct {
+	log            *zap.Logger
+	nodeID         storj.NodeID
+	store          *pieces.Store
+	notifications  *notifications.Service
+	limiter        *rate.Limiter
+	interval       time.Duration
+	checkpointPath string
+
+	Loop *sync2.Cycle
+}
+
+// NewService creates a new scrub service. The progress is saved to checkpointPath,
+// an empty path doesn't persist the progress.
+func NewService(log *zap.Logger, nodeID storj.NodeID, store *pieces.Store, notifications *notifications.Service, checkpointPath string, config Config) *Service {
+	limit, burst := rate.Inf, readBufferSize.Int()
+	if config.ReadRate > 0 {
+		limit = rate.Limit(config.ReadRate.Int())
+		if config.ReadRate.Int() > burst {
+			burst = config.ReadRate.Int()
+		}
+	}
+
+	loopInterval := checkInterval
+	if config.Interval < loopInterval {
+		loopInterval = config.Interval
+	}
+
+	return &Service{
+		log:            log,
+		nodeID:         nodeID,
+		store:          store,
+		notifications:  notifications,
+		limiter:        rate.NewLimiter(limit, burst),
+		interval:       config.Interval,
+		checkpointPath: checkpointPath,
+		Loop:           sync2.NewCycle(loopInterval),
+	}
+}
+
+// Run runs the scrub service.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		cp, err := loadCheckpoint(service.checkpointPath)
+		if err != nil {
+			service.log.Error("unable to load checkpoint", zap.Error(err))
+			return nil
+		}
+		// a pass in progress is continued, otherwise the next pass starts once the interval passed.
+		inProgress := len(cp.Satellites) > 0 || len(cp.Prefixes) > 0
+		if !inProgress && time.Since(cp.Finished) < service.interval {
+			return nil
+		}
+
+		stats, err := service.Scrub(ctx)
+		if err != nil {
+			service.log.Error("error during verifying pieces", zap.Error(err))
+		}
+		service.log.Info("verified pieces",
+			zap.Int64("verified", stats.Verified),
+			zap.Int64("corrupted", stats.Corrupted),
+			zap.Int64("failed", stats.Failed))
+		return nil
+	})
+}
+
+// Close stops the scrub service.
+func (service *Service) Close() (err error) {
+	service.Loop.Close()
+	return nil
+}
+
+// Scrub verifies all the stored pieces of every satellite and quarantines the corrupted ones.
+// It continues the pass interrupted the last time, the pieces verified before are skipped.
+func (service *Service) Scrub(ctx context.Context) (stats Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cp, err := loadCheckpoint(service.checkpointPath)
+	if err != nil {
+		return stats, err
+	}
+
+	satellites, err := service.store.StoringSatellites(ctx)
+	if err != nil {
+		return stats, Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, satellite := range satellites {
+		if cp.satelliteDone(satellite.String()) {
+			continue
+		}
+
+		satelliteStats, err := service.scrubSatellite(ctx, satellite, &cp)
+		stats.Verified += satelliteStats.Verified
+		stats.Corrupted += satelliteStats.Corrupted
+		stats.Failed += satelliteStats.Failed
+		if err != nil {
+			if ctx.Err() != nil {
+				return stats, ctx.Err()
+			}
+			group.Add(err)
+			continue
+		}
+
+		cp.Satellites = append(cp.Satellites, satellite.String())
+		cp.Prefixes = nil
+		if err := saveCheckpoint(service.checkpointPath, cp); err != nil {
+			group.Add(err)
+		}
+	}
+	if err := group.Err(); err != nil {
+		return stats, Error.Wrap(err)
+	}
+
+	return stats, saveCheckpoint(service.checkpointPath, checkpoint{Finished: time.Now()})
+}
+
+// scrubSatellite verifies the stored pieces of a single satellite, skipping the key
+// prefix directories already verified according to the checkpoint.
+func (service *Service) scrubSatellite(ctx context.Context, satellite storj.NodeID, cp *checkpoint) (stats Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	buffer := make([]byte, readBufferSize.Int())
+
+	defer func() {
+		if stats.Corrupted > 0 {
+			service.notifyCorrupted(ctx, satellite, stats.Corrupted)
+		}
+	}()
+
+	done := map[string]bool{}
+	for _, prefix := range cp.Prefixes {
+		done[prefix] = true
+	}
+
+	// the pieces of a key prefix directory are walked together, the directory is
+	// verified once the walk moves on to another one.
+	var current string
+	finishPrefix := func() error {
+		if current == "" || done[current] {
+			return nil
+		}
+		done[current] = true
+		cp.Prefixes = append(cp.Prefixes, current)
+		return saveCheckpoint(service.checkpointPath, *cp)
+	}
+
+	err = service.store.WalkSatellitePieces(ctx, satellite, func(access pieces.StoredPieceAccess) error {
+		path, err := access.FullPath(ctx)
+		if err != nil {
+			return err
+		}
+		// V0 pieces are walked after the others and can share their directories,
+		// they are verified on every pass. The same key prefix exists in every
+		// storage directory, so the directories are identified by their full path.
+		if access.StorageFormatVersion() >= filestore.FormatV1 {
+			prefix := filepath.Dir(path)
+			if prefix != current {
+				if err := finishPrefix(); err != nil {
+					return err
+				}
+				current = prefix
+			}
+			if done[prefix] {
+				return nil
+			}
+		} else if err := finishPrefix(); err != nil {
+			return err
+		}
+
+		verifyErr := service.verify(ctx, satellite, access, buffer)
+		switch {
+		case verifyErr == nil:
+			mon.Meter("scrub_verified_pieces").Mark(1)
+			stats.Verified++
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case errs.IsFunc(verifyErr, os.IsNotExist):
+			// the piece was deleted in the meantime.
+		case ErrCorrupted.Has(verifyErr):
+			mon.Meter("scrub_corrupted_pieces").Mark(1)
+			stats.Corrupted++
+
+			service.log.Error("piece is corrupted",
+				zap.Stringer("Satellite ID", satellite),
+				zap.Stringer("Piece ID", access.PieceID()),
+				zap.Error(verifyErr))
+
+			err := service.store.Quarantine(ctx, satellite, access.PieceID(), access.StorageFormatVersion())
+			if err != nil {
+				service.log.Error("unable to quarantine piece",
+					zap.Stringer("Satellite ID", satellite),
+					zap.Stringer("Piece ID", access.PieceID()),
+					zap.Error(err))
+			}
+		default:
+			mon.Meter("scrub_failed_pieces").Mark(1)
+			stats.Failed++
+
+			service.log.Warn("unable to verify piece",
+				zap.Stringer("Satellite ID", satellite),
+				zap.Stringer("Piece ID", access.PieceID()),
+				zap.Error(verifyErr))
+		}
+		return nil
+	})
+	if err == nil {
+		err = finishPrefix()
+	}
+	return stats, Error.Wrap(err)
+}
+
+// verify reads the whole piece and compares it with the hash stored with the piece.
+func (service *Service) verify(ctx context.Context, satellite storj.NodeID, access pieces.StoredPieceAccess, buffer []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := service.store.ReaderWithStorageFormat(ctx, satellite, access.PieceID(), access.StorageFormatVersion())
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	pieceHash, _, err := service.store.GetHashAndLimit(ctx, satellite, access.PieceID(), reader)
+	if err != nil {
+		var pathErr *os.PathError
+		if reader.StorageFormatVersion() == filestore.FormatV0 || errors.As(err, &pathErr) {
+			// the hash of V0 pieces is stored in the database, not in the piece,
+			// and a failing read doesn't tell anything about the content.
+			return err
+		}
+		return ErrCorrupted.New("invalid piece header: %v", err)
+	}
+
+	hash := pkcrypto.NewHash()
+	for {
+		if err := service.limiter.WaitN(ctx, len(buffer)); err != nil {
+			return err
+		}
+
+		n, err := reader.Read(buffer)
+		_, _ = hash.Write(buffer[:n])
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if !bytes.Equal(hash.Sum(nil), pieceHash.Hash) {
+		return ErrCorrupted.New("piece content does not match its hash")
+	}
+	return nil
+}
+
+// notifyCorrupted sends a notification to the dashboard about the quarantined pieces.
+func (service *Service) notifyCorrupted(ctx context.Context, satellite storj.NodeID, count int64) {
+	_, err := service.notifications.Receive(ctx, notifications.NewNotification{
+		SenderID: service.nodeID,
+		Type:     notifications.TypeCorruptedPieces,
+		Title:    "Corrupted pieces detected",
+		Message: fmt.Sprintf("%d pieces of Satellite %s did not match their hashes and were moved to quarantine. "+
+			"This may indicate a failing disk.", count, satellite),
+	})
+	if err != nil {
+		service.log.Error("unable to send notification about corrupted pieces", zap.Error(err))
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrub_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/scrub"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestScrub(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+		notificationService := notifications.NewService(log, db.Notifications())
+
+		service := scrub.NewService(log, testrand.NodeID(), store, notificationService, "", scrub.Config{
+			Interval: 0,
+			ReadRate: 0,
+		})
+
+		satelliteID := testrand.NodeID()
+		healthy := testrand.PieceID()
+		corrupted := testrand.PieceID()
+
+		for _, pieceID := range []storj.PieceID{healthy, corrupted} {
+			writer, err := store.Writer(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(300 * memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{Hash: writer.Hash()}))
+		}
+
+		stats, err := service.Scrub(ctx)
+		require.NoError(t, err)
+		require.Equal(t, scrub.Stats{Verified: 2}, stats)
+
+		// flip a byte in the content of the piece
+		info, err := db.Pieces().Stat(ctx, storage.BlobRef{Namespace: satelliteID.Bytes(), Key: corrupted.Bytes()})
+		require.NoError(t, err)
+		path, err := info.FullPath(ctx)
+		require.NoError(t, err)
+
+		file, err := os.OpenFile(path, os.O_RDWR, 0)
+		require.NoError(t, err)
+		data := make([]byte, 1)
+		_, err = file.ReadAt(data, pieces.V1PieceHeaderReservedArea+100)
+		require.NoError(t, err)
+		data[0]++
+		_, err = file.WriteAt(data, pieces.V1PieceHeaderReservedArea+100)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		stats, err = service.Scrub(ctx)
+		require.NoError(t, err)
+		require.Equal(t, scrub.Stats{Verified: 1, Corrupted: 1}, stats)
+
+		// the corrupted piece should not be served anymore
+		_, err = store.Reader(ctx, satelliteID, corrupted)
+		require.True(t, os.IsNotExist(err))
+
+		reader, err := store.Reader(ctx, satelliteID, healthy)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		page, err := db.Notifications().List(ctx, notifications.Cursor{Limit: 10, Page: 1})
+		require.NoError(t, err)
+		require.Len(t, page.Notifications, 1)
+		require.Equal(t, notifications.TypeCorruptedPieces, page.Notifications[0].Type)
+
+		// quarantined pieces are not verified again
+		stats, err = service.Scrub(ctx)
+		require.NoError(t, err)
+		require.Equal(t, scrub.Stats{Verified: 1}, stats)
+	})
+}
+
+func TestScrubCheckpoint(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+		notificationService := notifications.NewService(log, db.Notifications())
+
+		checkpointPath := ctx.File("scrub-checkpoint.json")
+		service := scrub.NewService(log, testrand.NodeID(), store, notificationService, checkpointPath, scrub.Config{})
+
+		satelliteID := testrand.NodeID()
+		verified, remaining := testrand.PieceID(), testrand.PieceID()
+		// pieces with a different first byte are stored in different key prefix directories.
+		verified[0], remaining[0] = 0x00, 0xff
+
+		var verifiedPrefix string
+		for _, pieceID := range []storj.PieceID{verified, remaining} {
+			writer, err := store.Writer(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(10 * memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{Hash: writer.Hash()}))
+
+			if pieceID == verified {
+				info, err := db.Pieces().Stat(ctx, storage.BlobRef{Namespace: satelliteID.Bytes(), Key: pieceID.Bytes()})
+				require.NoError(t, err)
+				path, err := info.FullPath(ctx)
+				require.NoError(t, err)
+				verifiedPrefix = filepath.Dir(path)
+			}
+		}
+
+		// an interrupted pass continues with the directories not verified yet
+		writeCheckpoint(t, checkpointPath, verifiedPrefix)
+
+		stats, err := service.Scrub(ctx)
+		require.NoError(t, err)
+		require.Equal(t, scrub.Stats{Verified: 1}, stats)
+
+		// the next pass starts over
+		stats, err = service.Scrub(ctx)
+		require.NoError(t, err)
+		require.Equal(t, scrub.Stats{Verified: 2}, stats)
+	})
+}
+
+func TestScrubCheckpointStorageDirs(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		nodeID := testrand.NodeID()
+
+		primary, err := filestore.NewDir(log, ctx.Dir("primary"))
+		require.NoError(t, err)
+		additionalPath := ctx.Dir("additional")
+
+		blobs, err := filestore.NewMulti(log, primary, []string{additionalPath}, filestore.DefaultConfig)
+		require.NoError(t, err)
+		defer ctx.Check(blobs.Close)
+		require.NoError(t, blobs.CreateVerificationFile(nodeID))
+		require.NoError(t, blobs.VerifyStorageDir(nodeID))
+
+		additional, err := filestore.NewDir(log, additionalPath)
+		require.NoError(t, err)
+
+		store := pieces.NewStore(log, blobs, db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+		notificationService := notifications.NewService(log, db.Notifications())
+
+		checkpointPath := ctx.File("scrub-checkpoint.json")
+		service := scrub.NewService(log, nodeID, store, notificationService, checkpointPath, scrub.Config{})
+
+		satelliteID := testrand.NodeID()
+		verified, remaining := testrand.PieceID(), testrand.PieceID()
+		// pieces with the same first bytes are stored in the same key prefix directory.
+		verified[0], verified[1] = 0x00, 0x00
+		remaining[0], remaining[1] = 0x00, 0x00
+
+		// store the pieces in different storage directories
+		for _, piece := range []struct {
+			dir     *filestore.Dir
+			pieceID storj.PieceID
+		}{
+			{dir: primary, pieceID: verified},
+			{dir: additional, pieceID: remaining},
+		} {
+			dirStore := pieces.NewStore(log, filestore.New(log, piece.dir, filestore.DefaultConfig),
+				db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+
+			writer, err := dirStore.Writer(ctx, satelliteID, piece.pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(10 * memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{Hash: writer.Hash()}))
+		}
+
+		info, err := blobs.Stat(ctx, storage.BlobRef{Namespace: satelliteID.Bytes(), Key: verified.Bytes()})
+		require.NoError(t, err)
+		path, err := info.FullPath(ctx)
+		require.NoError(t, err)
+
+		// the directory with the same prefix in the other storage directory is not verified yet
+		writeCheckpoint(t, checkpointPath, filepath.Dir(path))
+
+		stats, err := service.Scrub(ctx)
+		require.NoError(t, err)
+		require.Equal(t, scrub.Stats{Verified: 1}, stats)
+	})
+}
+
+func writeCheckpoint(t *testing.T, path string, prefixes ...string) {
+	data, err := json.Marshal(map[string][]string{"prefixes": prefixes})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, data, 0644))
+}
@@ -83,6 +83,9 @@ type Config struct {
 	Driver    string // if unset, uses sqlite3
 	Pieces    string
 	Filestore filestore.Config
+
+	// AdditionalPieces are the paths of additional directories for storing pieces.
+	AdditionalPieces []string
 }
 
 // DB contains access to different database tables.
@@ -119,7 +122,10 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 		return nil, err
 	}
 
-	pieces := filestore.New(log, piecesDir, config.Filestore)
+	pieces, err := openPieces(log, piecesDir, config)
+	if err != nil {
+		return nil, err
+	}
 
 	deprecatedInfoDB := &deprecatedInfoDB{}
 	v0PieceInfoDB := &v0PieceInfoDB{}
@@ -180,6 +186,15 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 	return db, nil
 }
 
+// openPieces creates the blob store for pieces, which uses multiple directories
+// when additional directories are configured.
+func openPieces(log *zap.Logger, piecesDir *filestore.Dir, config Config) (storage.Blobs, error) {
+	if len(config.AdditionalPieces) == 0 {
+		return filestore.New(log, piecesDir, config.Filestore), nil
+	}
+	return filestore.NewMulti(log, piecesDir, config.AdditionalPieces, config.Filestore)
+}
+
 // OpenExisting opens an existing master database for storage node.
 func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 	piecesDir, err := filestore.OpenDir(log, config.Pieces)
@@ -187,7 +202,10 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 		return nil, err
 	}
 
-	pieces := filestore.New(log, piecesDir, config.Filestore)
+	pieces, err := openPieces(log, piecesDir, config)
+	if err != nil {
+		return nil, err
+	}
 
 	deprecatedInfoDB := &deprecatedInfoDB{}
 	v0PieceInfoDB := &v0PieceInfoDB{}
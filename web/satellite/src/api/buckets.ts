@@ -32,6 +32,8 @@ export class BucketsApiGql extends BaseGql implements BucketsApi {
                             storage,
                             egress,
                             objectCount,
+                            storageLimit,
+                            egressLimit,
                             since,
                             before
                         },
@@ -98,7 +100,9 @@ export class BucketsApiGql extends BaseGql implements BucketsApi {
                 key.egress,
                 key.objectCount,
                 new Date(key.since),
-                new Date(key.before)));
+                new Date(key.before),
+                key.storageLimit,
+                key.egressLimit));
 
         return new BucketPage(buckets, page.search, page.limit, page.offset, page.pageCount, page.currentPage, page.totalCount);
     }
@@ -141,6 +141,10 @@ export class ProjectsApiGql extends BaseGql implements ProjectsApi {
                 limits.bandwidthUsed,
                 limits.storageLimit,
                 limits.storageUsed,
+                limits.segmentLimit,
+                limits.segmentUsed,
+                limits.objectLimit,
+                limits.objectUsed,
             );
         }
 
@@ -168,6 +172,10 @@ export class ProjectsApiGql extends BaseGql implements ProjectsApi {
                 limits.bandwidthUsed,
                 limits.storageLimit,
                 limits.storageUsed,
+                limits.segmentLimit,
+                limits.segmentUsed,
+                limits.objectLimit,
+                limits.objectUsed,
             );
         }
 
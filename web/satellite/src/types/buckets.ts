@@ -33,6 +33,8 @@ export class Bucket {
         public objectCount: number = 0,
         public since: Date = new Date(),
         public before: Date = new Date(),
+        public storageLimit: number | null = null,
+        public egressLimit: number | null = null,
     ) {}
 }
 
@@ -142,6 +142,10 @@ export class ProjectLimits {
         public bandwidthUsed: number = 0,
         public storageLimit: number = 0,
         public storageUsed: number = 0,
+        public segmentLimit: number = 0,
+        public segmentUsed: number = 0,
+        public objectLimit: number = 0,
+        public objectUsed: number = 0,
     ) {}
 }
 
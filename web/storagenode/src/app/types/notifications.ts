@@ -70,6 +70,7 @@ export class UINotification {
     private setIcon(): void {
         switch (this.type) {
         case NotificationTypes.AuditCheckFailure:
+        case NotificationTypes.CorruptedPieces:
             this.icon = NotificationIcon.FAIL;
             break;
         case NotificationTypes.Disqualification: